@@ -47,7 +47,8 @@ type DeliverResult struct {
 	Diff []ValidatorUpdate
 	// Tags, if present, will be used by tendermint to index and search the transaction history
 	Tags []common.KVPair
-	// GasUsed is currently unused field until effects in tendermint are clear
+	// GasUsed is the amount of gas consumed by the transaction. It is set
+	// by the application from the gas meter of the transaction.
 	GasUsed int64
 }
 
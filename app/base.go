@@ -3,6 +3,7 @@ package app
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -13,7 +14,36 @@ type BaseApp struct {
 	decoder weave.TxDecoder
 	handler weave.Handler
 	ticker  weave.Ticker
+	ender   weave.EndBlocker
 	debug   bool
+	gas     *gasLimits
+}
+
+// gasLimits loads the gas rules of the application and tracks the amount of
+// gas consumed in the current block.
+type gasLimits struct {
+	load      store.GasRulesLoader
+	blockUsed int64
+}
+
+func newGasLimits() *gasLimits {
+	return &gasLimits{load: store.DefaultGasRules}
+}
+
+// deliverLimit returns the gas limit of the next transaction delivered in
+// the current block. A zero limit means no limit.
+func (g *gasLimits) deliverLimit(rules store.GasRules) (int64, error) {
+	if rules.BlockLimit == 0 {
+		return rules.TxLimit, nil
+	}
+	left := rules.BlockLimit - g.blockUsed
+	if left <= 0 {
+		return 0, errors.Wrap(errors.ErrOutOfGas, "block gas limit reached")
+	}
+	if rules.TxLimit == 0 || left < rules.TxLimit {
+		return left, nil
+	}
+	return rules.TxLimit, nil
 }
 
 var _ abci.Application = BaseApp{}
@@ -32,9 +62,27 @@ func NewBaseApp(
 		handler:  handler,
 		ticker:   ticker,
 		debug:    debug,
+		gas:      newGasLimits(),
 	}
 }
 
+// WithGasRules sets the loader of the gas costs and limits. Rules are loaded
+// from the state before processing every transaction, so that all nodes apply
+// the same rules. By default, store.DefaultGasRules are used.
+func (b BaseApp) WithGasRules(load store.GasRulesLoader) BaseApp {
+	gas := *b.gas
+	gas.load = load
+	b.gas = &gas
+	return b
+}
+
+// WithEndBlocker sets the end blocker that is called at the end of every
+// block with the summary of all transactions delivered in that block.
+func (b BaseApp) WithEndBlocker(ender weave.EndBlocker) BaseApp {
+	b.ender = ender
+	return b
+}
+
 // DeliverTx - ABCI - dispatches to the handler
 func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
 	tx, err := b.loadTx(txBytes)
@@ -47,11 +95,29 @@ func (b BaseApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
 		"call", "deliver_tx",
 		"path", weave.GetPath(tx))
 
-	res, err := b.handler.Deliver(ctx, b.DeliverStore(), tx)
+	rules, err := b.gas.load(b.DeliverStore())
+	if err != nil {
+		return weave.DeliverTxError(errors.Wrap(err, "cannot load gas rules"), b.debug)
+	}
+	limit, err := b.gas.deliverLimit(rules)
+	if err != nil {
+		return weave.DeliverTxError(err, b.debug)
+	}
+	meter := weave.NewGasMeter(limit)
+	ctx = weave.WithGasMeter(ctx, meter)
+
+	// Changes are not reverted when the handler fails, even if it ran out
+	// of gas. Reverting the handler changes while keeping the fee charge
+	// is the responsibility of the decorators (see cash.DynamicFeeDecorator).
+	db := store.NewGasKVStore(b.DeliverStore(), meter, rules.Costs)
+	res, err := b.handler.Deliver(ctx, db, tx)
+	b.gas.blockUsed += meter.GasConsumed()
 	if err == nil {
 		b.AddValChange(res.Diff)
 	}
-	return weave.DeliverOrError(res, err, b.debug)
+	resp := weave.DeliverOrError(res, err, b.debug)
+	resp.GasUsed = meter.GasConsumed()
+	return resp
 }
 
 // CheckTx - ABCI - dispatches to the handler
@@ -65,14 +131,114 @@ func (b BaseApp) CheckTx(txBytes []byte) abci.ResponseCheckTx {
 		"call", "check_tx",
 		"path", weave.GetPath(tx))
 
-	res, err := b.handler.Check(ctx, b.CheckStore(), tx)
-	return weave.CheckOrError(res, err, b.debug)
+	rules, err := b.gas.load(b.CheckStore())
+	if err != nil {
+		return weave.CheckTxError(errors.Wrap(err, "cannot load gas rules"), b.debug)
+	}
+	meter := weave.NewGasMeter(rules.TxLimit)
+	ctx = weave.WithGasMeter(ctx, meter)
+
+	db := store.NewGasKVStore(b.CheckStore(), meter, rules.Costs)
+	res, err := b.handler.Check(ctx, db, tx)
+	if err == nil {
+		allocateConsumed(res, meter)
+	}
+	resp := weave.CheckOrError(res, err, b.debug)
+	resp.GasUsed = meter.GasConsumed()
+	return resp
+}
+
+// SimulatePath is the query path used to check a transaction without
+// persisting any changes. Query data must be a serialized transaction and the
+// response value is a serialized SimulationResult.
+const SimulatePath = "/simulate"
+
+// Query - ABCI - handles transaction simulation and dispatches all other
+// queries to the store.
+func (b BaseApp) Query(reqQuery abci.RequestQuery) abci.ResponseQuery {
+	if reqQuery.Path == SimulatePath {
+		return b.simulate(reqQuery)
+	}
+	return b.StoreApp.Query(reqQuery)
+}
+
+// simulate runs the transaction through the full decorator chain and handler
+// in check mode. The transaction is executed on top of the check state, so
+// that transactions already accepted into the mempool are taken into account.
+// All changes are discarded.
+func (b BaseApp) simulate(reqQuery abci.RequestQuery) abci.ResponseQuery {
+	if reqQuery.Height != 0 {
+		return queryError(errors.Wrap(errors.ErrInput, "simulation is supported only for the latest state"))
+	}
+	info, err := b.store.CommitInfo()
+	if err != nil {
+		return queryError(err)
+	}
+
+	rules, err := b.gas.load(b.CheckStore())
+	if err != nil {
+		return queryError(errors.Wrap(err, "cannot load gas rules"))
+	}
+
+	var res SimulationResult
+	tx, err := b.loadTx(reqQuery.Data)
+	if err != nil {
+		resp := weave.CheckTxError(err, b.debug)
+		res.Code, res.Log = resp.Code, resp.Log
+	} else {
+		ctx := weave.WithLogInfo(b.BlockContext(),
+			"call", "simulate",
+			"path", weave.GetPath(tx))
+		meter := weave.NewGasMeter(rules.TxLimit)
+		ctx = weave.WithGasMeter(ctx, meter)
+
+		cache := b.CheckStore().CacheWrap()
+		defer cache.Discard()
+
+		db := store.NewGasKVStore(cache, meter, rules.Costs)
+		cres, err := b.handler.Check(ctx, db, tx)
+		if err == nil {
+			allocateConsumed(cres, meter)
+		}
+		resp := weave.CheckOrError(cres, err, b.debug)
+		res.Code = resp.Code
+		res.Log = resp.Log
+		res.Data = resp.Data
+		res.GasAllocated = resp.GasWanted
+		res.GasUsed = meter.GasConsumed()
+		if err == nil {
+			res.GasPayment = cres.GasPayment
+			if !cres.RequiredFee.IsZero() {
+				fee := cres.RequiredFee
+				res.RequiredFee = &fee
+			}
+		}
+	}
+
+	value, err := res.Marshal()
+	if err != nil {
+		return queryError(err)
+	}
+	return abci.ResponseQuery{
+		Height: info.Version,
+		Value:  value,
+	}
+}
+
+// allocateConsumed makes sure that the gas allocated by a check result is not
+// less than the gas that was consumed by the check, so that the gas wanted
+// reported to tendermint accounts for the store operations as well.
+func allocateConsumed(res *weave.CheckResult, meter weave.GasMeter) {
+	if res != nil && res.GasAllocated < meter.GasConsumed() {
+		res.GasAllocated = meter.GasConsumed()
+	}
 }
 
 // BeginBlock - ABCI
 func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	// default: set the context properly
 	b.StoreApp.BeginBlock(req)
+	b.gas.blockUsed = 0
 
 	var response abci.ResponseBeginBlock
 	if b.ticker != nil {
@@ -84,6 +250,19 @@ func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock
 	return response
 }
 
+// EndBlock - ABCI
+// Calls the end blocker, if set, before returning the validator changes.
+func (b BaseApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	if b.ender != nil {
+		ctx := weave.WithLogInfo(b.BlockContext(), "call", "end_block")
+		summary := weave.BlockSummary{
+			GasUsed: b.gas.blockUsed,
+		}
+		b.ender.EndBlock(ctx, b.DeliverStore(), summary)
+	}
+	return b.StoreApp.EndBlock(req)
+}
+
 // loadTx calls the decoder, and capture any panics
 func (b BaseApp) loadTx(txBytes []byte) (tx weave.Tx, err error) {
 	defer errors.Recover(&err)
@@ -0,0 +1,289 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestBaseAppGasLimits(t *testing.T) {
+	// Every write costs exactly 100 gas.
+	conf := store.GasConfig{WriteCostFlat: 100}
+
+	cases := map[string]struct {
+		txLimit    int64
+		blockLimit int64
+		// writes is the number of writes performed by each
+		// delivered transaction.
+		writes   []int
+		wantErrs []*errors.Error
+		wantGas  []int64
+	}{
+		"no limits": {
+			writes:   []int{3, 50},
+			wantErrs: []*errors.Error{nil, nil},
+			wantGas:  []int64{300, 5000},
+		},
+		"transaction limit": {
+			txLimit:  250,
+			writes:   []int{2, 3, 1},
+			wantErrs: []*errors.Error{nil, errors.ErrOutOfGas, nil},
+			wantGas:  []int64{200, 250, 100},
+		},
+		"block limit": {
+			blockLimit: 500,
+			writes:     []int{3, 3, 1},
+			wantErrs:   []*errors.Error{nil, errors.ErrOutOfGas, errors.ErrOutOfGas},
+			wantGas:    []int64{300, 200, 0},
+		},
+		"transaction limit lower than block limit": {
+			txLimit:    200,
+			blockLimit: 500,
+			writes:     []int{2, 2, 2},
+			wantErrs:   []*errors.Error{nil, nil, errors.ErrOutOfGas},
+			wantGas:    []int64{200, 200, 100},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			handler := &writeHandler{}
+			kv := iavl.MockCommitStore()
+			decoder := func([]byte) (weave.Tx, error) { return &weavetest.Tx{}, nil }
+			app := NewBaseApp(NewStoreApp("gas", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+				WithGasRules(gasRules(conf, tc.txLimit, tc.blockLimit))
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1, Time: time.Now()}})
+
+			for i, writes := range tc.writes {
+				handler.writes = writes
+				handler.prefix = fmt.Sprintf("tx%d-", i)
+				resp := app.DeliverTx([]byte("tx"))
+				if tc.wantErrs[i] == nil {
+					assert.Equal(t, uint32(0), resp.Code)
+				} else {
+					assert.Equal(t, tc.wantErrs[i].ABCICode(), resp.Code)
+				}
+				assert.Equal(t, tc.wantGas[i], resp.GasUsed)
+			}
+
+			// Block limit is reset with each block.
+			app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2, Time: time.Now()}})
+			handler.writes = 1
+			handler.prefix = "next-block-"
+			resp := app.DeliverTx([]byte("tx"))
+			assert.Equal(t, uint32(0), resp.Code)
+		})
+	}
+}
+
+func TestBaseAppCheckTxGasLimit(t *testing.T) {
+	handler := &writeHandler{writes: 3, prefix: "check-"}
+	kv := iavl.MockCommitStore()
+	decoder := func([]byte) (weave.Tx, error) { return &weavetest.Tx{}, nil }
+	app := NewBaseApp(NewStoreApp("gas", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+		WithGasRules(gasRules(store.GasConfig{WriteCostFlat: 100}, 250, 0))
+
+	resp := app.CheckTx([]byte("tx"))
+	assert.Equal(t, errors.ErrOutOfGas.ABCICode(), resp.Code)
+	assert.Equal(t, int64(250), resp.GasUsed)
+}
+
+func TestBaseAppGasRulesFromState(t *testing.T) {
+	handler := &writeHandler{writes: 3, prefix: "state-"}
+	kv := iavl.MockCommitStore()
+	decoder := func([]byte) (weave.Tx, error) { return &weavetest.Tx{}, nil }
+	// The transaction gas limit is read from the state, so that it
+	// can be changed by a transaction.
+	rules := func(db store.ReadOnlyKVStore) (store.GasRules, error) {
+		raw, err := db.Get([]byte("txlimit"))
+		if err != nil {
+			return store.GasRules{}, err
+		}
+		var limit int64
+		if raw != nil {
+			limit = int64(raw[0])
+		}
+		return store.GasRules{Costs: store.GasConfig{WriteCostFlat: 100}, TxLimit: limit}, nil
+	}
+	app := NewBaseApp(NewStoreApp("gas", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+		WithGasRules(rules)
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1, Time: time.Now()}})
+
+	resp := app.DeliverTx([]byte("tx"))
+	assert.Equal(t, uint32(0), resp.Code)
+	assert.Equal(t, int64(300), resp.GasUsed)
+
+	assert.Nil(t, app.DeliverStore().Set([]byte("txlimit"), []byte{250}))
+	resp = app.DeliverTx([]byte("tx"))
+	assert.Equal(t, errors.ErrOutOfGas.ABCICode(), resp.Code)
+	assert.Equal(t, int64(250), resp.GasUsed)
+}
+
+func TestBaseAppCheckTxGasAllocated(t *testing.T) {
+	cases := map[string]struct {
+		allocated int64
+		wantGas   int64
+	}{
+		"consumed gas is allocated": {
+			allocated: 0,
+			wantGas:   300,
+		},
+		"handler allocates more than consumed": {
+			allocated: 1000,
+			wantGas:   1000,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			handler := &writeHandler{writes: 3, prefix: "check-", allocated: tc.allocated}
+			kv := iavl.MockCommitStore()
+			decoder := func([]byte) (weave.Tx, error) { return &weavetest.Tx{}, nil }
+			app := NewBaseApp(NewStoreApp("gas", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+				WithGasRules(gasRules(store.GasConfig{WriteCostFlat: 100}, 0, 0))
+
+			resp := app.CheckTx([]byte("tx"))
+			assert.Equal(t, uint32(0), resp.Code)
+			assert.Equal(t, int64(300), resp.GasUsed)
+			assert.Equal(t, tc.wantGas, resp.GasWanted)
+		})
+	}
+}
+
+func TestBaseAppSimulate(t *testing.T) {
+	fee := coin.NewCoin(0, 5, "IOV")
+	handler := &writeHandler{writes: 2, prefix: "simulate-", fee: fee}
+	kv := iavl.MockCommitStore()
+	decoder := func(raw []byte) (weave.Tx, error) {
+		if string(raw) != "tx" {
+			return nil, errors.Wrap(errors.ErrInput, "unknown transaction")
+		}
+		return &weavetest.Tx{}, nil
+	}
+	app := NewBaseApp(NewStoreApp("simulate", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+		WithGasRules(gasRules(store.GasConfig{WriteCostFlat: 100}, 0, 0))
+
+	simulate := func(t *testing.T, tx string) SimulationResult {
+		t.Helper()
+		resp := app.Query(abci.RequestQuery{Path: SimulatePath, Data: []byte(tx)})
+		assert.Equal(t, uint32(0), resp.Code)
+		var res SimulationResult
+		assert.Nil(t, res.Unmarshal(resp.Value))
+		return res
+	}
+
+	res := simulate(t, "tx")
+	assert.Equal(t, uint32(0), res.Code)
+	assert.Equal(t, &fee, res.RequiredFee)
+	assert.Equal(t, int64(200), res.GasUsed)
+	assert.Equal(t, int64(200), res.GasAllocated)
+	ok, err := app.CheckStore().Has([]byte("simulate-0"))
+	assert.Nil(t, err)
+	if ok {
+		t.Fatal("changes of a simulated transaction must not be persisted")
+	}
+
+	res = simulate(t, "invalid")
+	assert.Equal(t, errors.ErrInput.ABCICode(), res.Code)
+
+	app = app.WithGasRules(gasRules(store.GasConfig{WriteCostFlat: 100}, 150, 0))
+	res = simulate(t, "tx")
+	assert.Equal(t, errors.ErrOutOfGas.ABCICode(), res.Code)
+	assert.Equal(t, int64(150), res.GasUsed)
+	if res.RequiredFee != nil {
+		t.Fatalf("failed check must not return a fee: %v", res.RequiredFee)
+	}
+
+	resp := app.Query(abci.RequestQuery{Path: SimulatePath, Data: []byte("tx"), Height: 1})
+	assert.Equal(t, errors.ErrInput.ABCICode(), resp.Code)
+}
+
+func TestBaseAppEndBlocker(t *testing.T) {
+	handler := &writeHandler{writes: 2, prefix: "end-"}
+	kv := iavl.MockCommitStore()
+	decoder := func(raw []byte) (weave.Tx, error) {
+		if string(raw) == "invalid" {
+			return nil, errors.Wrap(errors.ErrInput, "invalid")
+		}
+		return &weavetest.Tx{}, nil
+	}
+	ender := &endBlockerMock{}
+	app := NewBaseApp(NewStoreApp("end", kv, weave.NewQueryRouter(), context.Background()), decoder, handler, nil, false).
+		WithGasRules(gasRules(store.GasConfig{WriteCostFlat: 100}, 0, 0)).
+		WithEndBlocker(ender)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1, Time: time.Now()}})
+	app.DeliverTx([]byte("tx"))
+	app.DeliverTx([]byte("tx"))
+	app.DeliverTx([]byte("invalid"))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+
+	// Summary is reset with each block.
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2, Time: time.Now()}})
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+
+	want := []weave.BlockSummary{
+		{GasUsed: 400},
+		{GasUsed: 0},
+	}
+	assert.Equal(t, want, ender.summaries)
+}
+
+// gasRules returns a loader of constant gas rules.
+func gasRules(costs store.GasConfig, txLimit, blockLimit int64) store.GasRulesLoader {
+	return func(store.ReadOnlyKVStore) (store.GasRules, error) {
+		return store.GasRules{Costs: costs, TxLimit: txLimit, BlockLimit: blockLimit}, nil
+	}
+}
+
+type endBlockerMock struct {
+	summaries []weave.BlockSummary
+}
+
+func (m *endBlockerMock) EndBlock(ctx weave.Context, db weave.CacheableKVStore, summary weave.BlockSummary) {
+	m.summaries = append(m.summaries, summary)
+}
+
+// writeHandler writes given number of keys using the prefix.
+type writeHandler struct {
+	writes int
+	prefix string
+	// fee is returned as the required fee of the check.
+	fee coin.Coin
+	// allocated is returned as the gas allocated by the check.
+	allocated int64
+}
+
+func (h *writeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.write(db); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{RequiredFee: h.fee, GasAllocated: h.allocated}, nil
+}
+
+func (h *writeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.write(db); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *writeHandler) write(db weave.KVStore) error {
+	for i := 0; i < h.writes; i++ {
+		key := fmt.Sprintf("%s%d", h.prefix, i)
+		if err := db.Set([]byte(key), []byte("value")); err != nil {
+			return errors.Wrap(err, "cannot write")
+		}
+	}
+	return nil
+}
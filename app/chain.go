@@ -84,3 +84,19 @@ func (s step) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave
 func (s step) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
 	return s.d.Deliver(ctx, store, tx, s.next)
 }
+
+// ChainEndBlockers returns an end blocker that calls all given end blockers,
+// in the order they were provided.
+func ChainEndBlockers(chain ...weave.EndBlocker) weave.EndBlocker {
+	return endBlockers(chain)
+}
+
+type endBlockers []weave.EndBlocker
+
+var _ weave.EndBlocker = endBlockers(nil)
+
+func (ebs endBlockers) EndBlock(ctx weave.Context, store weave.CacheableKVStore, summary weave.BlockSummary) {
+	for _, eb := range ebs {
+		eb.EndBlock(ctx, store, summary)
+	}
+}
@@ -155,3 +155,14 @@ func TestCutoffNil(t *testing.T) {
 		})
 	}
 }
+
+func TestChainEndBlockers(t *testing.T) {
+	e1 := &endBlockerMock{}
+	e2 := &endBlockerMock{}
+	summary := weave.BlockSummary{GasUsed: 10}
+
+	ChainEndBlockers(e1, e2).EndBlock(context.Background(), nil, summary)
+
+	assert.Equal(t, []weave.BlockSummary{summary}, e1.summaries)
+	assert.Equal(t, []weave.BlockSummary{summary}, e2.summaries)
+}
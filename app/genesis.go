@@ -26,3 +26,26 @@ func (c chainInitializer) FromGenesis(opts weave.Options, params weave.GenesisPa
 	}
 	return nil
 }
+
+//------ export state -----
+
+// ChainExporters lets you export the state of many extensions with one
+// function
+func ChainExporters(exps ...weave.Exporter) weave.Exporter {
+	return chainExporter{exps}
+}
+
+type chainExporter struct {
+	exps []weave.Exporter
+}
+
+// ExportGenesis will pass opts to all Exporters in the list,
+// aborting at the first error.
+func (c chainExporter) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	for _, e := range c.exps {
+		if err := e.ExportGenesis(db, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
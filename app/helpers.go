@@ -65,16 +65,28 @@ func (a *ABCIStore) Iterator(start, end []byte) (weave.Iterator, error) {
 		return nil, errors.Wrap(errors.ErrDatabase, "iterator only implemented for entire range")
 	}
 
-	query := a.app.Query(abci.RequestQuery{
-		Path: "/?prefix",
-		Data: nil,
-	})
-	if query.Code != 0 {
-		return nil, errors.Wrap(errors.ErrDatabase, query.Log)
-	}
-	models, err := toModels(query.Key, query.Value)
-	if err != nil {
-		return nil, err
+	// the result is paginated, so keep querying until there is no cursor
+	var (
+		models []weave.Model
+		opts   weave.QueryOptions
+	)
+	for {
+		query := a.app.Query(abci.RequestQuery{
+			Path: "/?" + weave.QueryMod(weave.PrefixQueryMod, opts),
+			Data: nil,
+		})
+		if query.Code != 0 {
+			return nil, errors.Wrap(errors.ErrDatabase, query.Log)
+		}
+		page, cursor, err := toModels(query.Key, query.Value)
+		if err != nil {
+			return nil, err
+		}
+		models = append(models, page...)
+		if cursor == nil {
+			break
+		}
+		opts.After = cursor
 	}
 
 	return store.NewSliceIterator(models), nil
@@ -85,13 +97,14 @@ func (a *ABCIStore) ReverseIterator(start, end []byte) (weave.Iterator, error) {
 	return nil, errors.Wrap(errors.ErrDatabase, "not implemented")
 }
 
-func toModels(keys, values []byte) ([]weave.Model, error) {
+func toModels(keys, values []byte) ([]weave.Model, []byte, error) {
 	var k, v ResultSet
 	if err := k.Unmarshal(keys); err != nil {
-		return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal keys: %v", err.Error())
+		return nil, nil, errors.Wrapf(errors.ErrState, "cannot unmarshal keys: %v", err.Error())
 	}
 	if err := v.Unmarshal(values); err != nil {
-		return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal values: %v", err.Error())
+		return nil, nil, errors.Wrapf(errors.ErrState, "cannot unmarshal values: %v", err.Error())
 	}
-	return JoinResults(&k, &v)
+	models, err := JoinResults(&k, &v)
+	return models, k.Cursor, err
 }
@@ -6,6 +6,7 @@ package app
 import (
 	fmt "fmt"
 	proto "github.com/gogo/protobuf/proto"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -24,6 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // ResultSet contains a list of keys or values
 type ResultSet struct {
 	Results [][]byte `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Cursor is set when the query result is not complete. It must be sent as
+	// the query data to continue the query and receive the following results.
+	Cursor []byte `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 }
 
 func (m *ResultSet) Reset()         { *m = ResultSet{} }
@@ -66,21 +70,145 @@ func (m *ResultSet) GetResults() [][]byte {
 	return nil
 }
 
+func (m *ResultSet) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// SimulationResult is the outcome of a transaction check that was executed
+// without persisting any changes.
+type SimulationResult struct {
+	// Code is the ABCI code of the check. Zero means the transaction would be
+	// accepted.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Log is a human readable information about the check result or error.
+	Log string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	// Data is the value returned by the handler.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// RequiredFee is the fee requested by the handlers for processing this
+	// transaction.
+	RequiredFee *coin.Coin `protobuf:"bytes,4,opt,name=required_fee,json=requiredFee,proto3" json:"required_fee,omitempty"`
+	// GasAllocated is the maximum amount of gas the transaction is allowed to
+	// consume.
+	GasAllocated int64 `protobuf:"varint,5,opt,name=gas_allocated,json=gasAllocated,proto3" json:"gas_allocated,omitempty"`
+	// GasPayment is the total fee paid by the transaction.
+	GasPayment int64 `protobuf:"varint,6,opt,name=gas_payment,json=gasPayment,proto3" json:"gas_payment,omitempty"`
+	// GasUsed is the amount of gas consumed by the check.
+	GasUsed int64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *SimulationResult) Reset()         { *m = SimulationResult{} }
+func (m *SimulationResult) String() string { return proto.CompactTextString(m) }
+func (*SimulationResult) ProtoMessage()    {}
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef4977b2ac0c9d2, []int{1}
+}
+func (m *SimulationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationResult.Merge(m, src)
+}
+func (m *SimulationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationResult proto.InternalMessageInfo
+
+func (m *SimulationResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SimulationResult) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *SimulationResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetRequiredFee() *coin.Coin {
+	if m != nil {
+		return m.RequiredFee
+	}
+	return nil
+}
+
+func (m *SimulationResult) GetGasAllocated() int64 {
+	if m != nil {
+		return m.GasAllocated
+	}
+	return 0
+}
+
+func (m *SimulationResult) GetGasPayment() int64 {
+	if m != nil {
+		return m.GasPayment
+	}
+	return 0
+}
+
+func (m *SimulationResult) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ResultSet)(nil), "app.ResultSet")
+	proto.RegisterType((*SimulationResult)(nil), "app.SimulationResult")
 }
 
 func init() { proto.RegisterFile("app/results.proto", fileDescriptor_9ef4977b2ac0c9d2) }
 
 var fileDescriptor_9ef4977b2ac0c9d2 = []byte{
-	// 105 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0x2c, 0x28, 0xd0,
-	0x2f, 0x4a, 0x2d, 0x2e, 0xcd, 0x29, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e,
-	0x2c, 0x28, 0x50, 0x52, 0xe5, 0xe2, 0x0c, 0x02, 0x8b, 0x06, 0xa7, 0x96, 0x08, 0x49, 0x70, 0xb1,
-	0x43, 0x95, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0xf0, 0x04, 0xc1, 0xb8, 0x4e, 0x12, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x90, 0xc4, 0x06, 0x36, 0xcc, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x13, 0x46, 0x35, 0xf1, 0x61, 0x00, 0x00, 0x00,
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x31, 0x4f, 0xfb, 0x30,
+	0x10, 0xc5, 0xeb, 0x7f, 0xfa, 0x6f, 0xe9, 0x35, 0x95, 0x8a, 0x07, 0x64, 0x18, 0x42, 0x54, 0x96,
+	0x2c, 0xb4, 0x12, 0xcc, 0x0c, 0x80, 0xc4, 0x8c, 0x5c, 0x31, 0x57, 0x47, 0x7c, 0x44, 0x91, 0xd2,
+	0xd8, 0xd8, 0xce, 0xc0, 0xb7, 0xe0, 0x63, 0x31, 0x76, 0x64, 0x60, 0x40, 0xed, 0x17, 0x41, 0x76,
+	0xdb, 0xed, 0xdd, 0xbb, 0xdf, 0x7b, 0xb6, 0x0e, 0x4e, 0xd1, 0x98, 0x85, 0x25, 0xd7, 0x35, 0xde,
+	0xcd, 0x8d, 0xd5, 0x5e, 0xf3, 0x04, 0x8d, 0xb9, 0x98, 0x96, 0xba, 0x6e, 0x17, 0xa5, 0x56, 0x54,
+	0xee, 0xed, 0xd9, 0x1d, 0x8c, 0x64, 0xe4, 0x96, 0xe4, 0xb9, 0x80, 0xe1, 0x21, 0x24, 0x58, 0x9e,
+	0x14, 0xa9, 0x3c, 0x8e, 0xfc, 0x0c, 0x06, 0x65, 0x67, 0x9d, 0xb6, 0xe2, 0x5f, 0xce, 0x8a, 0x54,
+	0x1e, 0xa6, 0xd9, 0x0f, 0x83, 0xe9, 0xb2, 0x5e, 0x77, 0x0d, 0xfa, 0x5a, 0xb7, 0xfb, 0x26, 0xce,
+	0xa1, 0x1f, 0x9e, 0x10, 0x2c, 0x67, 0xc5, 0x44, 0x46, 0xcd, 0xa7, 0x90, 0x34, 0xba, 0x8a, 0xe9,
+	0x91, 0x0c, 0x32, 0x50, 0x0a, 0x3d, 0x8a, 0x24, 0x16, 0x46, 0xcd, 0xaf, 0x21, 0xb5, 0xf4, 0xde,
+	0xd5, 0x96, 0xd4, 0xea, 0x8d, 0x48, 0xf4, 0x73, 0x56, 0x8c, 0x6f, 0x60, 0x1e, 0xbe, 0x3d, 0x7f,
+	0xd4, 0x75, 0x2b, 0xc7, 0xc7, 0xfd, 0x13, 0x11, 0xbf, 0x82, 0x49, 0x85, 0x6e, 0x85, 0x4d, 0xa3,
+	0x4b, 0xf4, 0xa4, 0xc4, 0xff, 0x9c, 0x15, 0x89, 0x4c, 0x2b, 0x74, 0xf7, 0x47, 0x8f, 0x5f, 0xc2,
+	0x38, 0x40, 0x06, 0x3f, 0xd6, 0xd4, 0x7a, 0x31, 0x88, 0x08, 0x54, 0xe8, 0x9e, 0xf7, 0x0e, 0x3f,
+	0x87, 0x93, 0x00, 0x74, 0x8e, 0x94, 0x18, 0xc6, 0xed, 0xb0, 0x42, 0xf7, 0xe2, 0x48, 0x3d, 0x88,
+	0xaf, 0x6d, 0xc6, 0x36, 0xdb, 0x8c, 0xfd, 0x6e, 0x33, 0xf6, 0xb9, 0xcb, 0x7a, 0x9b, 0x5d, 0xd6,
+	0xfb, 0xde, 0x65, 0xbd, 0xd7, 0x41, 0x3c, 0xdf, 0xed, 0xdf, 0x00, 0x6d, 0x8b, 0x35, 0xf4, 0x6a,
+	0x01, 0x00, 0x00,
 }
 
 func (m *ResultSet) Marshal() (dAtA []byte, err error) {
@@ -106,6 +234,72 @@ func (m *ResultSet) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], b)
 		}
 	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	return i, nil
+}
+
+func (m *SimulationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulationResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.RequiredFee != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.RequiredFee.Size()))
+		n1, err := m.RequiredFee.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.GasAllocated != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.GasAllocated))
+	}
+	if m.GasPayment != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.GasPayment))
+	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintResults(dAtA, i, uint64(m.GasUsed))
+	}
 	return i, nil
 }
 
@@ -130,6 +324,43 @@ func (m *ResultSet) Size() (n int) {
 			n += 1 + l + sovResults(uint64(l))
 		}
 	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovResults(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovResults(uint64(l))
+	}
+	if m.RequiredFee != nil {
+		l = m.RequiredFee.Size()
+		n += 1 + l + sovResults(uint64(l))
+	}
+	if m.GasAllocated != 0 {
+		n += 1 + sovResults(uint64(m.GasAllocated))
+	}
+	if m.GasPayment != 0 {
+		n += 1 + sovResults(uint64(m.GasPayment))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovResults(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -207,6 +438,271 @@ func (m *ResultSet) Unmarshal(dAtA []byte) error {
 			m.Results = append(m.Results, make([]byte, postIndex-iNdEx))
 			copy(m.Results[len(m.Results)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Log = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredFee == nil {
+				m.RequiredFee = &coin.Coin{}
+			}
+			if err := m.RequiredFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAllocated", wireType)
+			}
+			m.GasAllocated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasAllocated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+			}
+			m.GasPayment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPayment |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipResults(dAtA[iNdEx:])
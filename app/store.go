@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -199,17 +200,24 @@ Query gets data from the app store.
 A query request has the following elements:
 * Path - the type of query
 * Data - what to query, interpreted based on Path
-* Height - the block height to query (if 0 most recent)
-* Prove - if true, also return a proof
+* Height - the block height to query (if 0 most recent). Querying a
+  height that was already pruned from the store returns an error.
+* Prove - if true, also return a proof. Proofs are supported only for key
+  queries and contain one IAVL proof operation per returned key, as well
+  as absence proofs for all keys that were looked up but not found. Index
+  queries cannot be proven, because the index entry that references the
+  returned models is not part of the proof.
 
 Path may be "/", "/<bucket>", or "/<bucket>/<index>"
-It may be followed by "?prefix" to make a prefix query.
-Soon we will support "?range" for powerful range queries
+It may be followed by "?prefix" to make a prefix query,
+or by "?range" to make a range query.
 
 Key and Value in Results are always serialized ResultSet
 objects, able to support 0 to N values. They must be the
 same size. This makes things a little more difficult for
 simple queries, but provides a consistent interface.
+If the handler returned a partial result, the Key ResultSet
+contains a cursor that continues the query.
 */
 func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 
@@ -223,32 +231,55 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return
 	}
 
-	// TODO: support historical queries by getting old read-only
-	// height := reqQuery.Height
-	// if height == 0 {
-	// 	withProof := s.CommittedHeight() - 1
-	// 	if tree.Tree.VersionExists(uint64(withProof)) {
-	// 		height = withProof
-	// 	} else {
-	// 		height = s.CommittedHeight()
-	// 	}
-	// }
-	info, err := s.store.CommitInfo()
-	if err != nil {
-		return queryError(err)
+	var db weave.ReadOnlyKVStore
+	if reqQuery.Height == 0 {
+		info, err := s.store.CommitInfo()
+		if err != nil {
+			return queryError(err)
+		}
+		resQuery.Height = info.Version
+		db = s.store.committed.CacheWrap()
+	} else {
+		// historical query, read from the state committed at given height
+		ro, err := s.store.committed.ReadOnlyAtVersion(reqQuery.Height)
+		if err != nil {
+			return queryError(err)
+		}
+		resQuery.Height = reqQuery.Height
+		db = ro
+	}
+
+	var reads *readRecorder
+	if reqQuery.Prove {
+		if mod != weave.KeyQueryMod {
+			return queryError(errors.Wrap(errors.ErrInput, "proofs are supported only for key queries"))
+		}
+		if isIndexPath(path) {
+			return queryError(errors.Wrap(errors.ErrInput, "proofs are not supported for index queries"))
+		}
+		reads = &readRecorder{ReadOnlyKVStore: db}
+		db = reads
 	}
-	resQuery.Height = info.Version
-	// TODO: better version handling!
-	db := s.store.committed.CacheWrap()
 
 	// make the query
-	models, err := qh.Query(db, mod, reqQuery.Data)
+	var (
+		models []weave.Model
+		cursor []byte
+		err    error
+	)
+	if cqh, ok := qh.(weave.CursorQueryHandler); ok {
+		models, cursor, err = cqh.QueryWithCursor(db, mod, reqQuery.Data)
+	} else {
+		models, err = qh.Query(db, mod, reqQuery.Data)
+	}
 	if err != nil {
 		return queryError(err)
 	}
 
 	// set the info as ResultSets....
-	resQuery.Key, err = ResultsFromKeys(models).Marshal()
+	keys := ResultsFromKeys(models)
+	keys.Cursor = cursor
+	resQuery.Key, err = keys.Marshal()
 	if err != nil {
 		return queryError(err)
 	}
@@ -257,19 +288,73 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return queryError(err)
 	}
 
-	// TODO: support proofs given this info....
-	// if reqQuery.Prove {
-	//  value, proof, err := tree.GetVersionedWithProof(key, height)
-	//  if err != nil {
-	//      resQuery.Log = err.Error()
-	//      break
-	//  }
-	//  resQuery.Value = value
-	//  resQuery.Proof = proof.Bytes()
+	if reqQuery.Prove {
+		resQuery.Proof, err = s.queryProof(resQuery.Height, models, reads.absent)
+		if err != nil {
+			return queryError(err)
+		}
+	}
 
 	return resQuery
 }
 
+// queryProof builds a merkle proof for the result of a query made at given
+// height. Each returned model is proven to exist and each key that was read
+// but not found is proven to be absent.
+func (s *StoreApp) queryProof(height int64, models []weave.Model, missing [][]byte) (*merkle.Proof, error) {
+	keys := make([][]byte, 0, len(models)+len(missing))
+	for _, m := range models {
+		keys = append(keys, m.Key)
+	}
+	keys = append(keys, missing...)
+
+	var proof merkle.Proof
+	proven := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if proven[string(key)] {
+			continue
+		}
+		proven[string(key)] = true
+		_, op, err := s.store.committed.GetVersionedWithProof(key, height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "proof for key %X", key)
+		}
+		proof.Ops = append(proof.Ops, op)
+	}
+	return &proof, nil
+}
+
+// readRecorder wraps a ReadOnlyKVStore and remembers all keys that were
+// requested but do not exist, so that their absence can be proven.
+type readRecorder struct {
+	weave.ReadOnlyKVStore
+	absent [][]byte
+}
+
+// Get records the key if no value is found
+func (r *readRecorder) Get(key []byte) ([]byte, error) {
+	val, err := r.ReadOnlyKVStore.Get(key)
+	if err == nil && val == nil {
+		r.absent = append(r.absent, key)
+	}
+	return val, err
+}
+
+// Has records the key if it does not exist
+func (r *readRecorder) Has(key []byte) (bool, error) {
+	ok, err := r.ReadOnlyKVStore.Has(key)
+	if err == nil && !ok {
+		r.absent = append(r.absent, key)
+	}
+	return ok, err
+}
+
+// isIndexPath returns true if given query path is in the "/<bucket>/<index>"
+// format.
+func isIndexPath(path string) bool {
+	return strings.Contains(strings.Trim(path, "/"), "/")
+}
+
 // splitPath splits out the real path along with the query
 // modifier (everything after the ?)
 func splitPath(path string) (string, string) {
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/weavetest/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -55,3 +56,104 @@ func TestAddValChange(t *testing.T) {
 		assert.Equal(t, diff, weave.ValidatorUpdatesFromABCI(res.ValidatorUpdates).ValidatorUpdates)
 	})
 }
+
+func TestHistoricalQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/raw", rawQueryHandler{})
+	kv := iavl.MockCommitStore()
+	app := NewStoreApp("dummy", kv, qr, context.Background())
+
+	key := []byte("counter")
+	for i := byte(1); i <= 3; i++ {
+		assert.Nil(t, app.DeliverStore().Set(key, []byte{i}))
+		app.Commit()
+	}
+
+	cases := map[string]struct {
+		height     int64
+		wantHeight int64
+		wantValue  []byte
+		wantCode   uint32
+	}{
+		"latest": {
+			height:     0,
+			wantHeight: 3,
+			wantValue:  []byte{3},
+		},
+		"first version": {
+			height:     1,
+			wantHeight: 1,
+			wantValue:  []byte{1},
+		},
+		"second version": {
+			height:     2,
+			wantHeight: 2,
+			wantValue:  []byte{2},
+		},
+		"not committed yet": {
+			height:   4,
+			wantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{Path: "/raw", Data: key, Height: tc.height})
+			assert.Equal(t, tc.wantCode, res.Code)
+			if tc.wantCode != 0 {
+				return
+			}
+			assert.Equal(t, tc.wantHeight, res.Height)
+			var values ResultSet
+			assert.Nil(t, values.Unmarshal(res.Value))
+			assert.Equal(t, [][]byte{tc.wantValue}, values.Results)
+		})
+	}
+}
+
+func TestQueryProof(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/raw", rawQueryHandler{})
+	qr.Register("/raw/index", rawQueryHandler{})
+	kv := iavl.MockCommitStore()
+	app := NewStoreApp("dummy", kv, qr, context.Background())
+
+	assert.Nil(t, app.DeliverStore().Set([]byte("counter"), []byte{1}))
+	app.Commit()
+
+	cases := map[string]struct {
+		path      string
+		wantCode  uint32
+		wantProof bool
+	}{
+		"key query": {
+			path:      "/raw",
+			wantProof: true,
+		},
+		"prefix query": {
+			path:     "/raw?" + weave.PrefixQueryMod,
+			wantCode: errors.ErrInput.ABCICode(),
+		},
+		"index query": {
+			path:     "/raw/index",
+			wantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{Path: tc.path, Data: []byte("counter"), Prove: true})
+			assert.Equal(t, tc.wantCode, res.Code)
+			assert.Equal(t, tc.wantProof, res.Proof != nil)
+		})
+	}
+}
+
+// rawQueryHandler returns the value stored under the exact key.
+type rawQueryHandler struct{}
+
+func (rawQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	val, err := db.Get(data)
+	if err != nil {
+		return nil, err
+	}
+	return []weave.Model{weave.Pair(data, val)}, nil
+}
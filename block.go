@@ -0,0 +1,23 @@
+package weave
+
+// EndBlocker is an interface used to execute logic at the end of every block,
+// after all transactions were delivered.
+type EndBlocker interface {
+	// EndBlock is a method called at the end of the block with the
+	// summary of that block.
+	//
+	// Because the end of the block does not allow for an error response
+	// this method does not return one as well. It is the implementation
+	// responsibility to handle all error situations. Same as with the
+	// Ticker, an instance specific error (ie database issues) should
+	// terminate the process (ie panic).
+	EndBlock(ctx Context, store CacheableKVStore, summary BlockSummary)
+}
+
+// BlockSummary contains information about the execution of all transactions
+// delivered in a single block.
+type BlockSummary struct {
+	// GasUsed is the total amount of gas consumed by all delivered
+	// transactions.
+	GasUsed int64
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// proofRuntime decodes all proof operations that can be returned by a weave
+// application.
+var proofRuntime = newProofRuntime()
+
+func newProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(iavl.ProofOpIAVLValue, iavl.IAVLValueOpDecoder)
+	prt.RegisterOpDecoder(iavl.ProofOpIAVLAbsence, iavl.IAVLAbsenceOpDecoder)
+	return prt
+}
+
+// VerifyResultSet checks that the result of a key query, returned as a key
+// and a value ResultSet, is proven by given merkle proof to be part of the
+// state described by the app hash.
+//
+// Key is the full store key that was requested. The result must either
+// contain only that key, covered by an existence proof, or be empty, in
+// which case the proof must prove the absence of that key. Query for height
+// H must be verified against the app hash from the header of the block H+1.
+func VerifyResultSet(key []byte, keys, values *app.ResultSet, proof *merkle.Proof, appHash []byte) error {
+	if len(key) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "key")
+	}
+	if proof == nil || len(proof.Ops) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "proof")
+	}
+	if len(appHash) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "app hash")
+	}
+	models, err := app.JoinResults(keys, values)
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	switch n := len(models); {
+	case n > 1:
+		return errors.Wrapf(errors.ErrInput, "key query returned %d results", n)
+	case n == 1 && !bytes.Equal(models[0].Key, key):
+		return errors.Wrapf(errors.ErrInput, "result for a key %X that was not requested", models[0].Key)
+	}
+
+	if n := len(proof.Ops); n != 1 {
+		return errors.Wrapf(errors.ErrInput, "expected one proof operation, got %d", n)
+	}
+	pop := proof.Ops[0]
+	if !bytes.Equal(pop.Key, key) {
+		return errors.Wrapf(errors.ErrInput, "proof for a key %X that was not requested", pop.Key)
+	}
+	op, err := proofRuntime.Decode(pop)
+	if err != nil {
+		return errors.Wrapf(errors.ErrInput, "cannot decode proof: %s", err)
+	}
+
+	var args [][]byte
+	switch pop.Type {
+	case iavl.ProofOpIAVLValue:
+		if len(models) == 0 {
+			return errors.Wrapf(errors.ErrInput, "existence proof for a key %X that is not in the result", key)
+		}
+		args = [][]byte{models[0].Value}
+	case iavl.ProofOpIAVLAbsence:
+		if len(models) != 0 {
+			return errors.Wrapf(errors.ErrInput, "absence proof for a key %X that is in the result", key)
+		}
+	default:
+		return errors.Wrapf(errors.ErrInput, "unsupported proof type %q", pop.Type)
+	}
+
+	root, err := op.Run(args)
+	if err != nil {
+		return errors.Wrapf(errors.ErrState, "invalid proof for key %X: %s", key, err)
+	}
+	if len(root) != 1 || !bytes.Equal(root[0], appHash) {
+		return errors.Wrapf(errors.ErrState, "proof for key %X does not match the app hash", key)
+	}
+	return nil
+}
+
+// VerifyQueryResponse checks that the result of an ABCI key query for given
+// store key, made with the Prove flag, is proven against given app hash. See
+// VerifyResultSet.
+func VerifyQueryResponse(key []byte, res ResponseQuery, appHash []byte) error {
+	var keys, values app.ResultSet
+	if err := keys.Unmarshal(res.Key); err != nil {
+		return errors.Wrap(errors.ErrInput, "cannot decode keys")
+	}
+	if err := values.Unmarshal(res.Value); err != nil {
+		return errors.Wrap(errors.ErrInput, "cannot decode values")
+	}
+	return VerifyResultSet(key, &keys, &values, res.Proof, appHash)
+}
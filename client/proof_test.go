@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestVerifyQueryResponse(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	orm.RegisterQuery(qr)
+	kv := iavl.MockCommitStore()
+	store := app.NewStoreApp("proofs", kv, qr, context.Background())
+
+	assert.Nil(t, store.DeliverStore().Set([]byte("alice"), []byte("100")))
+	assert.Nil(t, store.DeliverStore().Set([]byte("bob"), []byte("200")))
+	store.Commit()
+	info, err := kv.LatestVersion()
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		key     string
+		verify  string
+		appHash []byte
+		tamper  func(*ResponseQuery)
+		wantErr *errors.Error
+	}{
+		"existing key": {
+			key:     "alice",
+			appHash: info.Hash,
+		},
+		"missing key": {
+			key:     "carol",
+			appHash: info.Hash,
+		},
+		"wrong app hash": {
+			key:     "alice",
+			appHash: []byte("a hash that does not match anything"),
+			wantErr: errors.ErrState,
+		},
+		"modified value": {
+			key:     "alice",
+			appHash: info.Hash,
+			tamper: func(res *ResponseQuery) {
+				res.Value, _ = app.ResultsFromValues([]weave.Model{weave.Pair(nil, []byte("999"))}).Marshal()
+			},
+			wantErr: errors.ErrState,
+		},
+		"hidden value": {
+			key:     "alice",
+			appHash: info.Hash,
+			tamper: func(res *ResponseQuery) {
+				res.Key, _ = app.ResultsFromKeys(nil).Marshal()
+				res.Value, _ = app.ResultsFromValues(nil).Marshal()
+			},
+			wantErr: errors.ErrInput,
+		},
+		"proof for another key": {
+			key:     "alice",
+			verify:  "bob",
+			appHash: info.Hash,
+			wantErr: errors.ErrInput,
+		},
+		"absence proof for another key": {
+			key:     "carol",
+			verify:  "bob",
+			appHash: info.Hash,
+			wantErr: errors.ErrInput,
+		},
+		"missing proof": {
+			key:     "alice",
+			appHash: info.Hash,
+			tamper: func(res *ResponseQuery) {
+				res.Proof = nil
+			},
+			wantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := store.Query(RequestQuery{Path: "/", Data: []byte(tc.key), Prove: true})
+			assert.Equal(t, uint32(0), res.Code)
+			if tc.tamper != nil {
+				tc.tamper(&res)
+			}
+			verify := tc.verify
+			if verify == "" {
+				verify = tc.key
+			}
+			err := VerifyQueryResponse([]byte(verify), res, tc.appHash)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
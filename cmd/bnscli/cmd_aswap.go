@@ -0,0 +1,129 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/x/aswap"
+)
+
+func cmdCreateSwap(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for creating an atomic swap. Funds are locked until they
+are released to the destination with the preimage or, after the timeout,
+returned to the source.
+
+Use a hash algorithm other than sha256 to swap with chains that use a
+different hash function, for example keccak256 for Ethereum HTLC contracts or
+hash160 for Bitcoin scripts. Those algorithms require the aswap package schema
+version 2.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		srcFl       = flAddress(fl, "src", "", "A source account address that the funds are locked from.")
+		dstFl       = flAddress(fl, "dst", "", "A destination account address that the funds are released to.")
+		amountFl    = flCoin(fl, "amount", "1 IOV", "An amount that is to be locked in the swap.")
+		timeoutFl   = flTime(fl, "timeout", inOneDay, "Timeout as 'YYYY-MM-DD HH:MM' in UTC. After that time the funds are returned to the source. If not provided, one day from now is used.")
+		memoFl      = fl.String("memo", "", "A short message attached to the swap.")
+		hashFl      = flHex(fl, "hash", "", "Hex encoded hash of the preimage. Required.")
+		algorithmFl = fl.String("hash-algorithm", "sha256", fmt.Sprintf("Hash function used to compute the preimage hash. One of %s.", strings.Join(hashAlgorithmNames(), ", ")))
+	)
+	fl.Parse(args)
+
+	if len(*hashFl) == 0 {
+		flagDie("preimage hash is required")
+	}
+	algorithm, ok := hashAlgorithms[*algorithmFl]
+	if !ok {
+		flagDie("unknown hash algorithm %q", *algorithmFl)
+	}
+	// Only sha256 is supported by the schema version 1.
+	var schema uint32 = 1
+	if algorithm != aswap.HashAlgorithm_SHA256 {
+		schema = 2
+	}
+
+	msg := aswap.CreateMsg{
+		Metadata:      &weave.Metadata{Schema: schema},
+		Source:        *srcFl,
+		Destination:   *dstFl,
+		PreimageHash:  *hashFl,
+		HashAlgorithm: algorithm,
+		Amount:        []*coin.Coin{amountFl},
+		Timeout:       timeoutFl.UnixTime(),
+		Memo:          *memoFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AswapCreateMsg{
+			AswapCreateMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReleaseSwap(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for releasing funds from given atomic swap to its
+destination. The preimage is verified using the hash algorithm of the swap.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		swapFl     = flSeq(fl, "swap", "", "An ID of a swap that is to be released.")
+		preimageFl = flHex(fl, "preimage", "", "Hex encoded preimage of the swap hash. Required.")
+	)
+	fl.Parse(args)
+
+	if len(*preimageFl) == 0 {
+		flagDie("preimage is required")
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_AswapReleaseMsg{
+			AswapReleaseMsg: &aswap.ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   *swapFl,
+				Preimage: *preimageFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+// hashAlgorithms maps command line names of the hash algorithms to their
+// values.
+var hashAlgorithms = map[string]aswap.HashAlgorithm{
+	"sha256":    aswap.HashAlgorithm_SHA256,
+	"keccak256": aswap.HashAlgorithm_Keccak256,
+	"hash160":   aswap.HashAlgorithm_Hash160,
+}
+
+func hashAlgorithmNames() []string {
+	names := make([]string, 0, len(hashAlgorithms))
+	for name := range hashAlgorithms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func inOneDay() time.Time {
+	return time.Now().Add(24 * time.Hour)
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/aswap"
+)
+
+func TestCmdCreateSwapHappyPath(t *testing.T) {
+	cases := map[string]struct {
+		Args       []string
+		WantSchema uint32
+		WantAlgo   aswap.HashAlgorithm
+	}{
+		"default sha256 hash": {
+			Args: []string{
+				"-hash", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+			},
+			WantSchema: 1,
+			WantAlgo:   aswap.HashAlgorithm_SHA256,
+		},
+		"keccak256 hash": {
+			Args: []string{
+				"-hash", "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+				"-hash-algorithm", "keccak256",
+			},
+			WantSchema: 2,
+			WantAlgo:   aswap.HashAlgorithm_Keccak256,
+		},
+		"hash160 hash": {
+			Args: []string{
+				"-hash", "bb1be98c142444d7a56aa3981c3942a978e4dc33",
+				"-hash-algorithm", "hash160",
+			},
+			WantSchema: 2,
+			WantAlgo:   aswap.HashAlgorithm_Hash160,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			args := append([]string{
+				"-src", "seq:test/bnscli/1",
+				"-dst", "seq:test/bnscli/2",
+				"-amount", "4 IOV",
+				"-timeout", "2030-01-01 12:00",
+			}, tc.Args...)
+			var output bytes.Buffer
+			if err := cmdCreateSwap(nil, &output, args); err != nil {
+				t.Fatalf("cannot create a transaction: %s", err)
+			}
+
+			tx, _, err := readTx(&output)
+			if err != nil {
+				t.Fatalf("cannot unmarshal created transaction: %s", err)
+			}
+			txmsg, err := tx.GetMsg()
+			if err != nil {
+				t.Fatalf("cannot get transaction message: %s", err)
+			}
+			msg := txmsg.(*aswap.CreateMsg)
+
+			assert.Equal(t, tc.WantSchema, msg.Metadata.Schema)
+			assert.Equal(t, tc.WantAlgo, msg.HashAlgorithm)
+			assert.Equal(t, tc.Args[1], hex.EncodeToString(msg.PreimageHash))
+		})
+	}
+}
+
+func TestCmdReleaseSwapHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-swap", "3",
+		"-preimage", "6162630000000000000000000000000000000000000000000000000000000000",
+	}
+	if err := cmdReleaseSwap(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*aswap.ReleaseMsg)
+
+	assert.Equal(t, sequenceID(3), msg.SwapID)
+	assert.Equal(t, args[3], hex.EncodeToString(msg.Preimage))
+}
@@ -9,9 +9,12 @@ import (
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gas"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -143,6 +146,48 @@ original transactions (ie signatures) are being dropped.
 					DistributionResetMsg: msg,
 				},
 			})
+		case *msgfee.SetMsgFeeMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{
+					MsgfeeSetMsgFeeMsg: msg,
+				},
+			})
+		case *msgfee.RemoveMsgFeeMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{
+					MsgfeeRemoveMsgFeeMsg: msg,
+				},
+			})
+		case *cron.CancelTaskMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CronCancelTaskMsg{
+					CronCancelTaskMsg: msg,
+				},
+			})
+		case *msgfee.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{
+					MsgfeeUpdateConfigurationMsg: msg,
+				},
+			})
+		case *cron.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CronUpdateConfigurationMsg{
+					CronUpdateConfigurationMsg: msg,
+				},
+			})
+		case *distribution.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg{
+					DistributionUpdateConfigurationMsg: msg,
+				},
+			})
+		case *gas.UpdateConfigurationMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_GasUpdateConfigurationMsg{
+					GasUpdateConfigurationMsg: msg,
+				},
+			})
 
 		case nil:
 			return errors.New("transaction without a message")
@@ -182,6 +227,13 @@ username.ChangeTokenTargetsMsg username_change_token_targets_msg = 63;
 distribution.CreateMsg distribution_create_msg = 66;
 distribution.DistributeMsg distribution_msg = 67;
 distribution.ResetMsg distribution_reset_msg = 68;
+msgfee.SetMsgFeeMsg msgfee_set_msg_fee_msg = 80;
+msgfee.RemoveMsgFeeMsg msgfee_remove_msg_fee_msg = 81;
+cron.CancelTaskMsg cron_cancel_task_msg = 82;
+msgfee.UpdateConfigurationMsg msgfee_update_configuration_msg = 83;
+cron.UpdateConfigurationMsg cron_update_configuration_msg = 84;
+distribution.UpdateConfigurationMsg distribution_update_configuration_msg = 85;
+gas.UpdateConfigurationMsg gas_update_configuration_msg = 86;
 "
 
 while read -r m; do
@@ -8,7 +8,6 @@ import (
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
-	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/x/cash"
 )
 
@@ -56,7 +55,7 @@ already has a fee set, overwrite it with a new value.
 	}
 	var (
 		payerFl  = flHex(fl, "payer", "", "Optional address of a payer. If not provided the main signer will be used.")
-		amountFl = flCoin(fl, "amount", "", "Fee value that should be attached to the transaction. If not provided, default minimal fee is used.")
+		amountFl = flCoin(fl, "amount", "", "Fee value that should be attached to the transaction. If not provided, the fee is estimated as done by the estimate-fee command.")
 		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 	)
@@ -79,27 +78,11 @@ already has a fee set, overwrite it with a new value.
 	}
 
 	if coin.IsEmpty(amountFl) {
-		msg, err := tx.GetMsg()
+		estimate, err := feeEstimate(*tmAddrFl, tx)
 		if err != nil {
-			return fmt.Errorf("cannot extract message from transaction: %s", err)
+			return fmt.Errorf("cannot estimate transaction fee: %s", err)
 		}
-
-		fee, err := msgfeeConf(*tmAddrFl, msg.Path())
-		if err != nil {
-			return fmt.Errorf("cannot fetch %T message fee information: %s", msg, err)
-		}
-
-		// Custom fee value is more important than global minimal fee setting.
-		if !coin.IsEmpty(fee) {
-			amountFl = fee
-		} else {
-			conf, err := cashGconf(*tmAddrFl)
-			if err != nil {
-				return fmt.Errorf("cannot fetch minimal fee configuration: %s", err)
-			}
-			amountFl = &conf.MinimalFee
-		}
-
+		amountFl = &estimate.Fee
 	}
 	tx.Fees = &cash.FeeInfo{
 		Payer: payer,
@@ -109,12 +92,3 @@ already has a fee set, overwrite it with a new value.
 	_, err = writeTx(output, tx)
 	return err
 }
-
-func cashGconf(nodeUrl string) (*cash.Configuration, error) {
-	store := tendermintStore(nodeUrl)
-	var conf cash.Configuration
-	if err := gconf.Load(store, "cash", &conf); err != nil {
-		return nil, err
-	}
-	return &conf, nil
-}
@@ -15,6 +15,7 @@ import (
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/msgfee"
@@ -128,16 +129,26 @@ func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 			},
 			WantFee: coin.NewCoinp(17, 0, "IOV"),
 		},
-		"custom message fee is more important than global setting": {
+		"message fee higher than minimal fee": {
 			Conf: cash.Configuration{
 				Metadata:   &weave.Metadata{Schema: 1},
-				MinimalFee: coin.NewCoin(123, 0, "IOV"),
+				MinimalFee: coin.NewCoin(3, 0, "IOV"),
 			},
 			Fees: map[string]coin.Coin{
 				sendMsg.Path(): coin.NewCoin(11, 0, "IOV"),
 			},
 			WantFee: coin.NewCoinp(11, 0, "IOV"),
 		},
+		"minimal fee is required even if message fee is lower": {
+			Conf: cash.Configuration{
+				Metadata:   &weave.Metadata{Schema: 1},
+				MinimalFee: coin.NewCoin(123, 0, "IOV"),
+			},
+			Fees: map[string]coin.Coin{
+				sendMsg.Path(): coin.NewCoin(11, 0, "IOV"),
+			},
+			WantFee: coin.NewCoinp(123, 0, "IOV"),
+		},
 	}
 
 	for testName, tc := range cases {
@@ -147,7 +158,7 @@ func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 				t.Fatalf("cannot serialize transaction: %s", err)
 			}
 
-			tm := newCashConfTendermintServer(t, tc.Conf, tc.Fees)
+			tm := newFeeEstimateTendermintServer(t, tc.Conf, tc.Fees)
 			defer tm.Close()
 
 			var output bytes.Buffer
@@ -177,15 +188,31 @@ type abciQueryRequest struct {
 	} `json:"params"`
 }
 
-// newCashConfTendermintServer returns an HTTP server that can respond to an
-// HTTP json-rpc request with given configuration.
-func newCashConfTendermintServer(
+// newFeeEstimateTendermintServer returns an HTTP server that can respond to
+// an HTTP json-rpc fee estimate request. Estimation is done using given
+// configuration and message fees.
+func newFeeEstimateTendermintServer(
 	t *testing.T,
 	conf cash.Configuration,
 	msgfees map[string]coin.Coin,
 ) *httptest.Server {
 	t.Helper()
 
+	db := store.MemStore()
+	migration.MustInitPkg(db, "msgfee")
+	rawConf, err := conf.Marshal()
+	assert.Nil(t, err)
+	assert.Nil(t, db.Set([]byte("_c:cash"), rawConf))
+	for path, fee := range msgfees {
+		_, err := msgfee.NewMsgFeeBucket().Create(db, &msgfee.MsgFee{
+			Metadata: &weave.Metadata{Schema: 1},
+			MsgPath:  path,
+			Fee:      fee,
+		})
+		assert.Nil(t, err)
+	}
+	query := msgfee.NewFeeEstimateQuery(coin.Coin{}, bnsd.TxDecoder)
+
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/" {
 			t.Fatalf("unexpected tendermint request: %s", r.URL)
@@ -197,48 +224,16 @@ func newCashConfTendermintServer(
 		err := decoder.Decode(&req)
 		assert.Nil(t, err)
 		assert.Equal(t, "abci_query", req.Method)
-		assert.Equal(t, "/", req.Params.Path)
+		assert.Equal(t, "/estimatefee", req.Params.Path)
 
 		raw, err := hex.DecodeString(req.Params.Data)
 		assert.Nil(t, err)
 
-		if bytes.Equal(raw, []byte("_c:cash")) {
-			io.WriteString(w, tmResponse(t, raw, &conf))
-			return
-		}
-
-		if bytes.HasPrefix(raw, []byte("schema:msgfee")) {
-			pkg := "schema:msgfee"
-			cnt := raw[len(pkg):]
-			if bytes.Equal(cnt, []byte{0, 0, 0, 1}) {
-				schema := &migration.Schema{
-					Metadata: &weave.Metadata{Schema: 1},
-					Pkg:      pkg,
-					Version:  1,
-				}
-				io.WriteString(w, tmResponse(t, raw, schema))
-			} else {
-				io.WriteString(w, tmEmptyResponse(t))
-			}
-			return
-		}
-
-		if bytes.HasPrefix(raw, []byte("msgfee:")) {
-			path := string(raw[len("msgfee:"):])
-			fee, ok := msgfees[path]
-			if !ok {
-				io.WriteString(w, tmEmptyResponse(t))
-				return
-			}
-			io.WriteString(w, tmResponse(t, raw, &msgfee.MsgFee{
-				Metadata: &weave.Metadata{Schema: 1},
-				MsgPath:  path,
-				Fee:      fee,
-			}))
-			return
-		}
-
-		t.Fatalf("unexpected tendermint request: %X", raw)
+		tx, err := bnsd.TxDecoder(raw)
+		assert.Nil(t, err)
+		estimate, err := query.Estimate(db, tx)
+		assert.Nil(t, err)
+		io.WriteString(w, tmResponse(t, nil, estimate))
 	}))
 }
 
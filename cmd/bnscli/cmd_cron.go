@@ -0,0 +1,113 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/client"
+	"github.com/iov-one/weave/x/cron"
+)
+
+func cmdCronTasks(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+List tasks that are queued for execution, in order of their execution time.
+
+For each task print its ID, execution time, the path of the message that is
+executed, conditions that authenticate the execution and the ID of the task
+series if the task is recurring. If a task cannot be decoded, the reason is
+printed instead of the message path. Use the task ID with the cancel-cron-task
+command to cancel a task.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+		limitFl = fl.Uint("limit", 0, "Maximum number of returned tasks. Zero means the server default.")
+		afterFl = flHex(fl, "after", "", "Hex encoded cursor returned by a previous query. Only tasks after the cursor are returned.")
+	)
+	fl.Parse(args)
+
+	opts := weave.QueryOptions{
+		Limit: uint32(*limitFl),
+		After: *afterFl,
+	}
+	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+	resp, err := bnsClient.AbciQuery("/crontasks?"+weave.QueryMod(weave.PrefixQueryMod, opts), nil)
+	if err != nil {
+		return fmt.Errorf("failed to run query: %s", err)
+	}
+
+	fmt.Fprintln(output, "id\trun at\tmessage\tauth\tseries")
+	for i, m := range resp.Models {
+		var task cron.PendingTask
+		if err := task.Unmarshal(m.Value); err != nil {
+			return fmt.Errorf("failed to unmarshal task %d: %s", i, err)
+		}
+		auth := make([]string, len(task.Auth))
+		for i, c := range task.Auth {
+			auth[i] = c.String()
+		}
+		msgPath := task.MsgPath
+		if task.DecodeError != "" {
+			msgPath = fmt.Sprintf("(%s)", task.DecodeError)
+		}
+		fmt.Fprintf(output, "%x\t%s\t%s\t%s\t%x\n",
+			m.Key,
+			task.RunAt.Time().UTC().Format(time.RFC3339),
+			msgPath,
+			strings.Join(auth, ","),
+			task.SeriesID)
+	}
+	if len(resp.Cursor) != 0 {
+		fmt.Fprintf(os.Stderr, "\nMore results available. Use -after=%x to continue.\n", resp.Cursor)
+	}
+	return nil
+}
+
+func cmdCancelCronTask(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Create a transaction for cancelling a task that is queued for execution. If the
+task is recurring, the whole task series is cancelled.
+
+This transaction must be signed either by the cron configuration owner or by
+all conditions that authenticate the task execution. If the owner is an
+election rule, use the as-proposal command to submit the change via
+governance.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		idFl = flHex(fl, "id", "", "Hex encoded ID of the task, as printed by the cron-tasks command. Required.")
+	)
+	fl.Parse(args)
+
+	if len(*idFl) == 0 {
+		flagDie("task ID is required")
+	}
+
+	msg := cron.CancelTaskMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		TaskID:   *idFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CronCancelTaskMsg{
+			CronCancelTaskMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gov"
+)
+
+func TestCmdCronTasks(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	runAt := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	scheduler := cron.NewScheduler(bnsd.CronTaskMarshaler)
+	cond := weavetest.NewCondition()
+	returnID, err := scheduler.Schedule(db, runAt.Add(time.Hour), []weave.Condition{cond}, &escrow.ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: weavetest.SequenceID(1),
+	})
+	assert.Nil(t, err)
+	seriesID, err := scheduler.ScheduleRecurring(db, runAt, cron.Recurrence{Months: 1}, nil, &gov.TallyMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ProposalID: weavetest.SequenceID(2),
+	})
+	assert.Nil(t, err)
+	var series cron.TaskSeries
+	assert.Nil(t, cron.NewTaskSeriesBucket().One(db, seriesID, &series))
+
+	tm := newCronTasksTendermintServer(t, db)
+	defer tm.Close()
+
+	var output bytes.Buffer
+	if err := cmdCronTasks(nil, &output, []string{"-tm", tm.URL}); err != nil {
+		t.Fatalf("cannot list tasks: %s", err)
+	}
+	want := "id\trun at\tmessage\tauth\tseries\n" +
+		fmt.Sprintf("%x\t2019-06-01T12:00:00Z\tgov/tally\t\t%x\n", series.TaskID, seriesID) +
+		fmt.Sprintf("%x\t2019-06-01T13:00:00Z\tescrow/return\t%s\t\n", returnID, cond)
+	assertLines(t, output.String(), want)
+}
+
+// newCronTasksTendermintServer returns an HTTP server that can respond to an
+// HTTP json-rpc pending cron tasks request. Tasks are read from given
+// database.
+func newCronTasksTendermintServer(t *testing.T, db weave.ReadOnlyKVStore) *httptest.Server {
+	t.Helper()
+
+	query := cron.NewTaskQuery(bnsd.CronTaskMarshaler)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			t.Fatalf("unexpected tendermint request: %s", r.URL)
+		}
+
+		defer r.Body.Close()
+		var req abciQueryRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "abci_query", req.Method)
+		assert.Equal(t, "/crontasks?prefix", req.Params.Path)
+
+		raw, err := hex.DecodeString(req.Params.Data)
+		assert.Nil(t, err)
+		models, err := query.Query(db, weave.PrefixQueryMod, raw)
+		assert.Nil(t, err)
+
+		keySet, err := app.ResultsFromKeys(models).Marshal()
+		assert.Nil(t, err)
+		valSet, err := app.ResultsFromValues(models).Marshal()
+		assert.Nil(t, err)
+		io.WriteString(w, `{
+		  "jsonrpc": "2.0",
+		  "id": "",
+		  "result": {
+		    "response": {
+		      "key": "`+base64.StdEncoding.EncodeToString(keySet)+`",
+		      "value": "`+base64.StdEncoding.EncodeToString(valSet)+`"
+		    }
+		  }
+		}`)
+	}))
+}
+
+func TestCmdCancelCronTaskHappyPath(t *testing.T) {
+	taskID := "5f63726f6e7461736b3a72756e61743a15a3f5bc5e9ea000"
+
+	var output bytes.Buffer
+	args := []string{
+		"-id", taskID,
+	}
+	if err := cmdCancelCronTask(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*cron.CancelTaskMsg)
+
+	assert.Equal(t, taskID, hex.EncodeToString(msg.TaskID))
+}
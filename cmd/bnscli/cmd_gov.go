@@ -13,10 +13,13 @@ import (
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -175,6 +178,48 @@ transaction (ie signatures) are being dropped.
 						GovCreateTextResolutionMsg: m,
 					},
 				})
+			case *msgfee.SetMsgFeeMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{
+						MsgfeeSetMsgFeeMsg: m,
+					},
+				})
+			case *msgfee.RemoveMsgFeeMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{
+						MsgfeeRemoveMsgFeeMsg: m,
+					},
+				})
+			case *cron.CancelTaskMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CronCancelTaskMsg{
+						CronCancelTaskMsg: m,
+					},
+				})
+			case *msgfee.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{
+						MsgfeeUpdateConfigurationMsg: m,
+					},
+				})
+			case *cron.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg{
+						CronUpdateConfigurationMsg: m,
+					},
+				})
+			case *distribution.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg{
+						DistributionUpdateConfigurationMsg: m,
+					},
+				})
+			case *gas.UpdateConfigurationMsg:
+				messages = append(messages, bnsd.ExecuteProposalBatchMsg_Union{
+					Sum: &bnsd.ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg{
+						GasUpdateConfigurationMsg: m,
+					},
+				})
 			}
 		}
 		option.Option = &bnsd.ProposalOptions_ExecuteProposalBatchMsg{
@@ -222,6 +267,34 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_GovCreateTextResolutionMsg{
 			GovCreateTextResolutionMsg: msg,
 		}
+	case *msgfee.SetMsgFeeMsg:
+		option.Option = &bnsd.ProposalOptions_MsgfeeSetMsgFeeMsg{
+			MsgfeeSetMsgFeeMsg: msg,
+		}
+	case *msgfee.RemoveMsgFeeMsg:
+		option.Option = &bnsd.ProposalOptions_MsgfeeRemoveMsgFeeMsg{
+			MsgfeeRemoveMsgFeeMsg: msg,
+		}
+	case *cron.CancelTaskMsg:
+		option.Option = &bnsd.ProposalOptions_CronCancelTaskMsg{
+			CronCancelTaskMsg: msg,
+		}
+	case *msgfee.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_MsgfeeUpdateConfigurationMsg{
+			MsgfeeUpdateConfigurationMsg: msg,
+		}
+	case *cron.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_CronUpdateConfigurationMsg{
+			CronUpdateConfigurationMsg: msg,
+		}
+	case *distribution.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_DistributionUpdateConfigurationMsg{
+			DistributionUpdateConfigurationMsg: msg,
+		}
+	case *gas.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_GasUpdateConfigurationMsg{
+			GasUpdateConfigurationMsg: msg,
+		}
 	}
 
 	rawOption, err := option.Marshal()
@@ -1,16 +1,18 @@
 package main
 
 import (
-	"bytes"
-	"crypto/sha256"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
-	"io/ioutil"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/crypto/bech32"
 	"github.com/stellar/go/exp/crypto/derivation"
@@ -26,16 +28,27 @@ Read mnemonic and generate a new private key.
 
 When successful a new file with binary content containing private key is
 created. This command fails if the private key file already exists.
+
+When the name is provided, the private key is encrypted and stored in the
+keystore instead, so that it is never written to the disk unencrypted. This
+command fails if a key with the same name already exists in the keystore.
 `)
 		fl.PrintDefaults()
 	}
 	var (
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
-		pathFl = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		pathFl     = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		algoFl     = fl.String("algo", "ed25519", "Signature algorithm of the generated key. Either ed25519 or secp256k1.")
+		nameFl     = fl.String("name", "", "Name of the key in the keystore. If provided, the key is stored in the keystore instead of the private key file.")
+		keystoreFl = keystoreFlag(fl)
 	)
 	fl.Parse(args)
 
+	if *nameFl != "" {
+		return keygenKeystore(input, *keystoreFl, *nameFl, *pathFl, *algoFl)
+	}
+
 	if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
 		// Do not allow to overwrite already existing private key. User
 		// must manually delete it first to ensure we do not delete
@@ -48,7 +61,7 @@ created. This command fails if the private key file already exists.
 		return fmt.Errorf("cannot read mnemonic: %s", err)
 	}
 
-	priv, err := keygen(string(mnemonic), *pathFl)
+	priv, err := keygen(string(mnemonic), *pathFl, *algoFl)
 	if err != nil {
 		return fmt.Errorf("cannot generate key: %s", err)
 	}
@@ -59,7 +72,7 @@ created. This command fails if the private key file already exists.
 	}
 	defer fd.Close()
 
-	if _, err := fd.Write(priv); err != nil {
+	if _, err := fd.Write(rawPrivateKey(priv)); err != nil {
 		return fmt.Errorf("cannot write private key: %s", err)
 	}
 	if err := fd.Close(); err != nil {
@@ -68,9 +81,40 @@ created. This command fails if the private key file already exists.
 	return nil
 }
 
+// keygenKeystore reads mnemonic, generates a private key and stores it
+// encrypted in the keystore under given name.
+func keygenKeystore(input io.Reader, keystorePath, name, derivationPath, algo string) error {
+	ks, err := client.OpenKeystore(keystorePath)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := ks.Key(name); err == nil {
+		return fmt.Errorf("key %q already exists", name)
+	}
+
+	mnemonic, err := readInput(input)
+	if err != nil {
+		return fmt.Errorf("cannot read mnemonic: %s", err)
+	}
+	priv, err := keygen(string(mnemonic), derivationPath, algo)
+	if err != nil {
+		return fmt.Errorf("cannot generate key: %s", err)
+	}
+
+	passphrase, err := readNewPassphrase()
+	if err != nil {
+		return err
+	}
+	if err := ks.Add(name, priv, passphrase); err != nil {
+		return fmt.Errorf("cannot store key: %s", err)
+	}
+	return nil
+}
+
 // keygen returns a private key generated using given mnemonic and derivation
-// path.
-func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
+// path. Ed25519 keys are derived as described in SLIP-0010, secp256k1 keys
+// as described in BIP-32.
+func keygen(mnemonic, derivationPath, algo string) (*crypto.PrivateKey, error) {
 	if err := validateMnemonic(string(mnemonic)); err != nil {
 		return nil, fmt.Errorf("invalid mnemonic: %s", err)
 	}
@@ -78,16 +122,122 @@ func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
 	// We do not allow for passphrase.
 	seed := bip39.NewSeed(string(mnemonic), "")
 
-	key, err := derivation.DeriveForPath(derivationPath, seed)
+	path, err := parseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algo {
+	case "ed25519":
+		key, err := deriveEd25519(path, seed)
+		if err != nil {
+			return nil, fmt.Errorf("cannot deriviate ed25519 key from seed: %s", err)
+		}
+		priv := ed25519.NewKeyFromSeed(key)
+		return &crypto.PrivateKey{Priv: &crypto.PrivateKey_Ed25519{Ed25519: priv}}, nil
+	case "secp256k1":
+		priv, err := deriveSecp256k1(path, seed)
+		if err != nil {
+			return nil, fmt.Errorf("cannot deriviate secp256k1 key from seed: %s", err)
+		}
+		return crypto.PrivKeySecp256k1FromBytes(priv), nil
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %q", algo)
+	}
+}
+
+// hardenedOffset is added to the index of a hardened derivation path segment.
+const hardenedOffset = 0x80000000
+
+// parseDerivationPath parses a derivation path as described in BIP-32, for
+// example m/44'/234'/0'. Hardened segment indexes are returned with the
+// hardenedOffset added.
+func parseDerivationPath(derivationPath string) ([]uint32, error) {
+	segments := strings.Split(derivationPath, "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("invalid derivation path %q: must start with m", derivationPath)
+	}
+	path := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		var offset uint32
+		if strings.HasSuffix(segment, "'") {
+			segment = segment[:len(segment)-1]
+			offset = hardenedOffset
+		}
+		index, err := strconv.ParseUint(segment, 10, 31)
+		if err != nil {
+			return nil, fmt.Errorf("invalid derivation path %q: invalid segment %q", derivationPath, segment)
+		}
+		path = append(path, uint32(index)+offset)
+	}
+	return path, nil
+}
+
+// formatDerivationPath returns the text representation of a derivation path.
+func formatDerivationPath(path []uint32) string {
+	var b strings.Builder
+	b.WriteString("m")
+	for _, index := range path {
+		if index >= hardenedOffset {
+			fmt.Fprintf(&b, "/%d'", index-hardenedOffset)
+		} else {
+			fmt.Fprintf(&b, "/%d", index)
+		}
+	}
+	return b.String()
+}
+
+// deriveEd25519 returns an ed25519 private key seed derived from the BIP-39
+// seed as described in SLIP-0010. Only hardened derivation is supported by
+// ed25519.
+// https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func deriveEd25519(path []uint32, seed []byte) ([]byte, error) {
+	key, err := derivation.NewMasterKey(seed)
 	if err != nil {
-		return nil, fmt.Errorf("cannot deriviate master key from seed: %s", err)
+		return nil, fmt.Errorf("master key: %s", err)
 	}
+	for _, index := range path {
+		if index < hardenedOffset {
+			return nil, fmt.Errorf("ed25519 requires hardened derivation path, got %s", formatDerivationPath(path))
+		}
+		if key, err = key.Derive(index); err != nil {
+			return nil, fmt.Errorf("child key: %s", err)
+		}
+	}
+	return key.Key, nil
+}
 
-	_, priv, err := ed25519.GenerateKey(bytes.NewReader(key.Key))
+// deriveSecp256k1 returns a raw secp256k1 private key derived from the seed
+// as described in BIP-32.
+func deriveSecp256k1(path []uint32, seed []byte) ([]byte, error) {
+	key, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
 	if err != nil {
-		return nil, fmt.Errorf("cannot generate ed25519 private key: %s", err)
+		return nil, fmt.Errorf("master key: %s", err)
+	}
+	for _, index := range path {
+		if key, err = key.Child(index); err != nil {
+			return nil, fmt.Errorf("child key: %s", err)
+		}
+	}
+	priv, err := key.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("private key: %s", err)
+	}
+	raw := priv.Serialize()
+	// Serialize does not pad the key to its full length.
+	if len(raw) < 32 {
+		raw = append(make([]byte, 32-len(raw)), raw...)
+	}
+	return raw, nil
+}
+
+// rawPrivateKey returns the private key bytes as they are stored in the
+// private key file.
+func rawPrivateKey(key *crypto.PrivateKey) []byte {
+	if raw := key.GetSecp256K1(); raw != nil {
+		return raw
 	}
-	return priv, nil
+	return key.GetEd25519()
 }
 
 // isMnemonicValid returns true if given mnemonic string is valid. Whitespaces
@@ -120,6 +270,11 @@ func cmdKeyaddr(input io.Reader, output io.Writer, args []string) error {
 	fl.Usage = func() {
 		fmt.Fprint(flag.CommandLine.Output(), `
 Print out a hex-address associated with your private key.
+
+When a derivation path is provided, instead of loading the private key from a
+file, read mnemonic and print out addresses of the keys derived from it. This
+allows to recover addresses of several accounts created by other wallets
+using the same mnemonic.
 `)
 		fl.PrintDefaults()
 	}
@@ -127,40 +282,73 @@ Print out a hex-address associated with your private key.
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
 		bechPrefixFl = fl.String("bp", "iov", "Bech32 prefix.")
+		pathFl       = fl.String("path", "", "Derivation path as described in BIP-44. If provided, mnemonic is read from the input and the key file is ignored.")
+		algoFl       = fl.String("algo", "ed25519", "Signature algorithm of the derived key. Either ed25519 or secp256k1. Used together with -path.")
+		countFl      = fl.Uint("count", 1, "Number of accounts to derive. The last segment of the derivation path is incremented for each following account. Used together with -path.")
 	)
 	fl.Parse(args)
 
-	raw, err := ioutil.ReadFile(*keyPathFl)
-	if err != nil {
-		return fmt.Errorf("cannot read private key file: %s", err)
+	if *pathFl == "" {
+		key, err := decodePrivateKey(*keyPathFl)
+		if err != nil {
+			return fmt.Errorf("cannot load private key: %s", err)
+		}
+		return printAddress(output, *bechPrefixFl, key.PublicKey())
 	}
 
-	if len(raw) != ed25519.PrivateKeySize {
-		return fmt.Errorf("invalid private key length: %d", len(raw))
+	path, err := parseDerivationPath(*pathFl)
+	if err != nil {
+		return err
 	}
-
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{
-			Ed25519: raw,
-		},
+	if len(path) == 0 {
+		return errors.New("derivation path must contain at least one segment")
+	}
+	// Incrementing the last segment must not overflow into the hardened
+	// range or beyond the maximum index.
+	last := uint64(path[len(path)-1])
+	maxIndex := uint64(hardenedOffset - 1)
+	if last >= hardenedOffset {
+		maxIndex = math.MaxUint32
+	}
+	if *countFl > 0 && last+uint64(*countFl-1) > maxIndex {
+		return fmt.Errorf("cannot derive %d accounts starting with %s: last segment index overflow", *countFl, *pathFl)
+	}
+	mnemonic, err := readInput(input)
+	if err != nil {
+		return fmt.Errorf("cannot read mnemonic: %s", err)
+	}
+	for i := uint(0); i < *countFl; i++ {
+		if i != 0 {
+			path[len(path)-1]++
+			fmt.Fprintln(output)
+		}
+		p := formatDerivationPath(path)
+		key, err := keygen(string(mnemonic), p, *algoFl)
+		if err != nil {
+			return fmt.Errorf("cannot derive key for %s: %s", p, err)
+		}
+		fmt.Fprintf(output, "path\t%s\n", p)
+		if err := printAddress(output, *bechPrefixFl, key.PublicKey()); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	bech, err := toBech32(*bechPrefixFl, key.PublicKey().GetEd25519())
+func printAddress(output io.Writer, bechPrefix string, pubkey *crypto.PublicKey) error {
+	bech, err := toBech32(bechPrefix, pubkey)
 	if err != nil {
 		return fmt.Errorf("cannot generate bech32 address format: %s", err)
 	}
-
 	fmt.Fprintf(output, "bech32\t%s\n", bech)
-	fmt.Fprintf(output, "hex\t%s\n", key.PublicKey().Address())
+	fmt.Fprintf(output, "hex\t%s\n", pubkey.Address())
 	return nil
 }
 
 // toBech32 computes the bech32 address representation as described in
 // https://github.com/iov-one/iov-core/blob/8846fed17443766a9ad9c908c3d7fc9d205e02ef/docs/address-derivation-v1.md#deriving-addresses-from-keypairs
-func toBech32(prefix string, pubkey []byte) ([]byte, error) {
-	data := append([]byte("sigs/ed25519/"), pubkey...)
-	hash := sha256.Sum256(data)
-	bech, err := bech32.Encode(prefix, hash[:20])
+func toBech32(prefix string, pubkey *crypto.PublicKey) ([]byte, error) {
+	bech, err := bech32.Encode(prefix, pubkey.Address())
 	if err != nil {
 		return nil, fmt.Errorf("cannot compute bech32: %s", err)
 	}
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"reflect"
+	"strings"
 	"testing"
-
-	"golang.org/x/crypto/ed25519"
 )
 
 func TestKeygen(t *testing.T) {
@@ -21,11 +24,11 @@ func TestKeygen(t *testing.T) {
 
 	for path, bech := range cases {
 		t.Run(path, func(t *testing.T) {
-			priv, err := keygen(mnemonic, path)
+			priv, err := keygen(mnemonic, path, "ed25519")
 			if err != nil {
 				t.Fatalf("cannot generate key: %s", err)
 			}
-			b, err := toBech32("tiov", priv.Public().(ed25519.PublicKey))
+			b, err := toBech32("tiov", priv.PublicKey())
 			if err != nil {
 				t.Fatalf("cannot serialize to bech32: %s", err)
 			}
@@ -38,6 +41,212 @@ func TestKeygen(t *testing.T) {
 	}
 }
 
+func TestDeriveEd25519(t *testing.T) {
+	// Test vector 1 for ed25519 from SLIP-0010 specification
+	// https://github.com/satoshilabs/slips/blob/master/slip-0010.md#test-vector-1-for-ed25519
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	cases := map[string]string{
+		"m":                         "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"m/0'":                      "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		"m/0'/1'":                   "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		"m/0'/1'/2'":                "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+		"m/0'/1'/2'/2'":             "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662",
+		"m/0'/1'/2'/2'/1000000000'": "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793",
+	}
+
+	for path, want := range cases {
+		t.Run(path, func(t *testing.T) {
+			raw, err := deriveEd25519(mustParsePath(t, path), seed)
+			if err != nil {
+				t.Fatalf("cannot derive key: %s", err)
+			}
+			if got := hex.EncodeToString(raw); got != want {
+				t.Logf("want: %s", want)
+				t.Logf(" got: %s", got)
+				t.Fatal("unexpected private key")
+			}
+		})
+	}
+
+	if _, err := deriveEd25519(mustParsePath(t, "m/0'/1"), seed); err == nil {
+		t.Fatal("non hardened derivation accepted")
+	}
+}
+
+func TestParseDerivationPath(t *testing.T) {
+	cases := map[string]struct {
+		want    []uint32
+		wantErr bool
+	}{
+		"m":                  {want: []uint32{}},
+		"m/44'/234'/0'":      {want: []uint32{hardenedOffset + 44, hardenedOffset + 234, hardenedOffset}},
+		"m/44'/0/2147483647": {want: []uint32{hardenedOffset + 44, 0, 2147483647}},
+		"":                   {wantErr: true},
+		"44'/234'/0'":        {wantErr: true},
+		"m/":                 {wantErr: true},
+		"m/44''":             {wantErr: true},
+		"m/-1'":              {wantErr: true},
+		"m/2147483648":       {wantErr: true},
+		"m/44h":              {wantErr: true},
+	}
+
+	for path, tc := range cases {
+		t.Run(path, func(t *testing.T) {
+			got, err := parseDerivationPath(path)
+			if hasErr := err != nil; hasErr != tc.wantErr {
+				t.Fatalf("returned error value: %+v", err)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Fatalf("unexpected path: %v", got)
+			}
+			if s := formatDerivationPath(got); s != path {
+				t.Fatalf("path formatted as %q", s)
+			}
+		})
+	}
+}
+
+func TestKeyaddrDerivedAccounts(t *testing.T) {
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+	var output bytes.Buffer
+	args := []string{"-bp", "tiov", "-path", "m/44'/234'/2'", "-count", "3"}
+	if err := cmdKeyaddr(strings.NewReader(mnemonic), &output, args); err != nil {
+		t.Fatalf("cannot derive addresses: %s", err)
+	}
+
+	// Addresses are the same as in TestKeygen.
+	const want = `path	m/44'/234'/2'
+bech32	tiov18gwds8rx8cajav3m4lr5j98vlly9n8ms930z2l
+hex	3A1CD81C663E3B2EB23BAFC74914ECFFC8599F70
+
+path	m/44'/234'/3'
+bech32	tiov1casuhjhjcqlxhlcfpqak5uccpqyajzp0nj3639
+hex	C761CBCAF2C03E6BFF09083B6A73180809D9082F
+
+path	m/44'/234'/4'
+bech32	tiov16rjld9tw88yrcc954cvvtnern576daunnn8jmn
+hex	D0E5F6956E39C83C60B4AE18C5CF239D3DA6F793
+`
+	if got := output.String(); got != want {
+		t.Logf("want: %s", want)
+		t.Logf(" got: %s", got)
+		t.Fatal("unexpected addresses")
+	}
+}
+
+func TestKeyaddrCountOverflow(t *testing.T) {
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+	cases := map[string]struct {
+		path    string
+		count   string
+		wantErr bool
+	}{
+		"last hardened index": {
+			path:  "m/44'/234'/2147483646'",
+			count: "2",
+		},
+		"hardened index overflow": {
+			path:    "m/44'/234'/2147483646'",
+			count:   "3",
+			wantErr: true,
+		},
+		"last non hardened index": {
+			path:  "m/44'/0/2147483646",
+			count: "2",
+		},
+		"non hardened index becomes hardened": {
+			path:    "m/44'/0/2147483646",
+			count:   "3",
+			wantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			args := []string{"-path", tc.path, "-count", tc.count, "-algo", "secp256k1"}
+			err := cmdKeyaddr(strings.NewReader(mnemonic), ioutil.Discard, args)
+			if hasErr := err != nil; hasErr != tc.wantErr {
+				t.Fatalf("returned error value: %+v", err)
+			}
+		})
+	}
+}
+
+func TestDeriveSecp256k1(t *testing.T) {
+	// Test vector 1 from BIP-32 specification
+	// https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki#test-vector-1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	cases := map[string]string{
+		"m":           "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'":        "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"m/0'/1":      "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		"m/0'/1/2'":   "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		"m/0'/1/2'/2": "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+	}
+
+	for path, want := range cases {
+		t.Run(path, func(t *testing.T) {
+			raw, err := deriveSecp256k1(mustParsePath(t, path), seed)
+			if err != nil {
+				t.Fatalf("cannot derive key: %s", err)
+			}
+			if got := hex.EncodeToString(raw); got != want {
+				t.Logf("want: %s", want)
+				t.Logf(" got: %s", got)
+				t.Fatal("unexpected private key")
+			}
+		})
+	}
+}
+
+func TestKeygenSecp256k1(t *testing.T) {
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+	priv, err := keygen(mnemonic, "m/44'/234'/0'", "secp256k1")
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	if len(priv.GetSecp256K1()) != 32 {
+		t.Fatalf("unexpected private key: %v", priv)
+	}
+	// Key derivation is deterministic and depends on the path.
+	again, err := keygen(mnemonic, "m/44'/234'/0'", "secp256k1")
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	if !bytes.Equal(again.GetSecp256K1(), priv.GetSecp256K1()) {
+		t.Fatal("key derivation is not deterministic")
+	}
+	other, err := keygen(mnemonic, "m/44'/234'/1'", "secp256k1")
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	if bytes.Equal(other.GetSecp256K1(), priv.GetSecp256K1()) {
+		t.Fatal("different paths derived the same key")
+	}
+
+	if _, err := keygen(mnemonic, "m/44'/234'/0'", "rsa"); err == nil {
+		t.Fatal("unsupported algorithm accepted")
+	}
+	if _, err := keygen(mnemonic, "44'/234'/0'", "secp256k1"); err == nil {
+		t.Fatal("invalid path accepted")
+	}
+}
+
+func mustParsePath(t testing.TB, path string) []uint32 {
+	t.Helper()
+	p, err := parseDerivationPath(path)
+	if err != nil {
+		t.Fatalf("cannot parse %q derivation path: %s", path, err)
+	}
+	return p
+}
+
 func TestMnemonic(t *testing.T) {
 	cases := map[string]struct {
 		mnemonic string
@@ -96,7 +305,7 @@ func TestMnemonic(t *testing.T) {
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			_, err := keygen(tc.mnemonic, "m/44'/234'/0'")
+			_, err := keygen(tc.mnemonic, "m/44'/234'/0'", "ed25519")
 			if hasErr := err != nil; hasErr != tc.wantErr {
 				t.Fatalf("returned erorr value: %+v", err)
 			}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iov-one/weave/cmd/bnsd/client"
+	"github.com/iov-one/weave/crypto"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+func cmdKey(input io.Reader, output io.Writer, args []string) error {
+	subcommands := map[string]func(io.Reader, io.Writer, []string) error{
+		"delete": cmdKeyDelete,
+		"export": cmdKeyExport,
+		"import": cmdKeyImport,
+		"list":   cmdKeyList,
+	}
+	if len(args) > 0 {
+		if run, ok := subcommands[args[0]]; ok {
+			return run(input, output, args[1:])
+		}
+	}
+	fmt.Fprint(flag.CommandLine.Output(), `
+Manage private keys stored in an encrypted keystore.
+
+Usage: key <list|import|export|delete> [<flags>]
+
+Each key in the keystore has a unique name and is encrypted using a
+passphrase. Use a key stored in the keystore to sign a transaction by
+providing its name to the sign command.
+`)
+	return errors.New("unknown key subcommand")
+}
+
+func cmdKeyList(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Print out the name, the algorithm and the address of all keys stored in the
+keystore. No passphrase is required.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = keystoreFlag(fl)
+	)
+	fl.Parse(args)
+
+	ks, err := client.OpenKeystore(*keystoreFl)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	for _, name := range ks.Names() {
+		k, err := ks.Key(name)
+		if err != nil {
+			return fmt.Errorf("cannot get %q key: %s", name, err)
+		}
+		fmt.Fprintf(output, "%s\t%s\t%s\n", name, k.Algorithm, k.Address)
+	}
+	return nil
+}
+
+func cmdKeyImport(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Encrypt a private key file, as created by the keygen command, and store it in
+the keystore under given name. Once imported, the private key file can be
+deleted.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = keystoreFlag(fl)
+		nameFl     = fl.String("name", "", "Name of the key in the keystore. Required.")
+		keyPathFl  = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
+			"Path to the private key file that should be imported. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		flagDie("name is required")
+	}
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+	ks, err := client.OpenKeystore(*keystoreFl)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := ks.Key(*nameFl); err == nil {
+		return fmt.Errorf("key %q already exists", *nameFl)
+	}
+	passphrase, err := readNewPassphrase()
+	if err != nil {
+		return err
+	}
+	if err := ks.Add(*nameFl, key, passphrase); err != nil {
+		return fmt.Errorf("cannot store key: %s", err)
+	}
+	return nil
+}
+
+func cmdKeyExport(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Decrypt a key stored in the keystore and write it to a private key file, in
+the same format as created by the keygen command. This command fails if the
+private key file already exists.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = keystoreFlag(fl)
+		nameFl     = fl.String("name", "", "Name of the key in the keystore. Required.")
+		keyPathFl  = fl.String("key", "", "Path to the private key file that should be created. Required.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		flagDie("name is required")
+	}
+	if *keyPathFl == "" {
+		flagDie("key is required")
+	}
+	if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
+		return fmt.Errorf("private key file %q already exists, delete this file and try again", *keyPathFl)
+	}
+
+	key, err := loadKeystoreKey(*keystoreFl, *nameFl)
+	if err != nil {
+		return err
+	}
+
+	fd, err := os.OpenFile(*keyPathFl, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0400)
+	if err != nil {
+		return fmt.Errorf("cannot create private key file: %s", err)
+	}
+	defer fd.Close()
+	if _, err := fd.Write(rawPrivateKey(key)); err != nil {
+		return fmt.Errorf("cannot write private key: %s", err)
+	}
+	if err := fd.Close(); err != nil {
+		return fmt.Errorf("cannot close private key file: %s", err)
+	}
+	return nil
+}
+
+func cmdKeyDelete(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Delete a key from the keystore. The passphrase of the key is required to
+ensure that a key is not deleted by an accident.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = keystoreFlag(fl)
+		nameFl     = fl.String("name", "", "Name of the key in the keystore. Required.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		flagDie("name is required")
+	}
+	ks, err := client.OpenKeystore(*keystoreFl)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := ks.Key(*nameFl); err != nil {
+		return fmt.Errorf("cannot get %q key: %s", *nameFl, err)
+	}
+	passphrase, err := readPassphrase(fmt.Sprintf("Passphrase of %q key: ", *nameFl))
+	if err != nil {
+		return err
+	}
+	if _, err := ks.Get(*nameFl, passphrase); err != nil {
+		return fmt.Errorf("cannot decrypt %q key: %s", *nameFl, err)
+	}
+	if err := ks.Delete(*nameFl); err != nil {
+		return fmt.Errorf("cannot delete %q key: %s", *nameFl, err)
+	}
+	return nil
+}
+
+func keystoreFlag(fl *flag.FlagSet) *string {
+	return fl.String("keystore", env("BNSCLI_KEYSTORE", os.Getenv("HOME")+"/.bnscli.keystore"),
+		"Path to the keystore file. You can use BNSCLI_KEYSTORE environment variable to set it.")
+}
+
+// loadKeystoreKey returns a decrypted key stored under given name. The user
+// is asked for the passphrase.
+func loadKeystoreKey(keystorePath, name string) (*crypto.PrivateKey, error) {
+	ks, err := client.OpenKeystore(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := ks.Key(name); err != nil {
+		return nil, fmt.Errorf("cannot get %q key: %s", name, err)
+	}
+	passphrase, err := readPassphrase(fmt.Sprintf("Passphrase of %q key: ", name))
+	if err != nil {
+		return nil, err
+	}
+	key, err := ks.Get(name, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt %q key: %s", name, err)
+	}
+	return key, nil
+}
+
+// readNewPassphrase asks for a passphrase twice, to ensure there is no typo.
+func readNewPassphrase() ([]byte, error) {
+	passphrase, err := readPassphrase("New passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("passphrase must not be empty")
+	}
+	if os.Getenv("BNSCLI_PASSPHRASE") != "" {
+		return passphrase, nil
+	}
+	repeated, err := readPassphrase("Repeat passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, repeated) {
+		return nil, errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+// readPassphrase returns the passphrase provided by the user. Because the
+// standard input is often used to pipe a transaction, the passphrase is read
+// directly from the terminal. For a non interactive usage it can be provided
+// using the BNSCLI_PASSPHRASE environment variable.
+//
+// This is a variable so that it can be replaced in tests.
+var readPassphrase = func(prompt string) ([]byte, error) {
+	if p := os.Getenv("BNSCLI_PASSPHRASE"); p != "" {
+		return []byte(p), nil
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open terminal to read passphrase, use BNSCLI_PASSPHRASE environment variable instead: %s", err)
+	}
+	defer tty.Close()
+	fmt.Fprint(tty, prompt)
+	passphrase, err := terminal.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(tty)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read passphrase: %s", err)
+	}
+	return passphrase, nil
+}
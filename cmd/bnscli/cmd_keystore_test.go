@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestCmdKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bnscli-keystore")
+	if err != nil {
+		t.Fatalf("cannot create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	keystore := filepath.Join(dir, "keystore")
+
+	passphrase := "correct horse battery staple"
+	defer func(fn func(string) ([]byte, error)) { readPassphrase = fn }(readPassphrase)
+	readPassphrase = func(string) ([]byte, error) { return []byte(passphrase), nil }
+
+	rawKey := fromHex(t, privKeyHex)
+	keyPath := mustCreateFile(t, bytes.NewReader(rawKey))
+	importArgs := []string{"-keystore", keystore, "-name", "main", "-key", keyPath}
+	if err := cmdKeyImport(nil, ioutil.Discard, importArgs); err != nil {
+		t.Fatalf("cannot import key: %s", err)
+	}
+	if err := cmdKeyImport(nil, ioutil.Discard, importArgs); err == nil {
+		t.Fatal("importing a key with the same name twice must fail")
+	}
+
+	var list bytes.Buffer
+	if err := cmdKeyList(nil, &list, []string{"-keystore", keystore}); err != nil {
+		t.Fatalf("cannot list keys: %s", err)
+	}
+	key, err := decodePrivateKey(keyPath)
+	if err != nil {
+		t.Fatalf("cannot decode private key: %s", err)
+	}
+	if want := "main\ted25519\t" + key.PublicKey().Address().String() + "\n"; list.String() != want {
+		t.Fatalf("unexpected list result: %q", list.String())
+	}
+
+	exported := filepath.Join(dir, "exported.key")
+	exportArgs := []string{"-keystore", keystore, "-name", "main", "-key", exported}
+	if err := cmdKeyExport(nil, ioutil.Discard, exportArgs); err != nil {
+		t.Fatalf("cannot export key: %s", err)
+	}
+	if raw, err := ioutil.ReadFile(exported); err != nil || !bytes.Equal(raw, rawKey) {
+		t.Fatalf("unexpected exported key: %v", err)
+	}
+	if err := cmdKeyExport(nil, ioutil.Discard, exportArgs); err == nil {
+		t.Fatal("export must not overwrite an existing file")
+	}
+
+	// Sign a transaction using a key from the keystore.
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	var output bytes.Buffer
+	signArgs := []string{"-tm", tmURL, "-keystore", keystore, "-name", "main"}
+	if err := cmdSignTransaction(&input, &output, signArgs); err != nil {
+		t.Fatalf("transaction signing failed: %s", err)
+	}
+	tx, _, err = readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+	if n := len(tx.Signatures); n != 1 {
+		t.Fatalf("want one signature, got %d", n)
+	}
+	if !reflect.DeepEqual(tx.Signatures[0].Pubkey, key.PublicKey()) {
+		t.Fatal("transaction signed with a wrong key")
+	}
+
+	passphrase = "invalid"
+	if err := cmdKeyDelete(nil, ioutil.Discard, []string{"-keystore", keystore, "-name", "main"}); err == nil {
+		t.Fatal("key deleted with an invalid passphrase")
+	}
+	passphrase = "correct horse battery staple"
+	if err := cmdKeyDelete(nil, ioutil.Discard, []string{"-keystore", keystore, "-name", "main"}); err != nil {
+		t.Fatalf("cannot delete key: %s", err)
+	}
+	list.Reset()
+	if err := cmdKeyList(nil, &list, []string{"-keystore", keystore}); err != nil {
+		t.Fatalf("cannot list keys: %s", err)
+	}
+	if list.Len() != 0 {
+		t.Fatalf("unexpected list result: %q", list.String())
+	}
+}
+
+func TestCmdKeygenKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bnscli-keystore")
+	if err != nil {
+		t.Fatalf("cannot create temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+	keystore := filepath.Join(dir, "keystore")
+	keyPath := filepath.Join(dir, "plain.key")
+
+	defer func(fn func(string) ([]byte, error)) { readPassphrase = fn }(readPassphrase)
+	readPassphrase = func(string) ([]byte, error) { return []byte("correct horse battery staple"), nil }
+
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+	args := []string{"-keystore", keystore, "-name", "main", "-key", keyPath}
+	if err := cmdKeygen(strings.NewReader(mnemonic), ioutil.Discard, args); err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+		t.Fatalf("private key file must not be created: %v", err)
+	}
+	if err := cmdKeygen(strings.NewReader(mnemonic), ioutil.Discard, args); err == nil {
+		t.Fatal("generating a key with the same name twice must fail")
+	}
+
+	key, err := loadKeystoreKey(keystore, "main")
+	if err != nil {
+		t.Fatalf("cannot load key: %s", err)
+	}
+	want, err := keygen(mnemonic, "m/44'/234'/0'", "ed25519")
+	if err != nil {
+		t.Fatalf("cannot generate key: %s", err)
+	}
+	if !reflect.DeepEqual(key, want) {
+		t.Fatal("unexpected key stored in the keystore")
+	}
+}
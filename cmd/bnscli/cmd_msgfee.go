@@ -1,12 +1,152 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/x/msgfee"
 )
 
-func msgfeeConf(nodeUrl string, msgPath string) (*coin.Coin, error) {
-	store := tendermintStore(nodeUrl)
-	b := msgfee.NewMsgFeeBucket()
-	return b.MessageFee(store, msgPath)
+func cmdEstimateFee(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read binary serialized transaction from standard input and print out the fee
+that is charged for processing it.
+
+The fee is the highest of the message fee declared for the transaction message
+type, the anti-spam fee required by the queried node and the minimal fee
+declared in the cash extension configuration. Each of those values is printed
+as well. The message fee of a batch transaction is the sum of the fees of all
+batched messages.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+	estimate, err := feeEstimate(*tmAddrFl, tx)
+	if err != nil {
+		return fmt.Errorf("cannot estimate transaction fee: %s", err)
+	}
+
+	fmt.Fprintf(output, "fee\t%s\n", &estimate.Fee)
+	fmt.Fprintf(output, "message fee\t%s\n", &estimate.MsgFee)
+	fmt.Fprintf(output, "antispam fee\t%s\n", &estimate.AntispamFee)
+	fmt.Fprintf(output, "minimal fee\t%s\n", &estimate.MinimalFee)
+	return nil
+}
+
+func cmdSetMsgFee(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Create a transaction for declaring a fee for a message path. A fee already
+declared for that path is replaced.
+
+This transaction must be signed by the message fee configuration owner. If the
+owner is an election rule, use the as-proposal command to submit the change
+via governance.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		pathFl = fl.String("path", "", "Path of the message, for example cash/send. Required.")
+		feeFl  = flCoin(fl, "fee", "", "Fee value that must be paid for processing a message. Required.")
+	)
+	fl.Parse(args)
+
+	if *pathFl == "" {
+		flagDie("message path is required")
+	}
+	if coin.IsEmpty(feeFl) {
+		flagDie("fee is required, use remove-msgfee command to remove a fee")
+	}
+
+	msg := msgfee.SetMsgFeeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		MsgPath:  *pathFl,
+		Fee:      *feeFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_MsgfeeSetMsgFeeMsg{
+			MsgfeeSetMsgFeeMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRemoveMsgFee(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Create a transaction for removing the fee declared for a message path.
+
+This transaction must be signed by the message fee configuration owner. If the
+owner is an election rule, use the as-proposal command to submit the change
+via governance.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		pathFl = fl.String("path", "", "Path of the message, for example cash/send. Required.")
+	)
+	fl.Parse(args)
+
+	if *pathFl == "" {
+		flagDie("message path is required")
+	}
+
+	msg := msgfee.RemoveMsgFeeMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		MsgPath:  *pathFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_MsgfeeRemoveMsgFeeMsg{
+			MsgfeeRemoveMsgFeeMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+// feeEstimate returns the fee that is charged for processing given
+// transaction.
+func feeEstimate(nodeUrl string, tx *bnsd.Tx) (*msgfee.FeeEstimate, error) {
+	raw, err := tx.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("cannot serialize transaction: %s", err)
+	}
+	bnsClient := client.NewClient(client.NewHTTPConnection(nodeUrl))
+	resp, err := bnsClient.AbciQuery("/estimatefee", raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Models) != 1 {
+		return nil, fmt.Errorf("unexpected response: %d models returned", len(resp.Models))
+	}
+	var estimate msgfee.FeeEstimate
+	if err := estimate.Unmarshal(resp.Models[0].Value); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal fee estimate: %s", err)
+	}
+	return &estimate, nil
 }
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/msgfee"
+	"github.com/iov-one/weave/x/multisig"
+)
+
+func TestCmdSetMsgFeeHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-path", "cash/send",
+		"-fee", "0.5 IOV",
+	}
+	if err := cmdSetMsgFee(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*msgfee.SetMsgFeeMsg)
+
+	assert.Equal(t, "cash/send", msg.MsgPath)
+	assert.Equal(t, coin.NewCoin(0, 500000000, "IOV"), msg.Fee)
+}
+
+func TestCmdRemoveMsgFeeHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-path", "cash/send",
+	}
+	if err := cmdRemoveMsgFee(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*msgfee.RemoveMsgFeeMsg)
+
+	assert.Equal(t, "cash/send", msg.MsgPath)
+}
+
+func TestCmdEstimateFee(t *testing.T) {
+	cases := map[string]struct {
+		tx   *bnsd.Tx
+		want string
+	}{
+		"only minimal fee is declared": {
+			tx: &bnsd.Tx{
+				Sum: &bnsd.Tx_CashSendMsg{
+					CashSendMsg: &cash.SendMsg{Metadata: &weave.Metadata{Schema: 1}},
+				},
+			},
+			want: `fee	0.1 IOV
+message fee	0
+antispam fee	0 IOV
+minimal fee	0.1 IOV
+`,
+		},
+		"message fee is higher than minimal fee": {
+			tx: &bnsd.Tx{
+				Sum: &bnsd.Tx_MultisigCreateMsg{
+					MultisigCreateMsg: &multisig.CreateMsg{Metadata: &weave.Metadata{Schema: 1}},
+				},
+			},
+			want: `fee	1 IOV
+message fee	1 IOV
+antispam fee	0 IOV
+minimal fee	0.1 IOV
+`,
+		},
+		"fees of all batched messages are summed": {
+			tx: &bnsd.Tx{
+				Sum: &bnsd.Tx_ExecuteBatchMsg{
+					ExecuteBatchMsg: &bnsd.ExecuteBatchMsg{
+						Messages: []bnsd.ExecuteBatchMsg_Union{
+							{
+								Sum: &bnsd.ExecuteBatchMsg_Union_MultisigCreateMsg{
+									MultisigCreateMsg: &multisig.CreateMsg{Metadata: &weave.Metadata{Schema: 1}},
+								},
+							},
+							{
+								Sum: &bnsd.ExecuteBatchMsg_Union_CashSendMsg{
+									CashSendMsg: &cash.SendMsg{Metadata: &weave.Metadata{Schema: 1}},
+								},
+							},
+							{
+								Sum: &bnsd.ExecuteBatchMsg_Union_MultisigCreateMsg{
+									MultisigCreateMsg: &multisig.CreateMsg{Metadata: &weave.Metadata{Schema: 1}},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: `fee	2 IOV
+message fee	2 IOV
+antispam fee	0 IOV
+minimal fee	0.1 IOV
+`,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var input bytes.Buffer
+			if _, err := writeTx(&input, tc.tx); err != nil {
+				t.Fatalf("cannot marshal transaction: %s", err)
+			}
+			var output bytes.Buffer
+			if err := cmdEstimateFee(&input, &output, []string{"-tm", tmURL}); err != nil {
+				t.Fatalf("cannot estimate fee: %s", err)
+			}
+			assertLines(t, output.String(), tc.want)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"os"
 	"strconv"
 	"strings"
 
@@ -35,6 +36,8 @@ Execute a ABCI query and print JSON encoded result.
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
 		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
 		prefixQueryFl = fl.Bool("prefix", false, "If true, use prefix queries instead of the exact match with provided data.")
+		limitFl       = fl.Uint("limit", 0, "Maximum number of results returned by a prefix query. Zero means the server default.")
+		afterFl       = flHex(fl, "after", "", "Hex encoded cursor returned by a previous prefix query. Only results after the cursor are returned.")
 	)
 	fl.Parse(args)
 
@@ -56,7 +59,13 @@ Execute a ABCI query and print JSON encoded result.
 	}
 	queryPath := *pathFl
 	if *prefixQueryFl || *dataFl == "" {
-		queryPath += "?" + weave.PrefixQueryMod
+		opts := weave.QueryOptions{
+			Limit: uint32(*limitFl),
+			After: *afterFl,
+		}
+		queryPath += "?" + weave.QueryMod(weave.PrefixQueryMod, opts)
+	} else if *limitFl != 0 || len(*afterFl) != 0 {
+		return errors.New("limit and after can be used only with prefix queries")
 	}
 
 	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
@@ -81,8 +90,13 @@ Execute a ABCI query and print JSON encoded result.
 	if err != nil {
 		return fmt.Errorf("cannot JSON serialize: %s", err)
 	}
-	_, err = output.Write(pretty)
-	return err
+	if _, err := output.Write(pretty); err != nil {
+		return err
+	}
+	if len(resp.Cursor) != 0 {
+		fmt.Fprintf(os.Stderr, "\nMore results available. Use -after=%x to continue.\n", resp.Cursor)
+	}
+	return nil
 }
 
 type keyval struct {
@@ -164,6 +178,11 @@ var queries = map[string]struct {
 		decKey: rawKey,
 		encID:  addressID,
 	},
+	"/feemarket": {
+		newObj: func() model { return &cash.FeeMarketState{} },
+		decKey: rawKey,
+		encID:  noID,
+	},
 }
 
 // model is an entity used by weave to store data. This interface is
@@ -202,6 +221,11 @@ func refID(s string) ([]byte, error) {
 	return ref.Marshal()
 }
 
+// noID is used by queries that do not accept any data.
+func noID(string) ([]byte, error) {
+	return nil, errors.New("this query does not accept data")
+}
+
 func addressID(s string) ([]byte, error) {
 	return weave.ParseAddress(s)
 }
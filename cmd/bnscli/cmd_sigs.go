@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"flag"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/sigs"
 )
 
@@ -27,6 +29,13 @@ Sign given transaction. This is decoding a transaction data from standard
 input, adds a signature and writes back to standard output signed transaction
 content.
 
+A transaction that is already signed can be signed again using a different
+key. This allows to collect signatures of several parties, for example to
+reach a multisig contract activation threshold, by passing the transaction
+file around. Use the signatures command to see who has signed it.
+
+Transaction content must not be modified once the first signature is added.
+
 `)
 		fl.PrintDefaults()
 	}
@@ -35,21 +44,40 @@ content.
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+		nameFl = fl.String("name", env("BNSCLI_KEY_NAME", ""),
+			"Name of the keystore key that transaction should be signed with. If set, the private key file is not used. You can use BNSCLI_KEY_NAME environment variable to set it.")
+		keystoreFl = keystoreFlag(fl)
 	)
 	fl.Parse(args)
 
-	if *keyPathFl == "" {
-		return errors.New("private key is required")
-	}
-	key, err := decodePrivateKey(*keyPathFl)
-	if err != nil {
-		return fmt.Errorf("cannot load private key: %s", err)
+	var key *crypto.PrivateKey
+	if *nameFl != "" {
+		k, err := loadKeystoreKey(*keystoreFl, *nameFl)
+		if err != nil {
+			return err
+		}
+		key = k
+	} else {
+		if *keyPathFl == "" {
+			return errors.New("private key is required")
+		}
+		k, err := decodePrivateKey(*keyPathFl)
+		if err != nil {
+			return fmt.Errorf("cannot load private key: %s", err)
+		}
+		key = k
 	}
 
 	tx, _, err := readTx(input)
 	if err != nil {
 		return fmt.Errorf("cannot read transaction: %s", err)
 	}
+	signer := key.PublicKey().Address()
+	for _, sig := range tx.Signatures {
+		if sig.Pubkey != nil && sig.Pubkey.Address().Equals(signer) {
+			return fmt.Errorf("transaction is already signed by %s", signer)
+		}
+	}
 
 	genesis, err := fetchGenesis(*tmAddrFl)
 	if err != nil {
@@ -72,18 +100,125 @@ content.
 	return err
 }
 
+func cmdSignatures(
+	input io.Reader,
+	output io.Writer,
+	args []string,
+) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read a transaction from the standard input and print out who has signed it.
+
+Each signature is verified. A signature is valid only if it was created for
+this transaction content and the chain and if its sequence is the next
+sequence expected from the signer. For each multisig contract attached to the
+transaction, the contract is fetched and the weight of the signatures is
+compared with the contract activation threshold.
+
+This command fails if any of the signatures is not valid.
+
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+	genesis, err := fetchGenesis(*tmAddrFl)
+	if err != nil {
+		return fmt.Errorf("cannot fetch genesis: %s", err)
+	}
+	bnsClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+
+	// Addresses of all valid signatures.
+	signed := make(map[string]bool)
+	var invalid int
+	for i, sig := range tx.Signatures {
+		if sig.Pubkey == nil {
+			fmt.Fprintf(output, "signature %d: invalid, no public key\n", i)
+			invalid++
+			continue
+		}
+		signer := sig.Pubkey.Address()
+		expected, err := client.NewNonce(bnsClient, signer).Query()
+		if err != nil {
+			return fmt.Errorf("cannot get the sequence of %s: %s", signer, err)
+		}
+		signBytes, err := sigs.BuildSignBytesTx(tx, genesis.ChainID, sig.Sequence)
+		if err != nil {
+			return fmt.Errorf("cannot build sign bytes: %s", err)
+		}
+		switch {
+		case !sig.Pubkey.Verify(signBytes, sig.Signature):
+			fmt.Fprintf(output, "signature %d: %s invalid, signature does not match\n", i, signer)
+			invalid++
+		case sig.Sequence != expected:
+			fmt.Fprintf(output, "signature %d: %s invalid, sequence %d, expected %d\n", i, signer, sig.Sequence, expected)
+			invalid++
+		default:
+			fmt.Fprintf(output, "signature %d: %s valid\n", i, signer)
+			signed[signer.String()] = true
+		}
+	}
+
+	for _, id := range tx.Multisig {
+		if len(id) != 8 {
+			return fmt.Errorf("invalid multisig contract ID: %x", id)
+		}
+		resp, err := bnsClient.AbciQuery("/contracts", id)
+		if err != nil {
+			return fmt.Errorf("cannot fetch %x multisig contract: %s", id, err)
+		}
+		if len(resp.Models) == 0 {
+			return fmt.Errorf("multisig contract %x not found", id)
+		}
+		var contract multisig.Contract
+		if err := contract.Unmarshal(resp.Models[0].Value); err != nil {
+			return fmt.Errorf("cannot decode %x multisig contract: %s", id, err)
+		}
+		// Only direct participants are counted. A participant that
+		// is another multisig contract is never considered signed.
+		var weight multisig.Weight
+		for _, p := range contract.Participants {
+			if signed[p.Signature.String()] {
+				weight += p.Weight
+			}
+		}
+		status := "not activated"
+		if weight >= contract.ActivationThreshold {
+			status = "activated"
+		}
+		fmt.Fprintf(output, "multisig %d: signed weight %d, activation threshold %d, %s\n",
+			binary.BigEndian.Uint64(id), weight, contract.ActivationThreshold, status)
+	}
+
+	if invalid != 0 {
+		return fmt.Errorf("%d invalid signatures", invalid)
+	}
+	return nil
+}
+
 func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
 	data, err := ioutil.ReadFile(filepath)
 	if err != nil {
 		return nil, fmt.Errorf("cannot read %q file: %s", filepath, err)
 	}
-	if len(data) != 64 {
+	// Key type is recognized by the length of the raw key.
+	switch len(data) {
+	case 64:
+		return &crypto.PrivateKey{Priv: &crypto.PrivateKey_Ed25519{Ed25519: data}}, nil
+	case 32:
+		return crypto.PrivKeySecp256k1FromBytes(data), nil
+	default:
 		return nil, errors.New("invalid key length")
 	}
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{Ed25519: data},
-	}
-	return key, nil
 }
 
 func fetchGenesis(serverURL string) (*genesis, error) {
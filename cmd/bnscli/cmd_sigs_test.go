@@ -9,6 +9,7 @@ import (
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/x/cash"
 )
 
@@ -44,6 +45,94 @@ func TestCmdSignTransactionHappyPath(t *testing.T) {
 	}
 }
 
+func TestCmdSignaturesMultipleSigners(t *testing.T) {
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Memo:     "co-signed",
+			},
+		},
+		Multisig: [][]byte{weavetest.SequenceID(1)},
+	}
+	var unsigned bytes.Buffer
+	if _, err := writeTx(&unsigned, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+
+	mainKey := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	// Participant of the multisig contract with ID 1, as declared in the
+	// genesis file.
+	coSignerKey := mustCreateFile(t, bytes.NewReader(fromHex(t, coSignerPrivKeyHex)))
+
+	var signedOnce bytes.Buffer
+	if err := cmdSignTransaction(&unsigned, &signedOnce, []string{"-tm", tmURL, "-key", mainKey}); err != nil {
+		t.Fatalf("transaction signing failed: %s", err)
+	}
+	partiallySigned := signedOnce.Bytes()
+
+	var report bytes.Buffer
+	if err := cmdSignatures(bytes.NewReader(partiallySigned), &report, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot check signatures: %s", err)
+	}
+	assertLines(t, report.String(), `signature 0: E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0 valid
+multisig 1: signed weight 1, activation threshold 3, not activated
+`)
+
+	// The same key cannot sign twice.
+	if err := cmdSignTransaction(bytes.NewReader(partiallySigned), ioutil.Discard, []string{"-tm", tmURL, "-key", mainKey}); err == nil {
+		t.Fatal("transaction signed twice with the same key")
+	}
+
+	var signedTwice bytes.Buffer
+	if err := cmdSignTransaction(bytes.NewReader(partiallySigned), &signedTwice, []string{"-tm", tmURL, "-key", coSignerKey}); err != nil {
+		t.Fatalf("transaction signing failed: %s", err)
+	}
+	fullySigned := signedTwice.Bytes()
+
+	report.Reset()
+	if err := cmdSignatures(bytes.NewReader(fullySigned), &report, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot check signatures: %s", err)
+	}
+	assertLines(t, report.String(), `signature 0: E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0 valid
+signature 1: DDB907C5949E3804BBB2E88121FBA96EE54D5C4D valid
+multisig 1: signed weight 3, activation threshold 3, activated
+`)
+
+	// Modifying the transaction after it was signed invalidates all
+	// signatures.
+	tx, _, err := readTx(bytes.NewReader(fullySigned))
+	if err != nil {
+		t.Fatalf("cannot read transaction: %s", err)
+	}
+	tx.GetCashSendMsg().Memo = "modified"
+	var modified bytes.Buffer
+	if _, err := writeTx(&modified, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	report.Reset()
+	if err := cmdSignatures(&modified, &report, []string{"-tm", tmURL}); err == nil {
+		t.Fatal("invalid signatures accepted")
+	}
+	assertLines(t, report.String(), `signature 0: E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0 invalid, signature does not match
+signature 1: DDB907C5949E3804BBB2E88121FBA96EE54D5C4D invalid, signature does not match
+multisig 1: signed weight 0, activation threshold 3, not activated
+`)
+}
+
+// coSignerPrivKeyHex is a hex-encoded private key of a multisig contract
+// participant on the test server.
+const coSignerPrivKeyHex = "ef2b8b24b812412802c12974a964cb80bcdc3ca57c1038c67d549e30ebac48936c680f3b14bb51f2c2e5d396b04ed9e5bdc5dd103e0e0637cf094df90a2dda79"
+
+func assertLines(t testing.TB, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Logf("want: %s", want)
+		t.Logf(" got: %s", got)
+		t.Fatal("unexpected output")
+	}
+}
+
 var logRequestFl = flag.Bool("logrequest", false, "Log all requests send to tendermint mock server. This is useful when writing new test. Use curl to send the same request to a real tendermint node and record the response.")
 
 func mustCreateFile(t testing.TB, r io.Reader) string {
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/x/aswap"
 	"github.com/iov-one/weave/x/batch"
@@ -13,6 +15,7 @@ import (
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/paychan"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 )
 
 func cmdSubmitTransaction(input io.Reader, output io.Writer, args []string) error {
@@ -57,6 +60,72 @@ Make sure to collect enough signatures before submitting the transaction.
 	return nil
 }
 
+func cmdSimulateTransaction(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read binary serialized transaction from standard input and check it against
+the current state of the blockchain, without submitting it.
+
+The transaction is processed the same way as when it is submitted, including
+signature, fee and gas checks, but no changes are persisted. The result code,
+log, the fee required by the transaction and the gas usage are written out.
+
+Signatures are verified as part of the check, so the transaction must be
+signed by all required parties before it is simulated. The required fee is
+reported only when the check is successful. Use the estimate-fee command to
+learn the fee of a message before creating a transaction.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	raw, err := readInput(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction from input: %s", err)
+	}
+	// Ensure that the input is a valid transaction before sending it.
+	tx, _, err := readTx(bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("cannot read transaction from input: %s", err)
+	}
+	rawTx, err := tx.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize transaction: %s", err)
+	}
+
+	tm := rpcclient.NewHTTP(*tmAddrFl, "/websocket")
+	resp, err := tm.ABCIQuery(app.SimulatePath, rawTx)
+	if err != nil {
+		return fmt.Errorf("cannot simulate transaction: %s", err)
+	}
+	if resp.Response.IsErr() {
+		return fmt.Errorf("cannot simulate transaction: (%d) %s", resp.Response.Code, resp.Response.Log)
+	}
+	var res app.SimulationResult
+	if err := res.Unmarshal(resp.Response.Value); err != nil {
+		return fmt.Errorf("cannot deserialize simulation result: %s", err)
+	}
+
+	fmt.Fprintf(output, "height\t%d\n", resp.Response.Height)
+	fmt.Fprintf(output, "code\t%d\n", res.Code)
+	fmt.Fprintf(output, "log\t%s\n", res.Log)
+	if res.RequiredFee != nil {
+		fmt.Fprintf(output, "required fee\t%s\n", res.RequiredFee)
+	}
+	fmt.Fprintf(output, "gas allocated\t%d\n", res.GasAllocated)
+	fmt.Fprintf(output, "gas used\t%d\n", res.GasUsed)
+
+	if res.Code != 0 {
+		return fmt.Errorf("transaction check failed with code %d", res.Code)
+	}
+	return nil
+}
+
 // extractResponses parse given raw response data bytes according to what is
 // expected considering the submitted transaction. It returns a human readable
 // representation of given response. It can return no data (and no error) if
@@ -2,11 +2,14 @@ package main
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/batch"
@@ -60,6 +63,65 @@ func TestCmdSubmitTxHappyPath(t *testing.T) {
 	}
 }
 
+func TestCmdSimulateTransaction(t *testing.T) {
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      fromHex(t, addr),
+				Destination: fromHex(t, addr),
+				Amount: &coin.Coin{
+					Whole:  5,
+					Ticker: "IOV",
+				},
+			},
+		},
+	}
+	var unsigned bytes.Buffer
+	if _, err := writeTx(&unsigned, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	rawUnsigned := unsigned.Bytes()
+
+	// A transaction without a signature is rejected by the check.
+	var output bytes.Buffer
+	if err := cmdSimulateTransaction(bytes.NewReader(rawUnsigned), &output, []string{"-tm", tmURL}); err == nil {
+		t.Fatal("unsigned transaction simulation succeeded")
+	}
+	if !strings.Contains(output.String(), "\ncode\t"+fmt.Sprint(errors.ErrUnauthorized.ABCICode())+"\n") {
+		t.Fatalf("unexpected output: %s", output.String())
+	}
+
+	var withFee bytes.Buffer
+	if err := cmdWithFee(bytes.NewReader(rawUnsigned), &withFee, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot set fee: %s", err)
+	}
+	var signed bytes.Buffer
+	signArgs := []string{
+		"-tm", tmURL,
+		"-key", mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))),
+	}
+	if err := cmdSignTransaction(&withFee, &signed, signArgs); err != nil {
+		t.Fatalf("cannot sign transaction: %s", err)
+	}
+	rawSigned := signed.Bytes()
+
+	output.Reset()
+	if err := cmdSimulateTransaction(bytes.NewReader(rawSigned), &output, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot simulate transaction: %s\n%s", err, output.String())
+	}
+	if !strings.Contains(output.String(), "\ncode\t0\n") {
+		t.Fatalf("unexpected output: %s", output.String())
+	}
+
+	// Simulation does not consume the signature sequence, so the same
+	// transaction can be simulated again.
+	output.Reset()
+	if err := cmdSimulateTransaction(bytes.NewReader(rawSigned), &output, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot simulate transaction again: %s\n%s", err, output.String())
+	}
+}
+
 func TestSubmitTxResponse(t *testing.T) {
 	fmts := map[string]func([]byte) (string, error){
 		"mymsg":      fmtSequence,
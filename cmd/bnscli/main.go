@@ -38,20 +38,30 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"as-batch":                  cmdAsBatch,
 	"as-proposal":               cmdAsProposal,
 	"as-sequence":               cmdAsSequence,
+	"cancel-cron-task":          cmdCancelCronTask,
+	"create-swap":               cmdCreateSwap,
+	"cron-tasks":                cmdCronTasks,
 	"del-proposal":              cmdDelProposal,
+	"estimate-fee":              cmdEstimateFee,
 	"from-sequence":             cmdFromSequence,
+	"key":                       cmdKey,
 	"keyaddr":                   cmdKeyaddr,
 	"keygen":                    cmdKeygen,
 	"mnemonic":                  cmdMnemonic,
 	"multisig":                  cmdMultisig,
 	"query":                     cmdQuery,
 	"register-username":         cmdRegisterUsername,
+	"remove-msgfee":             cmdRemoveMsgFee,
 	"release-escrow":            cmdReleaseEscrow,
+	"release-swap":              cmdReleaseSwap,
 	"reset-revenue":             cmdResetRevenue,
 	"resolve-username":          cmdResolveUsername,
 	"send-tokens":               cmdSendTokens,
+	"set-msgfee":                cmdSetMsgFee,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
+	"signatures":                cmdSignatures,
+	"simulate":                  cmdSimulateTransaction,
 	"submit":                    cmdSubmitTransaction,
 	"text-resolution":           cmdTextResolution,
 	"update-electorate":         cmdUpdateElectorate,
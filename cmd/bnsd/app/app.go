@@ -27,6 +27,7 @@ import (
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
@@ -77,7 +78,7 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 
 	migration.RegisterRoutes(r, authFn)
 	cash.RegisterRoutes(r, authFn, ctrl)
-	escrow.RegisterRoutes(r, authFn, ctrl)
+	escrow.RegisterRoutes(r, authFn, ctrl, scheduler)
 	multisig.RegisterRoutes(r, authFn)
 	//TODO: Possibly revisit passing the bucket later to have more control over types?
 	// or implement a check
@@ -85,9 +86,12 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	validators.RegisterRoutes(r, authFn)
 	distribution.RegisterRoutes(r, authFn, ctrl)
 	sigs.RegisterRoutes(r, authFn)
-	aswap.RegisterRoutes(r, authFn, ctrl)
+	aswap.RegisterRoutes(r, authFn, ctrl, scheduler)
 	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler)
 	username.RegisterRoutes(r, authFn)
+	msgfee.RegisterRoutes(r, authFn)
+	gas.RegisterRoutes(r, authFn)
+	cron.RegisterRoutes(r, authFn, CronTaskMarshaler)
 	return r
 }
 
@@ -95,6 +99,8 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	antiSpamQuery := msgfee.NewAntiSpamQuery(minFee)
+	feeEstimateQuery := msgfee.NewFeeEstimateQuery(minFee, TxDecoder)
+	cronTaskQuery := cron.NewTaskQuery(CronTaskMarshaler)
 
 	r.RegisterAll(
 		migration.RegisterQuery,
@@ -107,10 +113,12 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 		currency.RegisterQuery,
 		distribution.RegisterQuery,
 		antiSpamQuery.RegisterQuery,
+		feeEstimateQuery.RegisterQuery,
 		aswap.RegisterQuery,
 		gov.RegisterQuery,
 		username.RegisterQuery,
 		cron.RegisterQuery,
+		cronTaskQuery.RegisterQuery,
 	)
 	return r
 }
@@ -129,14 +137,15 @@ func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
 // fee).
 func CronStack() weave.Handler {
 	rt := app.NewRouter()
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	authFn := cron.Authenticator{}
 
 	// Cron is using custom router as not the same handlers are registered.
 	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl))
 	distribution.RegisterRoutes(rt, authFn, ctrl)
-	escrow.RegisterRoutes(rt, authFn, ctrl)
-	aswap.RegisterRoutes(rt, authFn, ctrl)
+	escrow.RegisterRoutes(rt, authFn, ctrl, scheduler)
+	aswap.RegisterRoutes(rt, authFn, ctrl, scheduler)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
@@ -163,9 +172,17 @@ func Application(
 	if err != nil {
 		return app.BaseApp{}, errors.Wrap(err, "cannot create store")
 	}
+	if s, ok := kv.(iavl.CommitStore); ok {
+		kv = s.WithPruning(options.Pruning)
+	}
 	store := app.NewStoreApp(name, kv, QueryRouter(options.MinFee), ctx)
-	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
-	base := app.NewBaseApp(store, tx, h, ticker, options.Debug)
+	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler).WithGasRules(gas.LoadRules)
+	base := app.NewBaseApp(store, tx, h, ticker, options.Debug).
+		WithGasRules(gas.LoadRules).
+		WithEndBlocker(app.ChainEndBlockers(
+			distribution.NewFeeDistributionEndBlocker(ctrl),
+			cash.NewFeeMarketEndBlocker(),
+		))
 	return base, nil
 }
 
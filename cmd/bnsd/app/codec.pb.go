@@ -12,10 +12,13 @@ import (
 	migration "github.com/iov-one/weave/migration"
 	aswap "github.com/iov-one/weave/x/aswap"
 	cash "github.com/iov-one/weave/x/cash"
+	cron "github.com/iov-one/weave/x/cron"
 	currency "github.com/iov-one/weave/x/currency"
 	distribution "github.com/iov-one/weave/x/distribution"
 	escrow "github.com/iov-one/weave/x/escrow"
+	gas "github.com/iov-one/weave/x/gas"
 	gov "github.com/iov-one/weave/x/gov"
+	msgfee "github.com/iov-one/weave/x/msgfee"
 	multisig "github.com/iov-one/weave/x/multisig"
 	sigs "github.com/iov-one/weave/x/sigs"
 	validators "github.com/iov-one/weave/x/validators"
@@ -37,12 +40,13 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 // Tx contains the message.
 //
 // When extending Tx, follow the rules:
-// - range 1-50 is reserved for middlewares,
-// - range 51-inf is reserved for different message types,
-// - keep the same numbers for the same message types in both bnsd and other
-//   applications. For example, FeeInfo field is used by both and indexed at
-//   first position. Skip unused fields (leave index unused or comment out for
-//   clarity).
+//   - range 1-50 is reserved for middlewares,
+//   - range 51-inf is reserved for different message types,
+//   - keep the same numbers for the same message types in both bnsd and other
+//     applications. For example, FeeInfo field is used by both and indexed at
+//     first position. Skip unused fields (leave index unused or comment out for
+//     clarity).
+//
 // When there is a gap in message sequence numbers - that most likely means some
 // old fields got deprecated. This is done to maintain binary compatibility.
 type Tx struct {
@@ -78,6 +82,13 @@ type Tx struct {
 	//	*Tx_GovVoteMsg
 	//	*Tx_GovUpdateElectorateMsg
 	//	*Tx_GovUpdateElectionRuleMsg
+	//	*Tx_MsgfeeSetMsgFeeMsg
+	//	*Tx_MsgfeeRemoveMsgFeeMsg
+	//	*Tx_CronCancelTaskMsg
+	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_CronUpdateConfigurationMsg
+	//	*Tx_DistributionUpdateConfigurationMsg
+	//	*Tx_GasUpdateConfigurationMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -195,32 +206,60 @@ type Tx_GovUpdateElectorateMsg struct {
 type Tx_GovUpdateElectionRuleMsg struct {
 	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
 }
-
-func (*Tx_CashSendMsg) isTx_Sum()                   {}
-func (*Tx_EscrowCreateMsg) isTx_Sum()               {}
-func (*Tx_EscrowReleaseMsg) isTx_Sum()              {}
-func (*Tx_EscrowReturnMsg) isTx_Sum()               {}
-func (*Tx_EscrowUpdatePartiesMsg) isTx_Sum()        {}
-func (*Tx_MultisigCreateMsg) isTx_Sum()             {}
-func (*Tx_MultisigUpdateMsg) isTx_Sum()             {}
-func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()        {}
-func (*Tx_CurrencyCreateMsg) isTx_Sum()             {}
-func (*Tx_ExecuteBatchMsg) isTx_Sum()               {}
-func (*Tx_UsernameRegisterTokenMsg) isTx_Sum()      {}
-func (*Tx_UsernameTransferTokenMsg) isTx_Sum()      {}
-func (*Tx_UsernameChangeTokenTargetsMsg) isTx_Sum() {}
-func (*Tx_DistributionCreateMsg) isTx_Sum()         {}
-func (*Tx_DistributionMsg) isTx_Sum()               {}
-func (*Tx_DistributionResetMsg) isTx_Sum()          {}
-func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum()     {}
-func (*Tx_AswapCreateMsg) isTx_Sum()                {}
-func (*Tx_AswapReleaseMsg) isTx_Sum()               {}
-func (*Tx_AswapReturnMsg) isTx_Sum()                {}
-func (*Tx_GovCreateProposalMsg) isTx_Sum()          {}
-func (*Tx_GovDeleteProposalMsg) isTx_Sum()          {}
-func (*Tx_GovVoteMsg) isTx_Sum()                    {}
-func (*Tx_GovUpdateElectorateMsg) isTx_Sum()        {}
-func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()      {}
+type Tx_MsgfeeSetMsgFeeMsg struct {
+	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
+}
+type Tx_MsgfeeRemoveMsgFeeMsg struct {
+	MsgfeeRemoveMsgFeeMsg *msgfee.RemoveMsgFeeMsg `protobuf:"bytes,81,opt,name=msgfee_remove_msg_fee_msg,json=msgfeeRemoveMsgFeeMsg,proto3,oneof"`
+}
+type Tx_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,82,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
+type Tx_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,83,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_CronUpdateConfigurationMsg struct {
+	CronUpdateConfigurationMsg *cron.UpdateConfigurationMsg `protobuf:"bytes,84,opt,name=cron_update_configuration_msg,json=cronUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_DistributionUpdateConfigurationMsg struct {
+	DistributionUpdateConfigurationMsg *distribution.UpdateConfigurationMsg `protobuf:"bytes,85,opt,name=distribution_update_configuration_msg,json=distributionUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_GasUpdateConfigurationMsg struct {
+	GasUpdateConfigurationMsg *gas.UpdateConfigurationMsg `protobuf:"bytes,86,opt,name=gas_update_configuration_msg,json=gasUpdateConfigurationMsg,proto3,oneof"`
+}
+
+func (*Tx_CashSendMsg) isTx_Sum()                        {}
+func (*Tx_EscrowCreateMsg) isTx_Sum()                    {}
+func (*Tx_EscrowReleaseMsg) isTx_Sum()                   {}
+func (*Tx_EscrowReturnMsg) isTx_Sum()                    {}
+func (*Tx_EscrowUpdatePartiesMsg) isTx_Sum()             {}
+func (*Tx_MultisigCreateMsg) isTx_Sum()                  {}
+func (*Tx_MultisigUpdateMsg) isTx_Sum()                  {}
+func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()             {}
+func (*Tx_CurrencyCreateMsg) isTx_Sum()                  {}
+func (*Tx_ExecuteBatchMsg) isTx_Sum()                    {}
+func (*Tx_UsernameRegisterTokenMsg) isTx_Sum()           {}
+func (*Tx_UsernameTransferTokenMsg) isTx_Sum()           {}
+func (*Tx_UsernameChangeTokenTargetsMsg) isTx_Sum()      {}
+func (*Tx_DistributionCreateMsg) isTx_Sum()              {}
+func (*Tx_DistributionMsg) isTx_Sum()                    {}
+func (*Tx_DistributionResetMsg) isTx_Sum()               {}
+func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum()          {}
+func (*Tx_AswapCreateMsg) isTx_Sum()                     {}
+func (*Tx_AswapReleaseMsg) isTx_Sum()                    {}
+func (*Tx_AswapReturnMsg) isTx_Sum()                     {}
+func (*Tx_GovCreateProposalMsg) isTx_Sum()               {}
+func (*Tx_GovDeleteProposalMsg) isTx_Sum()               {}
+func (*Tx_GovVoteMsg) isTx_Sum()                         {}
+func (*Tx_GovUpdateElectorateMsg) isTx_Sum()             {}
+func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()           {}
+func (*Tx_MsgfeeSetMsgFeeMsg) isTx_Sum()                 {}
+func (*Tx_MsgfeeRemoveMsgFeeMsg) isTx_Sum()              {}
+func (*Tx_CronCancelTaskMsg) isTx_Sum()                  {}
+func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()       {}
+func (*Tx_CronUpdateConfigurationMsg) isTx_Sum()         {}
+func (*Tx_DistributionUpdateConfigurationMsg) isTx_Sum() {}
+func (*Tx_GasUpdateConfigurationMsg) isTx_Sum()          {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -425,6 +464,55 @@ func (m *Tx) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
 	return nil
 }
 
+func (m *Tx) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeSetMsgFeeMsg); ok {
+		return x.MsgfeeSetMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMsgfeeRemoveMsgFeeMsg() *msgfee.RemoveMsgFeeMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeRemoveMsgFeeMsg); ok {
+		return x.MsgfeeRemoveMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetSum().(*Tx_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCronUpdateConfigurationMsg() *cron.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_CronUpdateConfigurationMsg); ok {
+		return x.CronUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetDistributionUpdateConfigurationMsg() *distribution.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_DistributionUpdateConfigurationMsg); ok {
+		return x.DistributionUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGasUpdateConfigurationMsg() *gas.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_GasUpdateConfigurationMsg); ok {
+		return x.GasUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -453,6 +541,13 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_GovVoteMsg)(nil),
 		(*Tx_GovUpdateElectorateMsg)(nil),
 		(*Tx_GovUpdateElectionRuleMsg)(nil),
+		(*Tx_MsgfeeSetMsgFeeMsg)(nil),
+		(*Tx_MsgfeeRemoveMsgFeeMsg)(nil),
+		(*Tx_CronCancelTaskMsg)(nil),
+		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_CronUpdateConfigurationMsg)(nil),
+		(*Tx_DistributionUpdateConfigurationMsg)(nil),
+		(*Tx_GasUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -585,6 +680,41 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
 			return err
 		}
+	case *Tx_MsgfeeSetMsgFeeMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
+			return err
+		}
+	case *Tx_MsgfeeRemoveMsgFeeMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeRemoveMsgFeeMsg); err != nil {
+			return err
+		}
+	case *Tx_CronCancelTaskMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_CronUpdateConfigurationMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_DistributionUpdateConfigurationMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_GasUpdateConfigurationMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GasUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -795,6 +925,62 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_GovUpdateElectionRuleMsg{msg}
 		return true, err
+	case 80: // sum.msgfee_set_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.SetMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeSetMsgFeeMsg{msg}
+		return true, err
+	case 81: // sum.msgfee_remove_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.RemoveMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeRemoveMsgFeeMsg{msg}
+		return true, err
+	case 82: // sum.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CronCancelTaskMsg{msg}
+		return true, err
+	case 83: // sum.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 84: // sum.cron_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CronUpdateConfigurationMsg{msg}
+		return true, err
+	case 85: // sum.distribution_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_DistributionUpdateConfigurationMsg{msg}
+		return true, err
+	case 86: // sum.gas_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gas.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GasUpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -929,6 +1115,41 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_MsgfeeSetMsgFeeMsg:
+		s := proto.Size(x.MsgfeeSetMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MsgfeeRemoveMsgFeeMsg:
+		s := proto.Size(x.MsgfeeRemoveMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CronUpdateConfigurationMsg:
+		s := proto.Size(x.CronUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_DistributionUpdateConfigurationMsg:
+		s := proto.Size(x.DistributionUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GasUpdateConfigurationMsg:
+		s := proto.Size(x.GasUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -998,6 +1219,13 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_DistributionCreateMsg
 	//	*ExecuteBatchMsg_Union_DistributionMsg
 	//	*ExecuteBatchMsg_Union_DistributionResetMsg
+	//	*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg
+	//	*ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg
+	//	*ExecuteBatchMsg_Union_CronCancelTaskMsg
+	//	*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_CronUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg
+	//	*ExecuteBatchMsg_Union_GasUpdateConfigurationMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -1085,22 +1313,50 @@ type ExecuteBatchMsg_Union_DistributionMsg struct {
 type ExecuteBatchMsg_Union_DistributionResetMsg struct {
 	DistributionResetMsg *distribution.ResetMsg `protobuf:"bytes,68,opt,name=distribution_reset_msg,json=distributionResetMsg,proto3,oneof"`
 }
-
-func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                   {}
-func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()               {}
-func (*ExecuteBatchMsg_Union_EscrowReleaseMsg) isExecuteBatchMsg_Union_Sum()              {}
-func (*ExecuteBatchMsg_Union_EscrowReturnMsg) isExecuteBatchMsg_Union_Sum()               {}
-func (*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) isExecuteBatchMsg_Union_Sum()        {}
-func (*ExecuteBatchMsg_Union_MultisigCreateMsg) isExecuteBatchMsg_Union_Sum()             {}
-func (*ExecuteBatchMsg_Union_MultisigUpdateMsg) isExecuteBatchMsg_Union_Sum()             {}
-func (*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteBatchMsg_Union_Sum()        {}
-func (*ExecuteBatchMsg_Union_CurrencyCreateMsg) isExecuteBatchMsg_Union_Sum()             {}
-func (*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteBatchMsg_Union_Sum()      {}
-func (*ExecuteBatchMsg_Union_UsernameTransferTokenMsg) isExecuteBatchMsg_Union_Sum()      {}
-func (*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteBatchMsg_Union_Sum() {}
-func (*ExecuteBatchMsg_Union_DistributionCreateMsg) isExecuteBatchMsg_Union_Sum()         {}
-func (*ExecuteBatchMsg_Union_DistributionMsg) isExecuteBatchMsg_Union_Sum()               {}
-func (*ExecuteBatchMsg_Union_DistributionResetMsg) isExecuteBatchMsg_Union_Sum()          {}
+type ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg struct {
+	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg struct {
+	MsgfeeRemoveMsgFeeMsg *msgfee.RemoveMsgFeeMsg `protobuf:"bytes,81,opt,name=msgfee_remove_msg_fee_msg,json=msgfeeRemoveMsgFeeMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,82,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,83,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CronUpdateConfigurationMsg struct {
+	CronUpdateConfigurationMsg *cron.UpdateConfigurationMsg `protobuf:"bytes,84,opt,name=cron_update_configuration_msg,json=cronUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg struct {
+	DistributionUpdateConfigurationMsg *distribution.UpdateConfigurationMsg `protobuf:"bytes,85,opt,name=distribution_update_configuration_msg,json=distributionUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_GasUpdateConfigurationMsg struct {
+	GasUpdateConfigurationMsg *gas.UpdateConfigurationMsg `protobuf:"bytes,86,opt,name=gas_update_configuration_msg,json=gasUpdateConfigurationMsg,proto3,oneof"`
+}
+
+func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                        {}
+func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()                    {}
+func (*ExecuteBatchMsg_Union_EscrowReleaseMsg) isExecuteBatchMsg_Union_Sum()                   {}
+func (*ExecuteBatchMsg_Union_EscrowReturnMsg) isExecuteBatchMsg_Union_Sum()                    {}
+func (*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) isExecuteBatchMsg_Union_Sum()             {}
+func (*ExecuteBatchMsg_Union_MultisigCreateMsg) isExecuteBatchMsg_Union_Sum()                  {}
+func (*ExecuteBatchMsg_Union_MultisigUpdateMsg) isExecuteBatchMsg_Union_Sum()                  {}
+func (*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteBatchMsg_Union_Sum()             {}
+func (*ExecuteBatchMsg_Union_CurrencyCreateMsg) isExecuteBatchMsg_Union_Sum()                  {}
+func (*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteBatchMsg_Union_Sum()           {}
+func (*ExecuteBatchMsg_Union_UsernameTransferTokenMsg) isExecuteBatchMsg_Union_Sum()           {}
+func (*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteBatchMsg_Union_Sum()      {}
+func (*ExecuteBatchMsg_Union_DistributionCreateMsg) isExecuteBatchMsg_Union_Sum()              {}
+func (*ExecuteBatchMsg_Union_DistributionMsg) isExecuteBatchMsg_Union_Sum()                    {}
+func (*ExecuteBatchMsg_Union_DistributionResetMsg) isExecuteBatchMsg_Union_Sum()               {}
+func (*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteBatchMsg_Union_Sum()                 {}
+func (*ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) isExecuteBatchMsg_Union_Sum()              {}
+func (*ExecuteBatchMsg_Union_CronCancelTaskMsg) isExecuteBatchMsg_Union_Sum()                  {}
+func (*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()       {}
+func (*ExecuteBatchMsg_Union_CronUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()         {}
+func (*ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum() {}
+func (*ExecuteBatchMsg_Union_GasUpdateConfigurationMsg) isExecuteBatchMsg_Union_Sum()          {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -1214,6 +1470,55 @@ func (m *ExecuteBatchMsg_Union) GetDistributionResetMsg() *distribution.ResetMsg
 	return nil
 }
 
+func (m *ExecuteBatchMsg_Union) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg); ok {
+		return x.MsgfeeSetMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMsgfeeRemoveMsgFeeMsg() *msgfee.RemoveMsgFeeMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg); ok {
+		return x.MsgfeeRemoveMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCronUpdateConfigurationMsg() *cron.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CronUpdateConfigurationMsg); ok {
+		return x.CronUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetDistributionUpdateConfigurationMsg() *distribution.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg); ok {
+		return x.DistributionUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetGasUpdateConfigurationMsg() *gas.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_GasUpdateConfigurationMsg); ok {
+		return x.GasUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
@@ -1232,6 +1537,13 @@ func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto
 		(*ExecuteBatchMsg_Union_DistributionCreateMsg)(nil),
 		(*ExecuteBatchMsg_Union_DistributionMsg)(nil),
 		(*ExecuteBatchMsg_Union_DistributionResetMsg)(nil),
+		(*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg)(nil),
+		(*ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg)(nil),
+		(*ExecuteBatchMsg_Union_CronCancelTaskMsg)(nil),
+		(*ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_CronUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg)(nil),
+		(*ExecuteBatchMsg_Union_GasUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -1314,6 +1626,41 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.DistributionResetMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeRemoveMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CronCancelTaskMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CronUpdateConfigurationMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_GasUpdateConfigurationMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GasUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -1444,6 +1791,62 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{msg}
 		return true, err
+	case 80: // sum.msgfee_set_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.SetMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{msg}
+		return true, err
+	case 81: // sum.msgfee_remove_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.RemoveMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{msg}
+		return true, err
+	case 82: // sum.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CronCancelTaskMsg{msg}
+		return true, err
+	case 83: // sum.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 84: // sum.cron_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CronUpdateConfigurationMsg{msg}
+		return true, err
+	case 85: // sum.distribution_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg{msg}
+		return true, err
+	case 86: // sum.gas_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gas.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_GasUpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1528,6 +1931,41 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg:
+		s := proto.Size(x.MsgfeeSetMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg:
+		s := proto.Size(x.MsgfeeRemoveMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CronUpdateConfigurationMsg:
+		s := proto.Size(x.CronUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg:
+		s := proto.Size(x.DistributionUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_GasUpdateConfigurationMsg:
+		s := proto.Size(x.GasUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1556,6 +1994,13 @@ type ProposalOptions struct {
 	//	*ProposalOptions_GovUpdateElectorateMsg
 	//	*ProposalOptions_GovUpdateElectionRuleMsg
 	//	*ProposalOptions_GovCreateTextResolutionMsg
+	//	*ProposalOptions_MsgfeeSetMsgFeeMsg
+	//	*ProposalOptions_MsgfeeRemoveMsgFeeMsg
+	//	*ProposalOptions_CronCancelTaskMsg
+	//	*ProposalOptions_MsgfeeUpdateConfigurationMsg
+	//	*ProposalOptions_CronUpdateConfigurationMsg
+	//	*ProposalOptions_DistributionUpdateConfigurationMsg
+	//	*ProposalOptions_GasUpdateConfigurationMsg
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -1649,24 +2094,52 @@ type ProposalOptions_GovUpdateElectionRuleMsg struct {
 type ProposalOptions_GovCreateTextResolutionMsg struct {
 	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
 }
-
-func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                   {}
-func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()              {}
-func (*ProposalOptions_UpdateEscrowPartiesMsg) isProposalOptions_Option()        {}
-func (*ProposalOptions_MultisigUpdateMsg) isProposalOptions_Option()             {}
-func (*ProposalOptions_ValidatorsApplyDiffMsg) isProposalOptions_Option()        {}
-func (*ProposalOptions_CurrencyCreateMsg) isProposalOptions_Option()             {}
-func (*ProposalOptions_ExecuteProposalBatchMsg) isProposalOptions_Option()       {}
-func (*ProposalOptions_UsernameRegisterTokenMsg) isProposalOptions_Option()      {}
-func (*ProposalOptions_UsernameTransferTokenMsg) isProposalOptions_Option()      {}
-func (*ProposalOptions_UsernameChangeTokenTargetsMsg) isProposalOptions_Option() {}
-func (*ProposalOptions_DistributionCreateMsg) isProposalOptions_Option()         {}
-func (*ProposalOptions_DistributionMsg) isProposalOptions_Option()               {}
-func (*ProposalOptions_DistributionResetMsg) isProposalOptions_Option()          {}
-func (*ProposalOptions_MigrationUpgradeSchemaMsg) isProposalOptions_Option()     {}
-func (*ProposalOptions_GovUpdateElectorateMsg) isProposalOptions_Option()        {}
-func (*ProposalOptions_GovUpdateElectionRuleMsg) isProposalOptions_Option()      {}
-func (*ProposalOptions_GovCreateTextResolutionMsg) isProposalOptions_Option()    {}
+type ProposalOptions_MsgfeeSetMsgFeeMsg struct {
+	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
+}
+type ProposalOptions_MsgfeeRemoveMsgFeeMsg struct {
+	MsgfeeRemoveMsgFeeMsg *msgfee.RemoveMsgFeeMsg `protobuf:"bytes,81,opt,name=msgfee_remove_msg_fee_msg,json=msgfeeRemoveMsgFeeMsg,proto3,oneof"`
+}
+type ProposalOptions_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,82,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
+type ProposalOptions_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,83,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_CronUpdateConfigurationMsg struct {
+	CronUpdateConfigurationMsg *cron.UpdateConfigurationMsg `protobuf:"bytes,84,opt,name=cron_update_configuration_msg,json=cronUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_DistributionUpdateConfigurationMsg struct {
+	DistributionUpdateConfigurationMsg *distribution.UpdateConfigurationMsg `protobuf:"bytes,85,opt,name=distribution_update_configuration_msg,json=distributionUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_GasUpdateConfigurationMsg struct {
+	GasUpdateConfigurationMsg *gas.UpdateConfigurationMsg `protobuf:"bytes,86,opt,name=gas_update_configuration_msg,json=gasUpdateConfigurationMsg,proto3,oneof"`
+}
+
+func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                        {}
+func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()                   {}
+func (*ProposalOptions_UpdateEscrowPartiesMsg) isProposalOptions_Option()             {}
+func (*ProposalOptions_MultisigUpdateMsg) isProposalOptions_Option()                  {}
+func (*ProposalOptions_ValidatorsApplyDiffMsg) isProposalOptions_Option()             {}
+func (*ProposalOptions_CurrencyCreateMsg) isProposalOptions_Option()                  {}
+func (*ProposalOptions_ExecuteProposalBatchMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_UsernameRegisterTokenMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_UsernameTransferTokenMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_UsernameChangeTokenTargetsMsg) isProposalOptions_Option()      {}
+func (*ProposalOptions_DistributionCreateMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_DistributionMsg) isProposalOptions_Option()                    {}
+func (*ProposalOptions_DistributionResetMsg) isProposalOptions_Option()               {}
+func (*ProposalOptions_MigrationUpgradeSchemaMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_GovUpdateElectorateMsg) isProposalOptions_Option()             {}
+func (*ProposalOptions_GovUpdateElectionRuleMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_GovCreateTextResolutionMsg) isProposalOptions_Option()         {}
+func (*ProposalOptions_MsgfeeSetMsgFeeMsg) isProposalOptions_Option()                 {}
+func (*ProposalOptions_MsgfeeRemoveMsgFeeMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_CronCancelTaskMsg) isProposalOptions_Option()                  {}
+func (*ProposalOptions_MsgfeeUpdateConfigurationMsg) isProposalOptions_Option()       {}
+func (*ProposalOptions_CronUpdateConfigurationMsg) isProposalOptions_Option()         {}
+func (*ProposalOptions_DistributionUpdateConfigurationMsg) isProposalOptions_Option() {}
+func (*ProposalOptions_GasUpdateConfigurationMsg) isProposalOptions_Option()          {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -1794,6 +2267,55 @@ func (m *ProposalOptions) GetGovCreateTextResolutionMsg() *gov.CreateTextResolut
 	return nil
 }
 
+func (m *ProposalOptions) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MsgfeeSetMsgFeeMsg); ok {
+		return x.MsgfeeSetMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMsgfeeRemoveMsgFeeMsg() *msgfee.RemoveMsgFeeMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MsgfeeRemoveMsgFeeMsg); ok {
+		return x.MsgfeeRemoveMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCronUpdateConfigurationMsg() *cron.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CronUpdateConfigurationMsg); ok {
+		return x.CronUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetDistributionUpdateConfigurationMsg() *distribution.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_DistributionUpdateConfigurationMsg); ok {
+		return x.DistributionUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGasUpdateConfigurationMsg() *gas.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GasUpdateConfigurationMsg); ok {
+		return x.GasUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
@@ -1814,6 +2336,13 @@ func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ProposalOptions_GovUpdateElectorateMsg)(nil),
 		(*ProposalOptions_GovUpdateElectionRuleMsg)(nil),
 		(*ProposalOptions_GovCreateTextResolutionMsg)(nil),
+		(*ProposalOptions_MsgfeeSetMsgFeeMsg)(nil),
+		(*ProposalOptions_MsgfeeRemoveMsgFeeMsg)(nil),
+		(*ProposalOptions_CronCancelTaskMsg)(nil),
+		(*ProposalOptions_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_CronUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_DistributionUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_GasUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -1906,6 +2435,41 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
 			return err
 		}
+	case *ProposalOptions_MsgfeeSetMsgFeeMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MsgfeeRemoveMsgFeeMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeRemoveMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CronCancelTaskMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CronUpdateConfigurationMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_DistributionUpdateConfigurationMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GasUpdateConfigurationMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GasUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -2052,20 +2616,76 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_GovCreateTextResolutionMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ProposalOptions)
-	// option
-	switch x := m.Option.(type) {
-	case *ProposalOptions_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
+	case 80: // option.msgfee_set_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.SetMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{msg}
+		return true, err
+	case 81: // option.msgfee_remove_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.RemoveMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MsgfeeRemoveMsgFeeMsg{msg}
+		return true, err
+	case 82: // option.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CronCancelTaskMsg{msg}
+		return true, err
+	case 83: // option.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 84: // option.cron_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CronUpdateConfigurationMsg{msg}
+		return true, err
+	case 85: // option.distribution_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_DistributionUpdateConfigurationMsg{msg}
+		return true, err
+	case 86: // option.gas_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gas.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GasUpdateConfigurationMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *ProposalOptions_EscrowReleaseMsg:
 		s := proto.Size(x.EscrowReleaseMsg)
 		n += 2 // tag and wire
@@ -2146,6 +2766,41 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ProposalOptions_MsgfeeSetMsgFeeMsg:
+		s := proto.Size(x.MsgfeeSetMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MsgfeeRemoveMsgFeeMsg:
+		s := proto.Size(x.MsgfeeRemoveMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CronUpdateConfigurationMsg:
+		s := proto.Size(x.CronUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_DistributionUpdateConfigurationMsg:
+		s := proto.Size(x.DistributionUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GasUpdateConfigurationMsg:
+		s := proto.Size(x.GasUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2213,6 +2868,13 @@ type ExecuteProposalBatchMsg_Union struct {
 	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg
 	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg
 	//	*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg
+	//	*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg
+	//	*ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg
+	//	*ExecuteProposalBatchMsg_Union_CronCancelTaskMsg
+	//	*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg
+	//	*ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg
 	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2297,23 +2959,58 @@ type ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg struct {
 type ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg struct {
 	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
 }
+type ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg struct {
+	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg struct {
+	MsgfeeRemoveMsgFeeMsg *msgfee.RemoveMsgFeeMsg `protobuf:"bytes,81,opt,name=msgfee_remove_msg_fee_msg,json=msgfeeRemoveMsgFeeMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CronCancelTaskMsg struct {
+	CronCancelTaskMsg *cron.CancelTaskMsg `protobuf:"bytes,82,opt,name=cron_cancel_task_msg,json=cronCancelTaskMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,83,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg struct {
+	CronUpdateConfigurationMsg *cron.UpdateConfigurationMsg `protobuf:"bytes,84,opt,name=cron_update_configuration_msg,json=cronUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg struct {
+	DistributionUpdateConfigurationMsg *distribution.UpdateConfigurationMsg `protobuf:"bytes,85,opt,name=distribution_update_configuration_msg,json=distributionUpdateConfigurationMsg,proto3,oneof"`
+}
+type ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg struct {
+	GasUpdateConfigurationMsg *gas.UpdateConfigurationMsg `protobuf:"bytes,86,opt,name=gas_update_configuration_msg,json=gasUpdateConfigurationMsg,proto3,oneof"`
+}
 
-func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
-func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
-func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
-func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
-func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                {}
+func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()       {}
+func (*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) isExecuteProposalBatchMsg_Union_Sum()      {}
+func (*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
-func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()          {}
-func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
-func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_DistributionCreateMsg) isExecuteProposalBatchMsg_Union_Sum()  {}
+func (*ExecuteProposalBatchMsg_Union_DistributionMsg) isExecuteProposalBatchMsg_Union_Sum()        {}
+func (*ExecuteProposalBatchMsg_Union_DistributionResetMsg) isExecuteProposalBatchMsg_Union_Sum()   {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
+func (*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum()    {}
+func (*ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_CronCancelTaskMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
+func (*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
 
 func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
 	if m != nil {
@@ -2420,6 +3117,55 @@ func (m *ExecuteProposalBatchMsg_Union) GetGovCreateTextResolutionMsg() *gov.Cre
 	return nil
 }
 
+func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg); ok {
+		return x.MsgfeeSetMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeRemoveMsgFeeMsg() *msgfee.RemoveMsgFeeMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg); ok {
+		return x.MsgfeeRemoveMsgFeeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCronCancelTaskMsg() *cron.CancelTaskMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CronCancelTaskMsg); ok {
+		return x.CronCancelTaskMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetCronUpdateConfigurationMsg() *cron.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg); ok {
+		return x.CronUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetDistributionUpdateConfigurationMsg() *distribution.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg); ok {
+		return x.DistributionUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetGasUpdateConfigurationMsg() *gas.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg); ok {
+		return x.GasUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
@@ -2437,6 +3183,13 @@ func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message,
 		(*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CronCancelTaskMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -2514,6 +3267,41 @@ func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.B
 		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
 			return err
 		}
+	case *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg:
+		_ = b.EncodeVarint(80<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeRemoveMsgFeeMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CronCancelTaskMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronCancelTaskMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CronUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.DistributionUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GasUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
@@ -2636,6 +3424,62 @@ func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wir
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{msg}
 		return true, err
+	case 80: // sum.msgfee_set_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.SetMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{msg}
+		return true, err
+	case 81: // sum.msgfee_remove_msg_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.RemoveMsgFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{msg}
+		return true, err
+	case 82: // sum.cron_cancel_task_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.CancelTaskMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CronCancelTaskMsg{msg}
+		return true, err
+	case 83: // sum.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 84: // sum.cron_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cron.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg{msg}
+		return true, err
+	case 85: // sum.distribution_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(distribution.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg{msg}
+		return true, err
+	case 86: // sum.gas_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gas.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2715,6 +3559,41 @@ func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg:
+		s := proto.Size(x.MsgfeeSetMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg:
+		s := proto.Size(x.MsgfeeRemoveMsgFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CronCancelTaskMsg:
+		s := proto.Size(x.CronCancelTaskMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg:
+		s := proto.Size(x.CronUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg:
+		s := proto.Size(x.DistributionUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg:
+		s := proto.Size(x.GasUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2743,6 +3622,7 @@ type CronTask struct {
 	//	*CronTask_EscrowReturnMsg
 	//	*CronTask_DistributionDistributeMsg
 	//	*CronTask_AswapReleaseMsg
+	//	*CronTask_AswapReturnMsg
 	//	*CronTask_GovTallyMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
@@ -2798,6 +3678,9 @@ type CronTask_DistributionDistributeMsg struct {
 type CronTask_AswapReleaseMsg struct {
 	AswapReleaseMsg *aswap.ReleaseMsg `protobuf:"bytes,71,opt,name=aswap_release_msg,json=aswapReleaseMsg,proto3,oneof"`
 }
+type CronTask_AswapReturnMsg struct {
+	AswapReturnMsg *aswap.ReturnMsg `protobuf:"bytes,72,opt,name=aswap_return_msg,json=aswapReturnMsg,proto3,oneof"`
+}
 type CronTask_GovTallyMsg struct {
 	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
 }
@@ -2806,6 +3689,7 @@ func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()          {}
 func (*CronTask_EscrowReturnMsg) isCronTask_Sum()           {}
 func (*CronTask_DistributionDistributeMsg) isCronTask_Sum() {}
 func (*CronTask_AswapReleaseMsg) isCronTask_Sum()           {}
+func (*CronTask_AswapReturnMsg) isCronTask_Sum()            {}
 func (*CronTask_GovTallyMsg) isCronTask_Sum()               {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
@@ -2850,6 +3734,13 @@ func (m *CronTask) GetAswapReleaseMsg() *aswap.ReleaseMsg {
 	return nil
 }
 
+func (m *CronTask) GetAswapReturnMsg() *aswap.ReturnMsg {
+	if x, ok := m.GetSum().(*CronTask_AswapReturnMsg); ok {
+		return x.AswapReturnMsg
+	}
+	return nil
+}
+
 func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	if x, ok := m.GetSum().(*CronTask_GovTallyMsg); ok {
 		return x.GovTallyMsg
@@ -2864,6 +3755,7 @@ func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*CronTask_EscrowReturnMsg)(nil),
 		(*CronTask_DistributionDistributeMsg)(nil),
 		(*CronTask_AswapReleaseMsg)(nil),
+		(*CronTask_AswapReturnMsg)(nil),
 		(*CronTask_GovTallyMsg)(nil),
 	}
 }
@@ -2892,6 +3784,11 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.AswapReleaseMsg); err != nil {
 			return err
 		}
+	case *CronTask_AswapReturnMsg:
+		_ = b.EncodeVarint(72<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.AswapReturnMsg); err != nil {
+			return err
+		}
 	case *CronTask_GovTallyMsg:
 		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
@@ -2939,6 +3836,14 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_AswapReleaseMsg{msg}
 		return true, err
+	case 72: // sum.aswap_return_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(aswap.ReturnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_AswapReturnMsg{msg}
+		return true, err
 	case 76: // sum.gov_tally_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -2976,6 +3881,11 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_AswapReturnMsg:
+		s := proto.Size(x.AswapReturnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *CronTask_GovTallyMsg:
 		s := proto.Size(x.GovTallyMsg)
 		n += 2 // tag and wire
@@ -3001,88 +3911,101 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 1284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x99, 0xdd, 0x6e, 0x1b, 0x45,
-	0x1b, 0xc7, 0x93, 0x26, 0xed, 0x9b, 0x4e, 0xd2, 0x26, 0x9e, 0xb6, 0x89, 0xe3, 0xb6, 0x4e, 0x9b,
-	0x57, 0x42, 0x15, 0x12, 0xbb, 0xa8, 0xe1, 0x9b, 0x96, 0x08, 0x3b, 0x2e, 0x29, 0xd0, 0xa4, 0x38,
-	0x0e, 0x27, 0x14, 0xac, 0xf1, 0xee, 0x78, 0xbd, 0xca, 0x7a, 0xc7, 0xda, 0x99, 0x75, 0x36, 0xc7,
-	0xdc, 0x00, 0x97, 0xc0, 0x7d, 0x70, 0x03, 0x3d, 0xec, 0x21, 0x47, 0x15, 0x4a, 0x2e, 0x81, 0x33,
-	0x38, 0x41, 0xf3, 0xb5, 0x9e, 0x59, 0x27, 0x14, 0x28, 0xe2, 0x4b, 0x3e, 0xcb, 0x3c, 0xff, 0x67,
-	0x7e, 0xf3, 0xb9, 0xff, 0x67, 0xdc, 0x82, 0xb2, 0xd7, 0xf7, 0xdd, 0x4e, 0x4c, 0x7d, 0x17, 0x0d,
-	0x06, 0xae, 0x47, 0x7c, 0xec, 0x39, 0x83, 0x84, 0x30, 0x02, 0x67, 0x79, 0xb4, 0xb2, 0x96, 0xeb,
-	0x99, 0x9b, 0x52, 0x9c, 0xc4, 0xa8, 0x8f, 0xcd, 0xb4, 0xca, 0xd5, 0x80, 0x04, 0x44, 0xfc, 0xe9,
-	0xf2, 0xbf, 0x54, 0xf4, 0x5a, 0x3f, 0x0c, 0x12, 0xc4, 0x42, 0x12, 0x5b, 0xc9, 0x57, 0x32, 0x17,
-	0xd1, 0x43, 0x64, 0x0d, 0x54, 0x81, 0x99, 0xeb, 0x21, 0xda, 0xb3, 0x62, 0xcb, 0x99, 0xeb, 0xa5,
-	0x49, 0x82, 0x63, 0xef, 0xc8, 0x8a, 0x57, 0x32, 0xd7, 0x0f, 0x29, 0x4b, 0xc2, 0x4e, 0x3a, 0x06,
-	0xbf, 0x9a, 0xb9, 0x98, 0x7a, 0x09, 0x39, 0xb4, 0xa2, 0xa5, 0xcc, 0x0d, 0xc8, 0xb0, 0x08, 0xef,
-	0xa7, 0x11, 0x0b, 0x69, 0x18, 0x14, 0x27, 0x42, 0xc3, 0x80, 0x5a, 0xb1, 0x72, 0xe6, 0x0e, 0x51,
-	0x14, 0xfa, 0x88, 0x91, 0xc4, 0x52, 0xd6, 0x7f, 0x5e, 0x04, 0xe7, 0x5a, 0x19, 0xbc, 0x0d, 0x66,
-	0xbb, 0x18, 0xd3, 0xf2, 0xf4, 0xad, 0xe9, 0x3b, 0xf3, 0x77, 0x2f, 0x39, 0x7c, 0x29, 0xce, 0x03,
-	0x8c, 0x1f, 0xc6, 0x5d, 0xd2, 0x14, 0x12, 0xbc, 0x0b, 0x00, 0x0d, 0x83, 0x18, 0xb1, 0x34, 0xc1,
-	0xb4, 0x7c, 0xee, 0xd6, 0xcc, 0x9d, 0xf9, 0xbb, 0xd0, 0xe1, 0x43, 0x39, 0x7b, 0xcc, 0xdf, 0xd3,
-	0x52, 0xd3, 0xc8, 0x82, 0x15, 0x30, 0xa7, 0xe7, 0x58, 0x9e, 0xbd, 0x35, 0x73, 0x67, 0xa1, 0x99,
-	0xb7, 0xe1, 0x06, 0xb8, 0xc4, 0x47, 0x69, 0x53, 0x1c, 0xfb, 0xed, 0x3e, 0x0d, 0xca, 0x1b, 0xe6,
-	0xd8, 0x7b, 0x38, 0xf6, 0x1f, 0xd1, 0x60, 0x7b, 0xaa, 0x39, 0xcf, 0xdb, 0xaa, 0x09, 0x37, 0x41,
-	0x49, 0xee, 0x4e, 0xdb, 0x4b, 0x30, 0x62, 0x58, 0x74, 0x7c, 0x43, 0x74, 0x2c, 0x39, 0x52, 0x71,
-	0xea, 0x42, 0x91, 0x9d, 0x17, 0x65, 0x2c, 0x0f, 0xc1, 0x1a, 0x80, 0x0a, 0x90, 0xe0, 0x08, 0x23,
-	0x2a, 0x09, 0x6f, 0x0a, 0x02, 0xd4, 0x84, 0xa6, 0x94, 0x24, 0x62, 0x49, 0x06, 0x47, 0x31, 0x63,
-	0x12, 0x09, 0x66, 0x69, 0x12, 0x0b, 0xc4, 0x5b, 0xf6, 0x24, 0x9a, 0x42, 0xb1, 0x26, 0x91, 0x87,
-	0xe0, 0x3e, 0x58, 0x55, 0x80, 0x74, 0xe0, 0xf3, 0x55, 0x0c, 0x50, 0xc2, 0x42, 0x4c, 0x05, 0xe8,
-	0x6d, 0x01, 0x2a, 0x6b, 0xd0, 0xbe, 0xc8, 0x78, 0x2c, 0x13, 0x24, 0x6f, 0x59, 0x4a, 0x45, 0x05,
-	0x36, 0xc0, 0x15, 0xbd, 0xbb, 0xe6, 0xf6, 0xbc, 0x23, 0x80, 0x57, 0x1c, 0xad, 0x59, 0x1b, 0x54,
-	0xd2, 0xd1, 0xd1, 0x16, 0x99, 0x18, 0x35, 0x3f, 0x8e, 0x79, 0xb7, 0x88, 0x91, 0xe3, 0x17, 0x30,
-	0x79, 0x90, 0x2f, 0x72, 0x74, 0xe7, 0xda, 0x68, 0x30, 0x88, 0x8e, 0xda, 0x7e, 0xd8, 0xed, 0x0a,
-	0xd8, 0x7b, 0x6a, 0x91, 0xa3, 0x0c, 0xe7, 0x43, 0x9e, 0xb1, 0x15, 0x76, 0xbb, 0x6a, 0x91, 0x23,
-	0xc9, 0x54, 0xf8, 0xec, 0xf4, 0x37, 0x65, 0x2e, 0xf2, 0x7d, 0x35, 0x3b, 0xad, 0xd9, 0x8b, 0xd4,
-	0xd1, 0xd1, 0x22, 0xeb, 0xa0, 0x84, 0x33, 0xec, 0xa5, 0x0c, 0xb7, 0x3b, 0x88, 0x79, 0x3d, 0x01,
-	0xb9, 0x27, 0x20, 0xd7, 0x1c, 0xee, 0x14, 0x4e, 0x43, 0xca, 0x35, 0xae, 0xea, 0x73, 0xb4, 0x43,
-	0xf0, 0x0b, 0x70, 0x5d, 0xbb, 0x49, 0x3b, 0xc1, 0x41, 0x48, 0x19, 0x4e, 0xda, 0x8c, 0x1c, 0x60,
-	0x79, 0x25, 0xee, 0x0b, 0x5c, 0xc5, 0xd1, 0x39, 0x4e, 0x53, 0xe5, 0xb4, 0x78, 0x8a, 0x64, 0x96,
-	0xb5, 0x58, 0xd4, 0x2c, 0x38, 0x4b, 0x50, 0x4c, 0xbb, 0x16, 0xfc, 0x83, 0x22, 0xbc, 0xa5, 0x72,
-	0x4e, 0x83, 0x17, 0x35, 0x78, 0x00, 0x6e, 0xe7, 0x70, 0xaf, 0x87, 0xe2, 0x00, 0x2b, 0x34, 0x43,
-	0x49, 0x80, 0x99, 0xbc, 0x89, 0x9b, 0x62, 0x88, 0xb5, 0xd1, 0x10, 0x75, 0x91, 0x29, 0x20, 0x2d,
-	0x99, 0x27, 0xc7, 0xb9, 0xa9, 0x33, 0x4e, 0x4d, 0x80, 0x9f, 0x81, 0x15, 0xd3, 0xee, 0xcc, 0x63,
-	0xab, 0x89, 0x21, 0x56, 0x1c, 0x53, 0xb7, 0x8e, 0xee, 0x9a, 0xa9, 0x8c, 0x8e, 0x6f, 0x1b, 0x2c,
-	0x59, 0x48, 0xce, 0xaa, 0x0b, 0xd6, 0x75, 0x9b, 0xb5, 0xa5, 0x1b, 0xda, 0x10, 0x4c, 0x95, 0x93,
-	0x76, 0xc0, 0xb2, 0x45, 0x4a, 0x30, 0xc5, 0x4c, 0xf0, 0xb6, 0x04, 0x6f, 0xd9, 0xe6, 0x35, 0xb9,
-	0x2c, 0x51, 0x57, 0x4d, 0x41, 0xc7, 0xe1, 0x57, 0xe0, 0x46, 0x5e, 0x35, 0xda, 0xe9, 0x20, 0x48,
-	0x90, 0x8f, 0xdb, 0xd4, 0xeb, 0xe1, 0x3e, 0x12, 0xd4, 0x86, 0x9a, 0x65, 0x9e, 0xe4, 0xec, 0xcb,
-	0xa4, 0x3d, 0x91, 0x23, 0xd1, 0xab, 0xb9, 0x5a, 0x14, 0xe1, 0x3d, 0xb0, 0x24, 0x8a, 0x8f, 0xb9,
-	0x8b, 0x0f, 0x04, 0x73, 0xc9, 0x11, 0x82, 0xb5, 0x7d, 0x97, 0x45, 0x68, 0xb4, 0x6f, 0x9b, 0xa0,
-	0x24, 0x7b, 0x9b, 0xee, 0xf7, 0x91, 0xb2, 0x2e, 0xd9, 0xdd, 0x32, 0xbf, 0x45, 0x11, 0x33, 0xbc,
-	0x2f, 0x1f, 0xde, 0xb0, 0xbe, 0x6d, 0x6b, 0x78, 0xd3, 0xf9, 0x2e, 0xab, 0xee, 0xda, 0xf8, 0x76,
-	0xc1, 0x4a, 0x40, 0x86, 0x7a, 0xea, 0x83, 0x84, 0x0c, 0x08, 0x45, 0x91, 0x80, 0x3c, 0x54, 0xbb,
-	0x1d, 0x90, 0xa1, 0x5a, 0xc1, 0x63, 0x25, 0xab, 0xdd, 0x0e, 0xc8, 0x70, 0x2c, 0xae, 0x81, 0x3e,
-	0x8e, 0x70, 0x11, 0xf8, 0xb1, 0x01, 0xdc, 0x12, 0xfa, 0x38, 0x70, 0x2c, 0x0e, 0x5f, 0x07, 0x0b,
-	0x1c, 0x38, 0x24, 0x6a, 0x6b, 0x3f, 0x11, 0x94, 0x05, 0x41, 0xf9, 0x9c, 0xe8, 0x6d, 0x05, 0x01,
-	0x19, 0xaa, 0x16, 0xf7, 0x39, 0xde, 0x43, 0x39, 0x25, 0x8e, 0xb0, 0xc7, 0x48, 0xa2, 0x4f, 0xe6,
-	0x91, 0xf2, 0x39, 0xde, 0x5d, 0x5a, 0x63, 0x23, 0x4f, 0x50, 0x3e, 0x17, 0x90, 0xe1, 0x29, 0x0a,
-	0x7c, 0x02, 0x6e, 0x14, 0xb1, 0xe2, 0x7a, 0xa6, 0x91, 0x24, 0xef, 0xa8, 0xef, 0xbf, 0x40, 0xe6,
-	0x57, 0x31, 0x8d, 0x14, 0xbb, 0x6c, 0xb3, 0x47, 0x5a, 0xed, 0x3c, 0x98, 0xa1, 0x69, 0x7f, 0xfd,
-	0xc7, 0x8b, 0x60, 0xb1, 0xe0, 0x73, 0xf0, 0x3e, 0x98, 0xeb, 0x63, 0x4a, 0x51, 0x20, 0x9e, 0x03,
-	0x33, 0xe2, 0xb2, 0x9e, 0x66, 0x88, 0xce, 0x7e, 0x1c, 0x92, 0xb8, 0x36, 0xfb, 0xf4, 0xf9, 0xda,
-	0x54, 0x33, 0xef, 0x52, 0xf9, 0xf6, 0x22, 0x38, 0x2f, 0x94, 0x49, 0x81, 0x9f, 0x14, 0xf8, 0xbf,
-	0xb1, 0xc0, 0x4f, 0x6a, 0xf3, 0xa4, 0x36, 0x17, 0x6a, 0xb3, 0x76, 0xbd, 0xef, 0xe6, 0xc1, 0xa2,
-	0xf6, 0xfc, 0xdd, 0x01, 0xcf, 0xa0, 0x7f, 0xcc, 0xac, 0xfe, 0x0c, 0xaf, 0xd9, 0x07, 0xab, 0xda,
-	0xe3, 0x25, 0xea, 0x77, 0x5a, 0x85, 0xec, 0xdc, 0x10, 0x09, 0x67, 0x58, 0xc5, 0x7f, 0xf6, 0x1b,
-	0x7f, 0x02, 0x2a, 0xfa, 0x11, 0x9f, 0x97, 0xfe, 0xe2, 0x6b, 0xfe, 0xa6, 0x55, 0xbc, 0xf4, 0xb1,
-	0x1b, 0xaf, 0xfa, 0x15, 0x7c, 0xba, 0x34, 0x71, 0x90, 0x89, 0x83, 0xfc, 0xe5, 0xaf, 0xfb, 0x7f,
-	0xe3, 0x63, 0x12, 0x76, 0x40, 0xd5, 0x78, 0xd5, 0x33, 0x9c, 0x31, 0xbe, 0xcf, 0x24, 0x1a, 0x1d,
-	0xde, 0xae, 0xe0, 0xdf, 0x30, 0x1e, 0xf7, 0x2d, 0x9c, 0xb1, 0x66, 0x9e, 0x24, 0x47, 0xa8, 0xe4,
-	0x4f, 0xfc, 0x31, 0xb5, 0x36, 0x07, 0x2e, 0x10, 0x61, 0xd5, 0xeb, 0x5f, 0x03, 0xb0, 0x72, 0xc6,
-	0xd7, 0x0c, 0x1b, 0x63, 0x6f, 0xd7, 0xff, 0xff, 0xea, 0xe7, 0xff, 0xc2, 0x37, 0xec, 0xab, 0x60,
-	0xee, 0x45, 0x15, 0xe1, 0x7f, 0x74, 0x52, 0x0d, 0x5e, 0xae, 0x1a, 0x4c, 0x8c, 0x76, 0x62, 0xb4,
-	0x45, 0xa3, 0x9d, 0x18, 0xe1, 0x19, 0x46, 0xa8, 0xdf, 0xb0, 0x33, 0x60, 0xae, 0x9e, 0x90, 0xb8,
-	0x85, 0xe8, 0x01, 0xdc, 0x01, 0x97, 0x51, 0xca, 0x7a, 0x38, 0x66, 0xa1, 0x27, 0x3e, 0x2f, 0x61,
-	0x7e, 0x0b, 0xb5, 0x57, 0x7e, 0x7a, 0xbe, 0xb6, 0x1e, 0x84, 0xac, 0x97, 0x76, 0x1c, 0x8f, 0xf4,
-	0xdd, 0x90, 0x0c, 0x5f, 0x23, 0x31, 0x76, 0x0f, 0x31, 0x1a, 0x62, 0xa7, 0x4e, 0x62, 0x3f, 0x14,
-	0xd3, 0x2f, 0xf4, 0xfe, 0x67, 0xfc, 0x86, 0xfe, 0x12, 0x5c, 0xb7, 0x6e, 0x54, 0xde, 0xc0, 0xbf,
-	0xfd, 0x9a, 0xae, 0x9a, 0xaa, 0x25, 0xbe, 0xfc, 0xbf, 0x84, 0x6d, 0x80, 0x4b, 0xfc, 0xb0, 0x19,
-	0x8a, 0xa2, 0x23, 0xd1, 0xf9, 0x53, 0x55, 0x1f, 0xf8, 0xd9, 0xb6, 0x78, 0x54, 0xfd, 0x62, 0x08,
-	0xc8, 0x50, 0x37, 0xd5, 0xe9, 0xd5, 0xca, 0x4f, 0x8f, 0xab, 0xd3, 0xcf, 0x8e, 0xab, 0xd3, 0x3f,
-	0x1c, 0x57, 0xa7, 0xbf, 0x39, 0xa9, 0x4e, 0x3d, 0x3b, 0xa9, 0x4e, 0x7d, 0x7f, 0x52, 0x9d, 0xea,
-	0x5c, 0x10, 0xff, 0x2d, 0xb3, 0xf1, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0xe8, 0x94, 0x96,
-	0xd2, 0x1a, 0x00, 0x00,
+	// 1495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x9a, 0x49, 0x6f, 0xdb, 0x46,
+	0x14, 0xc7, 0xed, 0xd8, 0x49, 0x8d, 0xb1, 0xe3, 0x65, 0xe2, 0x45, 0x56, 0x1c, 0x39, 0x71, 0x17,
+	0x04, 0x05, 0x4a, 0x16, 0x71, 0xf7, 0x26, 0x0d, 0x2a, 0xd9, 0x6e, 0x92, 0x36, 0x9b, 0x24, 0xe7,
+	0xd2, 0x34, 0xc4, 0x98, 0x1a, 0xd1, 0x84, 0x25, 0x8e, 0xc0, 0x21, 0x15, 0xf9, 0xdc, 0x63, 0x2f,
+	0x05, 0xfa, 0x45, 0xfa, 0x31, 0x02, 0xf4, 0x92, 0x63, 0x4f, 0x41, 0x91, 0x7c, 0x8a, 0xf6, 0x54,
+	0xcc, 0x9b, 0x19, 0x92, 0x43, 0x49, 0x4d, 0x37, 0x34, 0x49, 0xc1, 0x9b, 0xf8, 0xfe, 0x6f, 0x7e,
+	0x6f, 0x16, 0xf2, 0xbd, 0x47, 0xda, 0xa8, 0xe4, 0x76, 0x5b, 0xf6, 0x41, 0xc0, 0x5b, 0x36, 0xe9,
+	0xf5, 0x6c, 0x97, 0xb5, 0xa8, 0x6b, 0xf5, 0x42, 0x16, 0x31, 0x3c, 0x2d, 0xac, 0xe5, 0xcd, 0x44,
+	0x1f, 0xd8, 0x31, 0xa7, 0x61, 0x40, 0xba, 0x34, 0xeb, 0x56, 0x5e, 0xf6, 0x98, 0xc7, 0xe0, 0xa7,
+	0x2d, 0x7e, 0x29, 0xeb, 0x4a, 0xd7, 0xf7, 0x42, 0x12, 0xf9, 0x2c, 0x30, 0x9c, 0xcf, 0x0c, 0x6c,
+	0xc2, 0x1f, 0x12, 0x23, 0x50, 0x19, 0x0f, 0x6c, 0x97, 0xf0, 0xc3, 0x21, 0x5b, 0x98, 0x1b, 0xbc,
+	0x3a, 0xb0, 0xdd, 0x38, 0x0c, 0x69, 0xe0, 0x1e, 0x1b, 0xf6, 0xf2, 0xc0, 0x6e, 0xf9, 0x3c, 0x0a,
+	0xfd, 0x83, 0x78, 0x28, 0xe0, 0xf2, 0xc0, 0xa6, 0xdc, 0x0d, 0xd9, 0x43, 0xc3, 0xba, 0x34, 0xb0,
+	0x3d, 0xc2, 0x87, 0x4c, 0xac, 0x9f, 0x1f, 0xdb, 0xe5, 0x5e, 0x9b, 0xd2, 0xfc, 0x2c, 0xba, 0x71,
+	0x27, 0xf2, 0xb9, 0xef, 0xe5, 0x67, 0xcc, 0x7d, 0xcf, 0x84, 0x96, 0x06, 0x76, 0x9f, 0x74, 0xfc,
+	0x16, 0x89, 0x58, 0x68, 0x28, 0x5b, 0xdf, 0xad, 0xa0, 0x13, 0xcd, 0x01, 0xbe, 0x80, 0xa6, 0xdb,
+	0x94, 0xf2, 0xd2, 0xe4, 0xf9, 0xc9, 0x8b, 0xb3, 0x97, 0x4e, 0x5b, 0x62, 0x1f, 0xac, 0x3d, 0x4a,
+	0xaf, 0x07, 0x6d, 0x56, 0x07, 0x09, 0x5f, 0x42, 0x88, 0xfb, 0x5e, 0x40, 0xa2, 0x38, 0xa4, 0xbc,
+	0x74, 0xe2, 0xfc, 0xd4, 0xc5, 0xd9, 0x4b, 0xd8, 0x12, 0xa1, 0xac, 0x46, 0xd4, 0x6a, 0x68, 0xa9,
+	0x9e, 0xf1, 0xc2, 0x65, 0x34, 0xa3, 0xe7, 0x58, 0x9a, 0x3e, 0x3f, 0x75, 0x71, 0xae, 0x9e, 0x5c,
+	0xe3, 0x6d, 0x74, 0x5a, 0x44, 0x71, 0x38, 0x0d, 0x5a, 0x4e, 0x97, 0x7b, 0xa5, 0xed, 0x6c, 0xec,
+	0x06, 0x0d, 0x5a, 0x37, 0xb9, 0x77, 0x6d, 0xa2, 0x3e, 0x2b, 0xae, 0xd5, 0x25, 0xbe, 0x8a, 0x96,
+	0xe4, 0x36, 0x3a, 0x6e, 0x48, 0x49, 0x44, 0x61, 0xe0, 0x7b, 0x30, 0x70, 0xc9, 0x92, 0x8a, 0x55,
+	0x03, 0x45, 0x0e, 0x5e, 0x90, 0xb6, 0xc4, 0x84, 0xab, 0x08, 0x2b, 0x40, 0x48, 0x3b, 0x94, 0x70,
+	0x49, 0x78, 0x1f, 0x08, 0x58, 0x13, 0xea, 0x52, 0x92, 0x88, 0x45, 0x69, 0x4c, 0x6d, 0x99, 0x49,
+	0x84, 0x34, 0x8a, 0xc3, 0x00, 0x10, 0x1f, 0x98, 0x93, 0xa8, 0x83, 0x62, 0x4c, 0x22, 0x31, 0xe1,
+	0x7d, 0xb4, 0xae, 0x00, 0x71, 0xaf, 0x25, 0x56, 0xd1, 0x23, 0x61, 0xe4, 0x53, 0x0e, 0xa0, 0x0f,
+	0x01, 0x54, 0xd2, 0xa0, 0x7d, 0xf0, 0xb8, 0x23, 0x1d, 0x24, 0x6f, 0x55, 0x4a, 0x79, 0x05, 0xef,
+	0xa2, 0x33, 0x7a, 0x77, 0xb3, 0xdb, 0xf3, 0x11, 0x00, 0xcf, 0x58, 0x5a, 0x33, 0x36, 0x68, 0x49,
+	0x5b, 0xd3, 0x2d, 0xca, 0x62, 0xd4, 0xfc, 0x04, 0xe6, 0xe3, 0x3c, 0x46, 0xc6, 0xcf, 0x61, 0x12,
+	0xa3, 0x58, 0x64, 0x7a, 0xcf, 0x39, 0xa4, 0xd7, 0xeb, 0x1c, 0x3b, 0x2d, 0xbf, 0xdd, 0x06, 0xd8,
+	0x27, 0x6a, 0x91, 0xa9, 0x87, 0xf5, 0xb9, 0xf0, 0xd8, 0xf1, 0xdb, 0x6d, 0xb5, 0xc8, 0x54, 0xca,
+	0x2a, 0x62, 0x76, 0xfa, 0xe1, 0xcb, 0x2e, 0xf2, 0x53, 0x35, 0x3b, 0xad, 0x99, 0x8b, 0xd4, 0xd6,
+	0x74, 0x91, 0x35, 0xb4, 0x44, 0x07, 0xd4, 0x8d, 0x23, 0xea, 0x1c, 0x90, 0xc8, 0x3d, 0x04, 0xc8,
+	0x65, 0x80, 0xac, 0x58, 0x22, 0xcd, 0x58, 0xbb, 0x52, 0xae, 0x0a, 0x55, 0x9f, 0xa3, 0x69, 0xc2,
+	0x5f, 0xa3, 0xb3, 0x3a, 0x15, 0x39, 0x21, 0xf5, 0x7c, 0x1e, 0xd1, 0xd0, 0x89, 0xd8, 0x11, 0x95,
+	0xb7, 0xc4, 0x15, 0xc0, 0x95, 0x2d, 0xed, 0x63, 0xd5, 0x95, 0x4f, 0x53, 0xb8, 0x48, 0x66, 0x49,
+	0x8b, 0x79, 0xcd, 0x80, 0x47, 0x21, 0x09, 0x78, 0xdb, 0x80, 0x7f, 0x96, 0x87, 0x37, 0x95, 0xcf,
+	0x28, 0x78, 0x5e, 0xc3, 0x47, 0xe8, 0x42, 0x02, 0x77, 0x0f, 0x49, 0xe0, 0x51, 0x85, 0x8e, 0x48,
+	0xe8, 0xd1, 0x48, 0xde, 0x89, 0x57, 0x21, 0xc4, 0x66, 0x1a, 0xa2, 0x06, 0x9e, 0x00, 0x69, 0x4a,
+	0x3f, 0x19, 0xe7, 0x9c, 0xf6, 0x18, 0xe9, 0x80, 0xef, 0xa2, 0xb5, 0x6c, 0x5e, 0xcc, 0x1e, 0x5b,
+	0x15, 0x42, 0xac, 0x59, 0x59, 0xdd, 0x38, 0xba, 0x95, 0xac, 0x92, 0x1e, 0xdf, 0x35, 0xb4, 0x68,
+	0x20, 0x05, 0xab, 0x06, 0xac, 0xb3, 0x26, 0x6b, 0x47, 0x5f, 0xe8, 0x84, 0x90, 0x55, 0x05, 0xe9,
+	0x16, 0x5a, 0x35, 0x48, 0x21, 0xe5, 0x34, 0x02, 0xde, 0x0e, 0xf0, 0x56, 0x4d, 0x5e, 0x5d, 0xc8,
+	0x12, 0xb5, 0x9c, 0x15, 0xb4, 0x1d, 0x3f, 0x40, 0x1b, 0x49, 0xc9, 0x71, 0xe2, 0x9e, 0x17, 0x92,
+	0x16, 0x75, 0xb8, 0x7b, 0x48, 0xbb, 0x04, 0xa8, 0xbb, 0x6a, 0x96, 0x89, 0x93, 0xb5, 0x2f, 0x9d,
+	0x1a, 0xe0, 0x23, 0xd1, 0xeb, 0x89, 0x9a, 0x17, 0xf1, 0x65, 0xb4, 0x08, 0x95, 0x2b, 0xbb, 0x8b,
+	0x7b, 0xc0, 0x5c, 0xb4, 0x40, 0x30, 0xb6, 0x6f, 0x1e, 0x4c, 0xe9, 0xbe, 0x5d, 0x45, 0x4b, 0x72,
+	0x74, 0x36, 0xfb, 0x7d, 0xa1, 0x52, 0x97, 0x1c, 0x6e, 0x24, 0xbf, 0x05, 0xb0, 0xa5, 0xa6, 0x34,
+	0x7c, 0x26, 0xf5, 0x5d, 0x33, 0xc2, 0x67, 0x33, 0xdf, 0xbc, 0x1a, 0xae, 0x2c, 0xf8, 0x36, 0x5a,
+	0xf3, 0x58, 0x5f, 0x4f, 0xbd, 0x17, 0xb2, 0x1e, 0xe3, 0xa4, 0x03, 0x90, 0xeb, 0x6a, 0xb7, 0x3d,
+	0xd6, 0x57, 0x2b, 0xb8, 0xa3, 0x64, 0xb5, 0xdb, 0x1e, 0xeb, 0x0f, 0xd9, 0x35, 0xb0, 0x45, 0x3b,
+	0x34, 0x0f, 0xbc, 0x91, 0x01, 0xee, 0x80, 0x3e, 0x0c, 0x1c, 0xb2, 0xe3, 0x77, 0xd1, 0x9c, 0x00,
+	0xf6, 0x99, 0xda, 0xda, 0x2f, 0x81, 0x32, 0x07, 0x94, 0x7b, 0x4c, 0x6f, 0x2b, 0xf2, 0x58, 0xff,
+	0x1e, 0x4b, 0xf2, 0x9c, 0x18, 0xa1, 0x32, 0x25, 0xed, 0x50, 0x37, 0x62, 0xa1, 0x3e, 0x99, 0x9b,
+	0x2a, 0xcf, 0x89, 0xe1, 0x32, 0x35, 0xee, 0x26, 0x0e, 0x2a, 0xcf, 0x79, 0xac, 0x3f, 0x42, 0xc1,
+	0xf7, 0xd1, 0x46, 0x1e, 0x0b, 0xb7, 0x67, 0xdc, 0x91, 0xe4, 0x5b, 0xea, 0xf9, 0xcf, 0x91, 0xc5,
+	0xad, 0x18, 0x77, 0x14, 0xbb, 0x64, 0xb2, 0x53, 0x0d, 0xdf, 0x40, 0xab, 0xb2, 0xa5, 0x70, 0xd4,
+	0xdd, 0xee, 0xb4, 0xa9, 0xe4, 0xde, 0x01, 0xee, 0xb2, 0x25, 0x65, 0xab, 0x01, 0x77, 0xf5, 0x1e,
+	0x55, 0x44, 0x2c, 0xcd, 0x59, 0x2b, 0x6e, 0xa0, 0x75, 0xc5, 0x0a, 0x69, 0x97, 0xf5, 0xa9, 0x81,
+	0xbb, 0xab, 0x1e, 0x70, 0x85, 0xab, 0x83, 0x47, 0x96, 0xb8, 0x22, 0x95, 0x9c, 0x80, 0xf7, 0xd0,
+	0xb2, 0xe8, 0xbb, 0x1c, 0x97, 0x04, 0x2e, 0xed, 0x38, 0x11, 0xe1, 0x47, 0xc0, 0xab, 0xeb, 0x3c,
+	0x1f, 0x8a, 0x44, 0x01, 0x62, 0x93, 0xf0, 0x23, 0x9d, 0xe7, 0x43, 0x16, 0x18, 0x46, 0xec, 0xa1,
+	0x4d, 0x35, 0x39, 0xb5, 0x93, 0x2e, 0x0b, 0xda, 0xbe, 0x17, 0xab, 0x07, 0x54, 0x20, 0x1b, 0x80,
+	0xac, 0xe8, 0x29, 0xca, 0x0d, 0xab, 0x65, 0xdd, 0x24, 0x7d, 0x43, 0x3a, 0x8c, 0xd6, 0x31, 0x41,
+	0xe7, 0x60, 0xc2, 0x63, 0xc3, 0x34, 0x21, 0xcc, 0x86, 0x9c, 0xf9, 0xd8, 0x20, 0x65, 0x21, 0x8f,
+	0x09, 0x71, 0x8c, 0xde, 0x34, 0x52, 0xd5, 0xd8, 0x50, 0xfb, 0x10, 0xea, 0x0d, 0x33, 0x73, 0x8d,
+	0x0d, 0xb9, 0x95, 0x75, 0x1b, 0x13, 0xfa, 0x01, 0xda, 0xf0, 0x08, 0x1f, 0x1f, 0xf1, 0x9e, 0xca,
+	0x6a, 0x1e, 0xe1, 0xe3, 0x03, 0xad, 0x7b, 0x84, 0x8f, 0x16, 0xab, 0x27, 0xd1, 0x14, 0x8f, 0xbb,
+	0x5b, 0xdf, 0x2e, 0xa0, 0x85, 0x5c, 0xdd, 0xc5, 0x57, 0xd0, 0x4c, 0x97, 0x72, 0x4e, 0x3c, 0x68,
+	0x4f, 0xa7, 0x20, 0xcc, 0xa8, 0x02, 0x6d, 0xed, 0x07, 0x3e, 0x0b, 0xaa, 0xd3, 0x8f, 0x9e, 0x6c,
+	0x4e, 0xd4, 0x93, 0x21, 0xe5, 0x1f, 0xe7, 0xd1, 0x49, 0x50, 0x8a, 0x86, 0xb3, 0x68, 0x38, 0x5f,
+	0x60, 0xc3, 0x59, 0xf4, 0x8a, 0x45, 0xaf, 0x98, 0xef, 0x15, 0x8b, 0x2a, 0x5c, 0x54, 0xe1, 0x97,
+	0xb0, 0x0a, 0xff, 0xb4, 0x88, 0x16, 0x74, 0x4f, 0x7c, 0xbb, 0x27, 0x44, 0xfe, 0xf7, 0x8a, 0xe7,
+	0xbf, 0x51, 0xfb, 0xf6, 0xd1, 0xba, 0x5a, 0xaf, 0x42, 0xfd, 0xc5, 0xd2, 0x25, 0x07, 0xef, 0x82,
+	0xc3, 0x98, 0xd2, 0xf5, 0xbf, 0xad, 0x39, 0xf7, 0x51, 0x59, 0x7f, 0xe4, 0x48, 0x5e, 0x8d, 0xf2,
+	0x5f, 0x3b, 0xce, 0x19, 0xcd, 0x94, 0x3e, 0xf6, 0xcc, 0x57, 0x8f, 0x35, 0x3a, 0x5a, 0x2a, 0x2a,
+	0x5a, 0x51, 0xd1, 0xfe, 0xf3, 0xaf, 0x1f, 0xaf, 0xe4, 0xcb, 0xf6, 0x01, 0xaa, 0x64, 0xbe, 0x7a,
+	0x44, 0x74, 0x10, 0x89, 0x7d, 0x66, 0x9d, 0xf4, 0xf0, 0x6e, 0xab, 0xaa, 0x94, 0x7e, 0xfc, 0x68,
+	0xd2, 0x41, 0x54, 0x4f, 0x9c, 0x54, 0x55, 0x4a, 0x3e, 0x81, 0x0c, 0xa9, 0x45, 0x2b, 0x51, 0xb4,
+	0x12, 0x2f, 0x55, 0x2b, 0x31, 0x83, 0x4e, 0x31, 0x68, 0x1d, 0xb6, 0x7e, 0x58, 0x40, 0x6b, 0x63,
+	0xaa, 0x0b, 0xde, 0x1d, 0x7a, 0xb7, 0x7f, 0xfd, 0x0f, 0xcb, 0xd1, 0x73, 0xdf, 0xf1, 0xdf, 0x46,
+	0x33, 0xcf, 0xeb, 0x50, 0x5e, 0xe3, 0x45, 0x77, 0xf2, 0xcf, 0xba, 0x93, 0xa2, 0xf0, 0x17, 0x85,
+	0x3f, 0x5f, 0xf8, 0x8b, 0xc2, 0x5c, 0x14, 0xe6, 0xa2, 0x30, 0xbf, 0x3a, 0xef, 0xf8, 0xbf, 0x4e,
+	0xa1, 0x99, 0x5a, 0xc8, 0x02, 0x71, 0x7a, 0xf8, 0x16, 0x9a, 0x27, 0x71, 0x74, 0x48, 0x83, 0xc8,
+	0x77, 0x21, 0xdd, 0x43, 0x31, 0x9e, 0xab, 0xbe, 0xf5, 0xdb, 0x93, 0xcd, 0x2d, 0xcf, 0x8f, 0x0e,
+	0xe3, 0x03, 0xcb, 0x65, 0x5d, 0xdb, 0x67, 0xfd, 0x77, 0x58, 0x40, 0xed, 0x87, 0x94, 0xf4, 0xa9,
+	0x55, 0x63, 0x41, 0xcb, 0x87, 0xc7, 0x29, 0x37, 0xfa, 0xe5, 0xf8, 0xe6, 0xfd, 0x0d, 0x3a, 0x6b,
+	0x9c, 0x61, 0x72, 0x41, 0xff, 0x7c, 0xda, 0x5c, 0xcf, 0xaa, 0x86, 0xf8, 0xa2, 0xff, 0x92, 0xba,
+	0x8d, 0x4e, 0x8b, 0xd4, 0x15, 0x91, 0x4e, 0xe7, 0x18, 0x86, 0x7e, 0xa5, 0xba, 0x1d, 0x91, 0xa9,
+	0x9a, 0xc2, 0x2a, 0xc7, 0xcd, 0x7a, 0xac, 0xaf, 0x2f, 0xd5, 0xd9, 0x57, 0x4b, 0x8f, 0x9e, 0x56,
+	0x26, 0x1f, 0x3f, 0xad, 0x4c, 0xfe, 0xf2, 0xb4, 0x32, 0xf9, 0xfd, 0xb3, 0xca, 0xc4, 0xe3, 0x67,
+	0x95, 0x89, 0x9f, 0x9f, 0x55, 0x26, 0x0e, 0x4e, 0xc1, 0x3f, 0x05, 0x6d, 0xff, 0x3e, 0x00, 0x20,
+	0x8a, 0x96, 0xec, 0x8d, 0x25, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -3540,61 +4463,173 @@ func (m *Tx_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *Tx_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n28, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n28
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn28, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *Tx_MsgfeeRemoveMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeRemoveMsgFeeMsg.Size()))
+		n29, err := m.MsgfeeRemoveMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn28
+		i += n29
 	}
 	return i, nil
 }
-
+func (m *Tx_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n30, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n31, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *Tx_CronUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronUpdateConfigurationMsg.Size()))
+		n32, err := m.CronUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+func (m *Tx_DistributionUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionUpdateConfigurationMsg.Size()))
+		n33, err := m.DistributionUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+func (m *Tx_GasUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GasUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasUpdateConfigurationMsg.Size()))
+		n34, err := m.GasUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn35, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn35
+	}
+	return i, nil
+}
+
 func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.CashSendMsg != nil {
@@ -3603,11 +4638,11 @@ func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n29, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n36, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n36
 	}
 	return i, nil
 }
@@ -3619,11 +4654,11 @@ func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n30, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		n37, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n37
 	}
 	return i, nil
 }
@@ -3635,11 +4670,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n31, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n38, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n38
 	}
 	return i, nil
 }
@@ -3651,11 +4686,11 @@ func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n32, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n39, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n39
 	}
 	return i, nil
 }
@@ -3667,11 +4702,11 @@ func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n33, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		n40, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n40
 	}
 	return i, nil
 }
@@ -3683,11 +4718,11 @@ func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n34, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		n41, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n41
 	}
 	return i, nil
 }
@@ -3699,11 +4734,11 @@ func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n35, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n42, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n42
 	}
 	return i, nil
 }
@@ -3715,11 +4750,11 @@ func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (i
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n36, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n43, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n43
 	}
 	return i, nil
 }
@@ -3731,11 +4766,11 @@ func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n37, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n44, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n44
 	}
 	return i, nil
 }
@@ -3747,11 +4782,11 @@ func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n38, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n45, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n45
 	}
 	return i, nil
 }
@@ -3763,11 +4798,11 @@ func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n39, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n46, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n46
 	}
 	return i, nil
 }
@@ -3779,11 +4814,11 @@ func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n40, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n47, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n47
 	}
 	return i, nil
 }
@@ -3795,11 +4830,11 @@ func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (in
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n41, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n48, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n48
 	}
 	return i, nil
 }
@@ -3811,11 +4846,11 @@ func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n42, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n49, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n49
 	}
 	return i, nil
 }
@@ -3827,11 +4862,123 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n43, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n50, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n50
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n51, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeRemoveMsgFeeMsg.Size()))
+		n52, err := m.MsgfeeRemoveMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n53, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n54, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CronUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronUpdateConfigurationMsg.Size()))
+		n55, err := m.CronUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionUpdateConfigurationMsg.Size()))
+		n56, err := m.DistributionUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_GasUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GasUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasUpdateConfigurationMsg.Size()))
+		n57, err := m.GasUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
 	}
 	return i, nil
 }
@@ -3851,11 +4998,11 @@ func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Option != nil {
-		nn44, err := m.Option.MarshalTo(dAtA[i:])
+		nn58, err := m.Option.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn44
+		i += nn58
 	}
 	return i, nil
 }
@@ -3868,11 +5015,11 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n45, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		n59, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n59
 	}
 	return i, nil
 }
@@ -3884,11 +5031,11 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n46, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n60, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n60
 	}
 	return i, nil
 }
@@ -3900,11 +5047,11 @@ func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n47, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n61, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n61
 	}
 	return i, nil
 }
@@ -3916,11 +5063,11 @@ func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n48, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n62, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n62
 	}
 	return i, nil
 }
@@ -3932,11 +5079,11 @@ func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n49, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n63, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n63
 	}
 	return i, nil
 }
@@ -3948,11 +5095,11 @@ func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n50, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		n64, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n64
 	}
 	return i, nil
 }
@@ -3964,11 +5111,11 @@ func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, e
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n51, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		n65, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n65
 	}
 	return i, nil
 }
@@ -3980,11 +5127,11 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n52, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n66, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n66
 	}
 	return i, nil
 }
@@ -3996,11 +5143,11 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n53, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n67, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n67
 	}
 	return i, nil
 }
@@ -4012,11 +5159,11 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n54, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n68, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n68
 	}
 	return i, nil
 }
@@ -4028,11 +5175,11 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n55, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n69, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n69
 	}
 	return i, nil
 }
@@ -4044,11 +5191,11 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n56, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n70, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n70
 	}
 	return i, nil
 }
@@ -4060,11 +5207,11 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n57, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n71, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n71
 	}
 	return i, nil
 }
@@ -4076,11 +5223,11 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n58, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		n72, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n72
 	}
 	return i, nil
 }
@@ -4092,11 +5239,11 @@ func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n59, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n73, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n73
 	}
 	return i, nil
 }
@@ -4108,11 +5255,11 @@ func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int,
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n60, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n74, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n74
 	}
 	return i, nil
 }
@@ -4124,11 +5271,123 @@ func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n61, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n75, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n75
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n76, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MsgfeeRemoveMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeRemoveMsgFeeMsg.Size()))
+		n77, err := m.MsgfeeRemoveMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n78, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n78
+	}
+	return i, nil
+}
+func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n79, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
+	}
+	return i, nil
+}
+func (m *ProposalOptions_CronUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronUpdateConfigurationMsg.Size()))
+		n80, err := m.CronUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n80
+	}
+	return i, nil
+}
+func (m *ProposalOptions_DistributionUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionUpdateConfigurationMsg.Size()))
+		n81, err := m.DistributionUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GasUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GasUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasUpdateConfigurationMsg.Size()))
+		n82, err := m.GasUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
 	return i, nil
 }
@@ -4178,11 +5437,11 @@ func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn62, err := m.Sum.MarshalTo(dAtA[i:])
+		nn83, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn62
+		i += nn83
 	}
 	return i, nil
 }
@@ -4195,11 +5454,11 @@ func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, err
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n63, err := m.SendMsg.MarshalTo(dAtA[i:])
+		n84, err := m.SendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n84
 	}
 	return i, nil
 }
@@ -4211,11 +5470,11 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n64, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n85, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n85
 	}
 	return i, nil
 }
@@ -4227,11 +5486,11 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n65, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		n86, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n86
 	}
 	return i, nil
 }
@@ -4243,11 +5502,11 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n66, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		n87, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n87
 	}
 	return i, nil
 }
@@ -4259,11 +5518,11 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n67, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		n88, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n88
 	}
 	return i, nil
 }
@@ -4275,11 +5534,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n68, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n89, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n89
 	}
 	return i, nil
 }
@@ -4291,11 +5550,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n69, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n90, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n90
 	}
 	return i, nil
 }
@@ -4307,11 +5566,11 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n70, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n91, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n91
 	}
 	return i, nil
 }
@@ -4323,11 +5582,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n71, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n92, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n92
 	}
 	return i, nil
 }
@@ -4339,11 +5598,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n72, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n93, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n93
 	}
 	return i, nil
 }
@@ -4355,11 +5614,11 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n73, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		n94, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n94
 	}
 	return i, nil
 }
@@ -4371,11 +5630,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n74, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n95, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n95
 	}
 	return i, nil
 }
@@ -4387,11 +5646,11 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n75, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		n96, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n96
 	}
 	return i, nil
 }
@@ -4403,11 +5662,123 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n76, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		n97, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n97
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n98, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeRemoveMsgFeeMsg.Size()))
+		n99, err := m.MsgfeeRemoveMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n99
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CronCancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronCancelTaskMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronCancelTaskMsg.Size()))
+		n100, err := m.CronCancelTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n100
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n101, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CronUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CronUpdateConfigurationMsg.Size()))
+		n102, err := m.CronUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionUpdateConfigurationMsg.Size()))
+		n103, err := m.DistributionUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GasUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasUpdateConfigurationMsg.Size()))
+		n104, err := m.GasUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
 	}
 	return i, nil
 }
@@ -4435,11 +5806,11 @@ func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.Sum != nil {
-		nn77, err := m.Sum.MarshalTo(dAtA[i:])
+		nn105, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn77
+		i += nn105
 	}
 	return i, nil
 }
@@ -4452,11 +5823,11 @@ func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n78, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n106, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n106
 	}
 	return i, nil
 }
@@ -4468,11 +5839,11 @@ func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n79, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		n107, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n107
 	}
 	return i, nil
 }
@@ -4484,11 +5855,11 @@ func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error)
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n80, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		n108, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n108
 	}
 	return i, nil
 }
@@ -4500,11 +5871,27 @@ func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n81, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		n109, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n109
+	}
+	return i, nil
+}
+func (m *CronTask_AswapReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AswapReturnMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReturnMsg.Size()))
+		n110, err := m.AswapReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
 	}
 	return i, nil
 }
@@ -4516,11 +5903,11 @@ func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n82, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		n111, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n111
 	}
 	return i, nil
 }
@@ -4723,140 +6110,224 @@ func (m *Tx_DistributionCreateMsg) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DistributionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionMsg != nil {
+		l = m.DistributionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DistributionResetMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionResetMsg != nil {
+		l = m.DistributionResetMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_AswapCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapCreateMsg != nil {
+		l = m.AswapCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_AswapReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapReleaseMsg != nil {
+		l = m.AswapReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_AswapReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapReturnMsg != nil {
+		l = m.AswapReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovCreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateProposalMsg != nil {
+		l = m.GovCreateProposalMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_DistributionMsg) Size() (n int) {
+func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionMsg != nil {
-		l = m.DistributionMsg.Size()
+	if m.GovDeleteProposalMsg != nil {
+		l = m.GovDeleteProposalMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_DistributionResetMsg) Size() (n int) {
+func (m *Tx_GovVoteMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionResetMsg != nil {
-		l = m.DistributionResetMsg.Size()
+	if m.GovVoteMsg != nil {
+		l = m.GovVoteMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
+func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MigrationUpgradeSchemaMsg != nil {
-		l = m.MigrationUpgradeSchemaMsg.Size()
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_AswapCreateMsg) Size() (n int) {
+func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AswapCreateMsg != nil {
-		l = m.AswapCreateMsg.Size()
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_AswapReleaseMsg) Size() (n int) {
+func (m *Tx_MsgfeeSetMsgFeeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AswapReleaseMsg != nil {
-		l = m.AswapReleaseMsg.Size()
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_AswapReturnMsg) Size() (n int) {
+func (m *Tx_MsgfeeRemoveMsgFeeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AswapReturnMsg != nil {
-		l = m.AswapReturnMsg.Size()
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		l = m.MsgfeeRemoveMsgFeeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_GovCreateProposalMsg) Size() (n int) {
+func (m *Tx_CronCancelTaskMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovCreateProposalMsg != nil {
-		l = m.GovCreateProposalMsg.Size()
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
+func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovDeleteProposalMsg != nil {
-		l = m.GovDeleteProposalMsg.Size()
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_GovVoteMsg) Size() (n int) {
+func (m *Tx_CronUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovVoteMsg != nil {
-		l = m.GovVoteMsg.Size()
+	if m.CronUpdateConfigurationMsg != nil {
+		l = m.CronUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
+func (m *Tx_DistributionUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectorateMsg != nil {
-		l = m.GovUpdateElectorateMsg.Size()
+	if m.DistributionUpdateConfigurationMsg != nil {
+		l = m.DistributionUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
+func (m *Tx_GasUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectionRuleMsg != nil {
-		l = m.GovUpdateElectionRuleMsg.Size()
+	if m.GasUpdateConfigurationMsg != nil {
+		l = m.GasUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
@@ -5068,6 +6539,90 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		l = m.MsgfeeRemoveMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CronCancelTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CronUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronUpdateConfigurationMsg != nil {
+		l = m.CronUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionUpdateConfigurationMsg != nil {
+		l = m.DistributionUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_GasUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUpdateConfigurationMsg != nil {
+		l = m.GasUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ProposalOptions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5284,6 +6839,90 @@ func (m *ProposalOptions_GovCreateTextResolutionMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MsgfeeRemoveMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		l = m.MsgfeeRemoveMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CronCancelTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CronUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CronUpdateConfigurationMsg != nil {
+		l = m.CronUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_DistributionUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionUpdateConfigurationMsg != nil {
+		l = m.DistributionUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GasUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUpdateConfigurationMsg != nil {
+		l = m.GasUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *ExecuteProposalBatchMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5413,68 +7052,152 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionMsg != nil {
+		l = m.DistributionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionResetMsg != nil {
+		l = m.DistributionResetMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeRemoveMsgFeeMsg != nil {
+		l = m.MsgfeeRemoveMsgFeeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) Size() (n int) {
+func (m *ExecuteProposalBatchMsg_Union_CronCancelTaskMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionMsg != nil {
-		l = m.DistributionMsg.Size()
+	if m.CronCancelTaskMsg != nil {
+		l = m.CronCancelTaskMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) Size() (n int) {
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionResetMsg != nil {
-		l = m.DistributionResetMsg.Size()
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+func (m *ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectorateMsg != nil {
-		l = m.GovUpdateElectorateMsg.Size()
+	if m.CronUpdateConfigurationMsg != nil {
+		l = m.CronUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+func (m *ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectionRuleMsg != nil {
-		l = m.GovUpdateElectionRuleMsg.Size()
+	if m.DistributionUpdateConfigurationMsg != nil {
+		l = m.DistributionUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+func (m *ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovCreateTextResolutionMsg != nil {
-		l = m.GovCreateTextResolutionMsg.Size()
+	if m.GasUpdateConfigurationMsg != nil {
+		l = m.GasUpdateConfigurationMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
@@ -5545,6 +7268,18 @@ func (m *CronTask_AswapReleaseMsg) Size() (n int) {
 	}
 	return n
 }
+func (m *CronTask_AswapReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapReturnMsg != nil {
+		l = m.AswapReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
 func (m *CronTask_GovTallyMsg) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6474,7 +8209,252 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 75:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.VoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovVoteMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeRemoveMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.RemoveMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeRemoveMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.CancelTaskMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CronCancelTaskMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6501,15 +8481,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.VoteMsg{}
+			v := &cron.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovVoteMsg{v}
+			m.Sum = &Tx_CronUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6536,15 +8516,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &distribution.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			m.Sum = &Tx_DistributionUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6571,11 +8551,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &gas.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			m.Sum = &Tx_GasUpdateConfigurationMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6964,7 +8944,252 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.RegisterTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.TransferTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.DistributeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6991,15 +9216,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7026,15 +9251,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeRemoveMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7061,15 +9286,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &msgfee.RemoveMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7096,15 +9321,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &cron.CancelTaskMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CronCancelTaskMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7131,15 +9356,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7166,15 +9391,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &cron.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CronUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7201,15 +9426,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &distribution.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7236,11 +9461,11 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &gas.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_GasUpdateConfigurationMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7853,11 +10078,256 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 79:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateTextResolutionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeRemoveMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.RemoveMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_MsgfeeRemoveMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.CancelTaskMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CronCancelTaskMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_CronUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_DistributionUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 79:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7884,11 +10354,11 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateTextResolutionMsg{}
+			v := &gas.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
+			m.Option = &ProposalOptions_GasUpdateConfigurationMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8520,6 +10990,251 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeRemoveMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.RemoveMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeRemoveMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronCancelTaskMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.CancelTaskMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CronCancelTaskMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cron.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_CronUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_DistributionUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 86:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gas.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GasUpdateConfigurationMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -8745,6 +11460,41 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_AswapReleaseMsg{v}
 			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_AswapReturnMsg{v}
+			iNdEx = postIndex
 		case 76:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GovTallyMsg", wireType)
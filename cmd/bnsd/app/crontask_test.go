@@ -0,0 +1,60 @@
+package bnsd
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/aswap"
+	"github.com/iov-one/weave/x/distribution"
+	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gov"
+)
+
+func TestCronTaskMarshaler(t *testing.T) {
+	auth := []weave.Condition{weavetest.NewCondition()}
+
+	cases := map[string]weave.Msg{
+		"escrow release": &escrow.ReleaseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: weavetest.SequenceID(1),
+		},
+		"escrow return": &escrow.ReturnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: weavetest.SequenceID(2),
+		},
+		"distribution": &distribution.DistributeMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			RevenueID: weavetest.SequenceID(3),
+		},
+		"swap release": &aswap.ReleaseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			SwapID:   weavetest.SequenceID(4),
+			Preimage: make([]byte, 32),
+		},
+		"swap return": &aswap.ReturnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			SwapID:   weavetest.SequenceID(5),
+		},
+		"gov tally": &gov.TallyMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: weavetest.SequenceID(6),
+		},
+	}
+
+	for testName, msg := range cases {
+		t.Run(testName, func(t *testing.T) {
+			raw, err := CronTaskMarshaler.MarshalTask(auth, msg)
+			if err != nil {
+				t.Fatalf("cannot marshal task: %s", err)
+			}
+			gotAuth, gotMsg, err := CronTaskMarshaler.UnmarshalTask(raw)
+			if err != nil {
+				t.Fatalf("cannot unmarshal task: %s", err)
+			}
+			assert.Equal(t, auth, gotAuth)
+			assert.Equal(t, msg, gotMsg)
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -28,6 +30,7 @@ func decodeProposalOptions(raw []byte) (weave.Msg, error) {
 // such a setup can be easily extended to allow many more actions in other modules.
 func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
 	r := app.NewRouter()
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	// we only allow these to be authenticated by the governance context, not by sigs or other items
 	auth := gov.Authenticate{}
@@ -35,10 +38,12 @@ func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
 	// Make sure to register for all items in ProposalOptions
 	cash.RegisterRoutes(r, auth, ctrl)
 	validators.RegisterRoutes(r, auth)
-	escrow.RegisterRoutes(r, auth, ctrl)
+	escrow.RegisterRoutes(r, auth, ctrl, scheduler)
 	distribution.RegisterRoutes(r, auth, ctrl)
 	migration.RegisterRoutes(r, auth)
 	gov.RegisterBasicProposalRouters(r, auth)
+	msgfee.RegisterRoutes(r, auth)
+	cron.RegisterRoutes(r, auth, CronTaskMarshaler)
 
 	// We must wrap with batch middleware so it can process ExecuteProposalBatchMsg.
 	// We add ActionTagger here, so the messages executed as a result of a governance vote also get properly tagged.
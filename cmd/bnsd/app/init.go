@@ -13,14 +13,19 @@ import (
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/commands/server"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/aswap"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/validators"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -94,13 +99,23 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&migration.Initializer{},
+		&sigs.Initializer{},
 		&multisig.Initializer{},
 		&cash.Initializer{},
 		&currency.Initializer{},
 		&validators.Initializer{},
 		&distribution.Initializer{},
 		&msgfee.Initializer{},
-		&escrow.Initializer{Minter: cash.NewController(cash.NewBucket())},
+		&cron.Initializer{},
+		&gas.Initializer{},
+		&escrow.Initializer{
+			Minter:    cash.NewController(cash.NewBucket()),
+			Scheduler: cron.NewScheduler(CronTaskMarshaler),
+		},
+		&aswap.Initializer{
+			Minter:    cash.NewController(cash.NewBucket()),
+			Scheduler: cron.NewScheduler(CronTaskMarshaler),
+		},
 		&gov.Initializer{},
 		&username.Initializer{},
 	))
@@ -108,6 +123,46 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	return application
 }
 
+// GenerateExport is used to create a stub for server/export.go command. The
+// exporters are listed in the same order as the initializers in DecorateApp.
+func GenerateExport(home string) (weave.CommitKVStore, weave.Exporter, error) {
+	kv, err := CommitKVStore(filepath.Join(home, "bns.db"))
+	if err != nil {
+		return nil, nil, err
+	}
+	exporter := app.ChainExporters(
+		&migration.Initializer{},
+		&sigs.Initializer{},
+		&multisig.Initializer{},
+		&cash.Initializer{},
+		&currency.Initializer{},
+		&validators.Initializer{},
+		&distribution.Initializer{},
+		&msgfee.Initializer{},
+		&cron.Initializer{},
+		&gas.Initializer{},
+		&escrow.Initializer{},
+		&aswap.Initializer{},
+		&gov.Initializer{},
+		&username.Initializer{},
+	)
+	return kv, exporter, nil
+}
+
+// GenerateSnapshotStore is used to create a stub for server/snapshot.go
+// command.
+func GenerateSnapshotStore(home string) (server.SnapshotStore, error) {
+	kv, err := CommitKVStore(filepath.Join(home, "bns.db"))
+	if err != nil {
+		return nil, err
+	}
+	ss, ok := kv.(server.SnapshotStore)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "%T does not support snapshots", kv)
+	}
+	return ss, nil
+}
+
 // InlineApp will take a previously prepared CommitStore and return a complete Application
 func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Application {
 	minFee := coin.Coin{}
@@ -1,11 +1,30 @@
 package bnsd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/aswap"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/distribution"
+	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
+	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
+	"github.com/iov-one/weave/x/validators"
 )
 
 func TestGenInitOptions(t *testing.T) {
@@ -37,3 +56,189 @@ func TestGenInitOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestExportGenesis(t *testing.T) {
+	const genesis = `
+	{
+		"conf": {
+			"cash": {
+				"collector_address": "cond:foo/bar/0000000000000001",
+				"minimal_fee": {"whole": 0, "fractional": 10000000, "ticker": "IOV"}
+			},
+			"migration": {
+				"admin": "cond:foo/bar/0000000000000002"
+			}
+		},
+		"initialize_schema": [
+			{"pkg": "aswap", "ver": 1},
+			{"pkg": "cash", "ver": 1},
+			{"pkg": "currency", "ver": 1},
+			{"pkg": "distribution", "ver": 1},
+			{"pkg": "escrow", "ver": 1},
+			{"pkg": "gov", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
+			{"pkg": "multisig", "ver": 1},
+			{"pkg": "username", "ver": 1},
+			{"pkg": "validators", "ver": 1}
+		],
+		"cash": [
+			{
+				"address": "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf",
+				"coins": [{"whole": 123456789, "ticker": "IOV"}]
+			}
+		],
+		"currencies": [
+			{"ticker": "IOV", "name": "Main token of this chain"}
+		],
+		"multisig": [
+			{
+				"id": 3,
+				"participants": [
+					{"weight": 1, "signature": "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf"},
+					{"weight": 2, "signature": "904bc35e341b428d4faa535022b553efbc443d49"}
+				],
+				"activation_threshold": 2,
+				"admin_threshold": 3
+			}
+		],
+		"update_validators": {
+			"addresses": ["cond:foo/bar/0000000000000003"]
+		},
+		"distribution": [
+			{
+				"admin": "cond:foo/bar/0000000000000004",
+				"destinations": [
+					{"weight": 1, "address": "904bc35e341b428d4faa535022b553efbc443d49"}
+				]
+			}
+		],
+		"msgfee": [
+			{"msg_path": "cash/send", "fee": {"whole": 1, "ticker": "IOV"}}
+		],
+		"escrow": [
+			{
+				"id": 5,
+				"source": "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf",
+				"arbiter": "904bc35e341b428d4faa535022b553efbc443d49",
+				"destination": "91d66344d78599b66e1b504db958b1b07a8f5049",
+				"timeout": 1000000000,
+				"amount": [{"whole": 7, "ticker": "IOV"}]
+			}
+		],
+		"aswap": [
+			{
+				"id": 4,
+				"source": "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf",
+				"destination": "91d66344d78599b66e1b504db958b1b07a8f5049",
+				"preimage_hash": "zJEWbvoMp/8T8n2WJ7zq8bCD6PfqtjwNeE9hFeOfzsU=",
+				"timeout": 1000000000,
+				"amount": [{"whole": 9, "ticker": "IOV"}]
+			}
+		],
+		"governance": {
+			"electorate": [
+				{
+					"admin": "cond:foo/bar/0000000000000005",
+					"title": "first",
+					"electors": [
+						{"weight": 10, "address": "904bc35e341b428d4faa535022b553efbc443d49"}
+					]
+				}
+			],
+			"rules": [
+				{
+					"admin": "cond:foo/bar/0000000000000006",
+					"title": "fooo",
+					"voting_period": "1h",
+					"threshold": {"numerator": 2, "denominator": 3},
+					"electorate_id": 1
+				}
+			]
+		},
+		"username": [
+			{
+				"Username": "alice*iov",
+				"Owner": "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf",
+				"Targets": [{"blockchain_id": "mychain", "address": "123"}]
+			}
+		]
+	}`
+
+	initializer := app.ChainInitializers(
+		&migration.Initializer{},
+		&sigs.Initializer{},
+		&multisig.Initializer{},
+		&cash.Initializer{},
+		&currency.Initializer{},
+		&validators.Initializer{},
+		&distribution.Initializer{},
+		&msgfee.Initializer{},
+		&cron.Initializer{},
+		&escrow.Initializer{
+			Minter:    cash.NewController(cash.NewBucket()),
+			Scheduler: cron.NewScheduler(CronTaskMarshaler),
+		},
+		&aswap.Initializer{
+			Minter:    cash.NewController(cash.NewBucket()),
+			Scheduler: cron.NewScheduler(CronTaskMarshaler),
+		},
+		&gov.Initializer{},
+		&username.Initializer{},
+	)
+	home, err := ioutil.TempDir("", "bnsd")
+	assert.Nil(t, err)
+	defer os.RemoveAll(home)
+	_, exporter, err := GenerateExport(home)
+	assert.Nil(t, err)
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+	db := store.MemStore()
+	assert.Nil(t, initializer.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	exported := make(weave.Options)
+	assert.Nil(t, exporter.ExportGenesis(db, exported))
+	for name := range opts {
+		if _, ok := exported[name]; !ok {
+			t.Errorf("%q not exported", name)
+		}
+	}
+	// Escrow funds are exported as an account balance.
+	if !strings.Contains(string(exported["cash"]), `"whole":7`) {
+		t.Errorf("escrow funds not exported: %s", exported["cash"])
+	}
+
+	// Loading the exported state must result in the same state.
+	db = store.MemStore()
+	assert.Nil(t, initializer.FromGenesis(exported, weave.GenesisParams{}, db))
+	reexported := make(weave.Options)
+	assert.Nil(t, exporter.ExportGenesis(db, reexported))
+	for name, raw := range exported {
+		if want, got := string(raw), string(reexported[name]); want != got {
+			t.Errorf("%q state differs after re-import\nwant %s\n got %s", name, want, got)
+		}
+	}
+
+	// The escrow return is scheduled at genesis and the task is exported
+	// together with the escrow referencing it.
+	if !strings.Contains(string(exported["escrow"]), `"return_task_id"`) {
+		t.Errorf("escrow return task not exported: %s", exported["escrow"])
+	}
+	if !strings.Contains(string(exported["cron"]), `"task"`) {
+		t.Errorf("escrow return task not exported: %s", exported["cron"])
+	}
+
+	// Sequence IDs must be preserved.
+	if !strings.Contains(string(exported["escrow"]), `"id":5`) {
+		t.Errorf("escrow ID not preserved: %s", exported["escrow"])
+	}
+	if !strings.Contains(string(exported["aswap"]), `"id":4`) {
+		t.Errorf("swap ID not preserved: %s", exported["aswap"])
+	}
+	if !strings.Contains(string(exported["aswap"]), `"return_task_id"`) {
+		t.Errorf("swap return task not exported: %s", exported["aswap"])
+	}
+	if !strings.Contains(string(exported["multisig"]), `"id":3`) {
+		t.Errorf("contract ID not preserved: %s", exported["multisig"])
+	}
+}
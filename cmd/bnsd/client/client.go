@@ -154,12 +154,18 @@ type AbciResponse struct {
 	// a list of key/value pairs
 	Models []weave.Model
 	Height int64
+	// Cursor is set if the result is not complete. Use it as the
+	// weave.QueryOptions.After value to query for the next results.
+	Cursor []byte
 }
 
 // AbciQuery calls abci query on tendermint rpc,
 // verifies if it is an error or empty, and if there is
 // data pulls out the ResultSets from keys and values into
 // a useful AbciResponse struct
+//
+// Prefix query results are paginated. Use weave.QueryMod to build a path
+// with weave.QueryOptions to set the limit or continue from a cursor.
 func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 	var out AbciResponse
 
@@ -188,6 +194,7 @@ func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 		return out, err
 	}
 
+	out.Cursor = keys.Cursor
 	out.Models, err = app.JoinResults(&keys, &vals)
 	return out, err
 }
@@ -408,18 +415,24 @@ func (b *BnsClient) Currencies() (CurrenciesResponse, error) {
 		Currencies: make(map[string]currency.TokenInfo),
 	}
 
-	resp, err := b.AbciQuery("/tokens?prefix", nil)
-	if err != nil {
-		return out, errors.Wrap(err, "failed to query for all currencies")
-	}
-	for _, v := range resp.Models {
-		var ti currency.TokenInfo
-		if err := ti.Unmarshal(v.Value); err != nil {
-			return out, errors.Wrapf(err, "failed to unmarshal value of key %q", string(v.Key))
+	var opts weave.QueryOptions
+	for {
+		resp, err := b.AbciQuery("/tokens?"+weave.QueryMod(weave.PrefixQueryMod, opts), nil)
+		if err != nil {
+			return out, errors.Wrap(err, "failed to query for all currencies")
+		}
+		for _, v := range resp.Models {
+			var ti currency.TokenInfo
+			if err := ti.Unmarshal(v.Value); err != nil {
+				return out, errors.Wrapf(err, "failed to unmarshal value of key %q", string(v.Key))
+			}
+			out.Currencies[string(v.Key)] = ti
+		}
+		if resp.Cursor == nil {
+			return out, nil
 		}
-		out.Currencies[string(v.Key)] = ti
+		opts.After = resp.Cursor
 	}
-	return out, nil
 }
 
 // UserResponse is a response on a query for a User
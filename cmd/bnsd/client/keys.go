@@ -69,7 +69,8 @@ func LoadPrivateKey(filename string) (*PrivateKey, error) {
 }
 
 // SavePrivateKey will encode the private key in hex and write to
-// the named file
+// the named file. The key is stored in plain text, use Keystore to store
+// it encrypted.
 //
 // Refuses to overwrite a file unless force is true
 func SavePrivateKey(key *PrivateKey, filename string, force bool) error {
@@ -0,0 +1,279 @@
+package client
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeystoreVersion is the version of the keystore file format.
+const KeystoreVersion = 1
+
+// Scrypt parameters used to derive the encryption key from a passphrase.
+// Those values follow the recommendation for interactive logins from the
+// scrypt paper. Parameters are stored together with every key, so they can be
+// changed without breaking existing keystores.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 32
+)
+
+// Keystore is a file that holds any number of named private keys. Each key is
+// encrypted with AES-256-GCM, using a key derived from a passphrase with
+// scrypt. Names and addresses of the keys are stored in plain text, so that
+// the keys can be listed without providing a passphrase.
+//
+// Every modification is immediately written to the file.
+type Keystore struct {
+	path string
+	keys map[string]*EncryptedKey
+}
+
+// EncryptedKey is a single, passphrase protected, private key stored in a
+// keystore.
+type EncryptedKey struct {
+	Address weave.Address `json:"address"`
+	// Algorithm is the signature algorithm of the key, for example
+	// ed25519.
+	Algorithm string      `json:"algorithm"`
+	KDF       ScryptParam `json:"kdf"`
+	// Nonce is the AES-GCM nonce used to encrypt the key.
+	Nonce []byte `json:"nonce"`
+	// Ciphertext is the encrypted and authenticated, protobuf
+	// serialized private key.
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// ScryptParam holds all values required to derive the encryption key from a
+// passphrase.
+type ScryptParam struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt []byte `json:"salt"`
+}
+
+type keystoreFile struct {
+	Version int                      `json:"version"`
+	Keys    map[string]*EncryptedKey `json:"keys"`
+}
+
+// OpenKeystore loads the keystore from given file. If the file does not exist,
+// an empty keystore is returned. The file is created when the first key is
+// added.
+func OpenKeystore(path string) (*Keystore, error) {
+	ks := &Keystore{
+		path: path,
+		keys: make(map[string]*EncryptedKey),
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ks, nil
+		}
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	var f keystoreFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot decode keystore: %s", err)
+	}
+	if f.Version != KeystoreVersion {
+		return nil, errors.Wrapf(errors.ErrInput, "unsupported keystore version %d", f.Version)
+	}
+	if f.Keys != nil {
+		ks.keys = f.Keys
+	}
+	return ks, nil
+}
+
+// Names returns the names of all keys, in alphabetical order.
+func (ks *Keystore) Names() []string {
+	names := make([]string, 0, len(ks.keys))
+	for name := range ks.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Key returns the encrypted key stored under given name.
+func (ks *Keystore) Key(name string) (*EncryptedKey, error) {
+	k, ok := ks.keys[name]
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %q", name)
+	}
+	return k, nil
+}
+
+// Add encrypts the private key with the passphrase and stores it under given
+// name. A name can be used only once.
+func (ks *Keystore) Add(name string, key *PrivateKey, passphrase []byte) error {
+	if name == "" {
+		return errors.Wrap(errors.ErrEmpty, "name")
+	}
+	if _, ok := ks.keys[name]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "key %q", name)
+	}
+	enc, err := EncryptKey(key, passphrase)
+	if err != nil {
+		return err
+	}
+	ks.keys[name] = enc
+	if err := ks.save(); err != nil {
+		delete(ks.keys, name)
+		return err
+	}
+	return nil
+}
+
+// Get returns the decrypted private key stored under given name.
+func (ks *Keystore) Get(name string, passphrase []byte) (*PrivateKey, error) {
+	enc, err := ks.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	return enc.Decrypt(passphrase)
+}
+
+// Delete removes the key stored under given name.
+func (ks *Keystore) Delete(name string) error {
+	enc, err := ks.Key(name)
+	if err != nil {
+		return err
+	}
+	delete(ks.keys, name)
+	if err := ks.save(); err != nil {
+		ks.keys[name] = enc
+		return err
+	}
+	return nil
+}
+
+// save writes the keystore to a temporary file first, so that a failed write
+// does not corrupt already stored keys.
+func (ks *Keystore) save() error {
+	raw, err := json.MarshalIndent(keystoreFile{Version: KeystoreVersion, Keys: ks.keys}, "", "  ")
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(ks.path), filepath.Base(ks.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if err := tmp.Chmod(KeyPerm); err != nil {
+		tmp.Close()
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if err := os.Rename(tmp.Name(), ks.path); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+// EncryptKey returns the private key encrypted with a key derived from the
+// passphrase.
+func EncryptKey(key *PrivateKey, passphrase []byte) (*EncryptedKey, error) {
+	plain, err := key.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize private key")
+	}
+	kdf := ScryptParam{
+		N:    scryptN,
+		R:    scryptR,
+		P:    scryptP,
+		Salt: make([]byte, saltLen),
+	}
+	if _, err := io.ReadFull(rand.Reader, kdf.Salt); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot create salt: %s", err)
+	}
+	aead, err := kdf.cipher(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot create nonce: %s", err)
+	}
+	pub := key.PublicKey()
+	enc := &EncryptedKey{
+		Address:   pub.Address(),
+		Algorithm: keyAlgorithm(pub),
+		KDF:       kdf,
+		Nonce:     nonce,
+	}
+	// Address is authenticated together with the key, so that it cannot
+	// be changed without invalidating the ciphertext.
+	enc.Ciphertext = aead.Seal(nil, nonce, plain, enc.Address)
+	return enc, nil
+}
+
+// Decrypt returns the private key. An unauthorized error is returned if the
+// passphrase is not valid.
+func (k *EncryptedKey) Decrypt(passphrase []byte) (*PrivateKey, error) {
+	aead, err := k.KDF.cipher(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.Nonce) != aead.NonceSize() {
+		return nil, errors.Wrap(errors.ErrInput, "invalid nonce")
+	}
+	plain, err := aead.Open(nil, k.Nonce, k.Ciphertext, k.Address)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid passphrase")
+	}
+	var key PrivateKey
+	if err := key.Unmarshal(plain); err != nil {
+		return nil, errors.Wrap(err, "cannot deserialize private key")
+	}
+	return &key, nil
+}
+
+func (p ScryptParam) cipher(passphrase []byte) (cipher.AEAD, error) {
+	secret, err := scrypt.Key(passphrase, p.Salt, p.N, p.R, p.P, scryptKeyLen)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot derive key: %s", err)
+	}
+	block, err := aes.NewCipher(secret)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot create cipher: %s", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot create cipher: %s", err)
+	}
+	return aead, nil
+}
+
+// keyAlgorithm returns the name of the public key signature algorithm.
+func keyAlgorithm(pub *crypto.PublicKey) string {
+	switch {
+	case pub.GetEd25519() != nil:
+		return "ed25519"
+	case pub.GetSecp256K1() != nil:
+		return "secp256k1"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keystore.json")
+
+	ks, err := OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ks.Names()))
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("opening an empty keystore must not create a file: %v", err)
+	}
+
+	alice := GenPrivateKey()
+	bob := crypto.GenPrivKeySecp256k1()
+	assert.Nil(t, ks.Add("alice", alice, []byte("alice secret")))
+	assert.Nil(t, ks.Add("bob", bob, []byte("bob secret")))
+	if err := ks.Add("alice", bob, []byte("x")); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+	if err := ks.Add("", bob, []byte("x")); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want empty error, got %+v", err)
+	}
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(KeyPerm), info.Mode().Perm())
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	for _, secret := range [][]byte{alice.GetEd25519(), bob.GetSecp256K1()} {
+		if bytes.Contains(raw, secret) || bytes.Contains(raw, []byte(base64.StdEncoding.EncodeToString(secret))) {
+			t.Fatal("keystore contains a plain text private key")
+		}
+	}
+
+	// All changes are persisted.
+	ks, err = OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, ks.Names())
+
+	enc, err := ks.Key("bob")
+	assert.Nil(t, err)
+	assert.Equal(t, "secp256k1", enc.Algorithm)
+	assert.Equal(t, bob.PublicKey().Address(), enc.Address)
+
+	got, err := ks.Get("alice", []byte("alice secret"))
+	assert.Nil(t, err)
+	assert.Equal(t, alice, got)
+	got, err = ks.Get("bob", []byte("bob secret"))
+	assert.Nil(t, err)
+	assert.Equal(t, bob, got)
+
+	if _, err := ks.Get("alice", []byte("bob secret")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+	if _, err := ks.Get("charlie", []byte("alice secret")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	assert.Nil(t, ks.Delete("alice"))
+	if err := ks.Delete("alice"); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+	ks, err = OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bob"}, ks.Names())
+}
+
+func TestEncryptedKeyTampering(t *testing.T) {
+	key := GenPrivateKey()
+	passphrase := []byte("a secret")
+
+	cases := map[string]struct {
+		modify func(*EncryptedKey)
+	}{
+		"address": {
+			modify: func(k *EncryptedKey) { k.Address = GenPrivateKey().PublicKey().Address() },
+		},
+		"ciphertext": {
+			modify: func(k *EncryptedKey) { k.Ciphertext[0]++ },
+		},
+		"salt": {
+			modify: func(k *EncryptedKey) { k.KDF.Salt[0]++ },
+		},
+		"nonce": {
+			modify: func(k *EncryptedKey) { k.Nonce[0]++ },
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			enc, err := EncryptKey(key, passphrase)
+			assert.Nil(t, err)
+			got, err := enc.Decrypt(passphrase)
+			assert.Nil(t, err)
+			if !reflect.DeepEqual(key, got) {
+				t.Fatal("unexpected key")
+			}
+
+			tc.modify(enc)
+			if _, err := enc.Decrypt(passphrase); !errors.ErrUnauthorized.Is(err) {
+				t.Fatalf("want unauthorized error, got %+v", err)
+			}
+		})
+	}
+}
@@ -32,8 +32,10 @@ func helpMessage() {
 	fmt.Println("help      Print this message")
 	fmt.Println("init      Initialize app options in genesis file")
 	fmt.Println("start     Run the abci server")
+	fmt.Println("export    Write the app state at given height as a genesis file")
 	fmt.Println("getblock  Extract a block from blockchain.db")
 	fmt.Println("retry     Run last block again to ensure it produces same result")
+	fmt.Println("snapshot  Create a state snapshot or restore the state from one")
 	fmt.Println("version   Print the app version")
 	fmt.Println(`
   -home string
@@ -62,10 +64,14 @@ func main() {
 		err = server.InitCmd(bnsd.GenInitOptions, logger, *varHome, rest)
 	case "start":
 		err = server.StartCmd(bnsd.GenerateApp, logger, *varHome, rest)
+	case "export":
+		err = server.ExportCmd(bnsd.GenerateExport, logger, *varHome, rest)
 	case "getblock":
 		err = server.GetBlockCmd(rest)
 	case "retry":
 		err = server.RetryCmd(bnsd.InlineApp, logger, *varHome, rest)
+	case "snapshot":
+		err = server.SnapshotCmd(bnsd.GenerateSnapshotStore, logger, *varHome, rest)
 	case "testgen":
 		err = commands.TestGenCmd(bnsd.Examples(), rest)
 	case "version":
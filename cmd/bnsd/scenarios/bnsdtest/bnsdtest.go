@@ -286,6 +286,7 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 			{"ver": 1, "pkg": "currency"},
 			{"ver": 1, "pkg": "distribution"},
 			{"ver": 1, "pkg": "escrow"},
+			{"ver": 1, "pkg": "gas"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
 			{"ver": 1, "pkg": "multisig"},
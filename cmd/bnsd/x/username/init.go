@@ -1,6 +1,8 @@
 package username
 
 import (
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -10,20 +12,23 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisToken is the genesis file representation of a username token.
+type genesisToken struct {
+	Username Username
+	Targets  []BlockchainAddress
+	Owner    weave.Address
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type TokenInput struct {
-		Username Username
-		Targets  []BlockchainAddress
-		Owner    weave.Address
-	}
 	stream := opts.Stream("username")
 
 	bucket := NewTokenBucket()
 	for i := 0; ; i++ {
-		var t TokenInput
+		var t genesisToken
 
 		err := stream(&t)
 		switch {
@@ -47,3 +52,36 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		}
 	}
 }
+
+// ExportGenesis will write all username tokens to opts, in the format
+// expected by FromGenesis.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	it, err := NewTokenBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate tokens")
+	}
+	defer it.Release()
+
+	tokens := []genesisToken{}
+	for {
+		var t Token
+		key, err := it.LoadNext(&t)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load token")
+		}
+		tokens = append(tokens, genesisToken{
+			Username: Username(key),
+			Targets:  t.Targets,
+			Owner:    t.Owner,
+		})
+	}
+	raw, err := json.Marshal(tokens)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal tokens")
+	}
+	opts["username"] = raw
+	return nil
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+)
+
+const (
+	flagOut = "out"
+
+	validatorsKey = "validators"
+)
+
+// ExportGenerator should be implemented by the application. It returns the
+// application state store and the exporter of all extensions, in the same
+// order as they are initialized.
+type ExportGenerator func(home string) (weave.CommitKVStore, weave.Exporter, error)
+
+type exportArgs struct {
+	height int64
+	out    string
+}
+
+func parseExportArgs(args []string) (exportArgs, error) {
+	var res exportArgs
+	exportFlags := flag.NewFlagSet("export", flag.ExitOnError)
+	exportFlags.Int64Var(&res.height, flagHeight, 0, "height of the state to export, latest if 0")
+	exportFlags.StringVar(&res.out, flagOut, "", "file to write the genesis to, stdout if empty")
+	err := exportFlags.Parse(args)
+	return res, err
+}
+
+// ExportCmd writes the application state at given height as a genesis file.
+// The genesis file present in the home directory is used as the template:
+// app_state is replaced with the exported state and if the application keeps
+// track of the validator set, the validators are replaced as well.
+//
+// The result can be used to start a new chain with the same state.
+func ExportCmd(gen ExportGenerator, logger log.Logger, home string, args []string) error {
+	flags, err := parseExportArgs(args)
+	if err != nil {
+		return err
+	}
+	if flags.height < 0 {
+		return errors.Wrap(errors.ErrInput, "height must not be negative")
+	}
+
+	kv, exporter, err := gen(home)
+	if err != nil {
+		return err
+	}
+	height := flags.height
+	if height == 0 {
+		latest, err := kv.LatestVersion()
+		if err != nil {
+			return errors.Wrap(err, "cannot get latest version")
+		}
+		height = latest.Version
+	}
+	// Logger output goes to stdout, so it must not be used when the
+	// genesis is written to stdout as well.
+	if flags.out != "" {
+		logger.Info("Exporting state", "height", height)
+	}
+
+	db, err := kv.ReadOnlyAtVersion(height)
+	if err != nil {
+		return errors.Wrapf(err, "cannot load state at height %d", height)
+	}
+	opts := make(weave.Options)
+	if err := exporter.ExportGenesis(db, opts); err != nil {
+		return errors.Wrap(err, "cannot export state")
+	}
+
+	genFile := filepath.Join(home, DirConfig, "genesis.json")
+	doc, err := exportGenesisDoc(genFile, db, opts)
+	if err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if flags.out == "" {
+		_, err = fmt.Fprintln(os.Stdout, string(out))
+		return err
+	}
+	return ioutil.WriteFile(flags.out, out, 0600)
+}
+
+// exportGenesisDoc loads the genesis file and replaces its application state
+// and validators with those exported from db.
+func exportGenesisDoc(genFile string, db weave.ReadOnlyKVStore, opts weave.Options) (GenesisDoc, error) {
+	bz, err := ioutil.ReadFile(genFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read genesis file")
+	}
+	var doc GenesisDoc
+	if err := json.Unmarshal(bz, &doc); err != nil {
+		return nil, errors.Wrap(err, "cannot parse genesis file")
+	}
+
+	state, err := json.Marshal(opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal app state")
+	}
+	doc[AppStateKey] = state
+
+	validators, err := exportValidators(db)
+	if err != nil {
+		return nil, err
+	}
+	// An empty set means the application does not track validators and
+	// the ones declared in the genesis file are still valid.
+	if len(validators) != 0 {
+		raw, err := cdc.MarshalJSON(validators)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal validators")
+		}
+		doc[validatorsKey] = raw
+	}
+	return doc, nil
+}
+
+func exportValidators(db weave.ReadOnlyKVStore) ([]types.GenesisValidator, error) {
+	updates, err := weave.GetValidatorUpdates(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load validators")
+	}
+	var validators []types.GenesisValidator
+	for _, u := range updates.ValidatorUpdates {
+		if u.Power == 0 {
+			continue
+		}
+		pubKey, err := types.PB2TM.PubKey(u.PubKey.AsABCI())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot convert validator public key")
+		}
+		validators = append(validators, types.GenesisValidator{
+			Address: pubKey.Address(),
+			PubKey:  pubKey,
+			Power:   u.Power,
+		})
+	}
+	return validators, nil
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"flag"
+	"fmt"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	flagDir       = "dir"
+	flagChunkSize = "chunk"
+	flagHash      = "hash"
+)
+
+// SnapshotStore is implemented by the application state stores that can be
+// saved to and loaded from a snapshot.
+type SnapshotStore interface {
+	LatestVersion() (weave.CommitID, error)
+	CreateSnapshot(dir string, version int64, chunkSize int) (*iavlstore.SnapshotManifest, error)
+	RestoreSnapshot(dir string) (weave.CommitID, error)
+}
+
+// SnapshotStoreGenerator should be implemented by the application. It returns
+// the application state store located in the home directory.
+type SnapshotStoreGenerator func(home string) (SnapshotStore, error)
+
+type snapshotArgs struct {
+	dir       string
+	height    int64
+	chunkSize int
+	hash      string
+}
+
+func parseSnapshotArgs(cmd string, args []string) (snapshotArgs, error) {
+	var res snapshotArgs
+	snapshotFlags := flag.NewFlagSet("snapshot "+cmd, flag.ExitOnError)
+	snapshotFlags.StringVar(&res.dir, flagDir, "", "snapshot directory")
+	switch cmd {
+	case "create":
+		snapshotFlags.Int64Var(&res.height, flagHeight, 0, "height of the state to snapshot, latest if 0")
+		snapshotFlags.IntVar(&res.chunkSize, flagChunkSize, iavlstore.DefaultSnapshotChunkSize, "maximum size of a chunk file in bytes")
+	case "restore":
+		snapshotFlags.StringVar(&res.hash, flagHash, "", "hex encoded trusted app hash the restored state must match")
+	}
+	if err := snapshotFlags.Parse(args); err != nil {
+		return res, err
+	}
+	if res.dir == "" {
+		return res, errors.Wrap(errors.ErrInput, "snapshot directory is required")
+	}
+	return res, nil
+}
+
+// SnapshotCmd creates a snapshot of the application state or restores the
+// application state from a snapshot. A node started with the restored state
+// does not have to replay all blocks from genesis.
+//
+// Usage:
+//   xxx snapshot create -dir=<path> [-height=N] [-chunk=bytes]
+//   xxx snapshot restore -dir=<path> [-hash=<app hash>]
+//
+// The application must not be running, because the state store cannot be
+// open by two processes.
+func SnapshotCmd(gen SnapshotStoreGenerator, logger log.Logger, home string, args []string) error {
+	if len(args) == 0 {
+		return errors.Wrap(errors.ErrInput, "usage: cmd snapshot <create|restore> -dir=<path> [options]")
+	}
+	cmd := args[0]
+	if cmd != "create" && cmd != "restore" {
+		return errors.Wrapf(errors.ErrInput, "unknown snapshot command: %s", cmd)
+	}
+	flags, err := parseSnapshotArgs(cmd, args[1:])
+	if err != nil {
+		return err
+	}
+
+	kv, err := gen(home)
+	if err != nil {
+		return err
+	}
+	if cmd == "create" {
+		return createSnapshot(kv, logger, flags)
+	}
+	return restoreSnapshot(kv, logger, flags)
+}
+
+func createSnapshot(kv SnapshotStore, logger log.Logger, flags snapshotArgs) error {
+	height := flags.height
+	if height == 0 {
+		latest, err := kv.LatestVersion()
+		if err != nil {
+			return errors.Wrap(err, "cannot get latest version")
+		}
+		height = latest.Version
+	}
+	m, err := kv.CreateSnapshot(flags.dir, height, flags.chunkSize)
+	if err != nil {
+		return errors.Wrapf(err, "cannot create snapshot at height %d", height)
+	}
+	logger.Info("Snapshot created", "height", m.Version, "hash", m.Hash, "chunks", len(m.Chunks))
+	return nil
+}
+
+func restoreSnapshot(kv SnapshotStore, logger log.Logger, flags snapshotArgs) error {
+	var trusted []byte
+	if flags.hash != "" {
+		var err error
+		if trusted, err = hex.DecodeString(flags.hash); err != nil {
+			return errors.Wrapf(errors.ErrInput, "invalid hash: %s", err)
+		}
+		// Check the manifest before writing anything to the store.
+		m, err := iavlstore.ReadSnapshotManifest(flags.dir)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(trusted, m.Hash) {
+			return errors.Wrapf(errors.ErrState, "snapshot hash %X does not match %X", m.Hash, trusted)
+		}
+	}
+	id, err := kv.RestoreSnapshot(flags.dir)
+	if err != nil {
+		return errors.Wrap(err, "cannot restore snapshot")
+	}
+	logger.Info("Snapshot restored", "height", id.Version, "hash", fmt.Sprintf("%X", id.Hash))
+	return nil
+}
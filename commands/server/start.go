@@ -4,6 +4,7 @@ import (
 	"flag"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
 	"github.com/tendermint/tendermint/abci/server"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -14,6 +15,9 @@ const (
 	flagBind   = "bind"
 	flagDebug  = "debug"
 	flagMinFee = "min_fee"
+
+	flagKeepRecent = "keep_recent"
+	flagKeepEvery  = "keep_every"
 )
 
 type Options struct {
@@ -21,6 +25,9 @@ type Options struct {
 	Debug  bool
 	Home   string
 	Logger log.Logger
+	// Pruning decides which committed versions of the state are kept.
+	// The zero value keeps all versions.
+	Pruning iavlstore.PruningStrategy
 }
 
 func parseFlags(args []string) (string, *Options, error) {
@@ -35,11 +42,18 @@ func parseFlags(args []string) (string, *Options, error) {
 	startFlags.StringVar(&addr, flagBind, "tcp://localhost:26658", "address server listens on")
 	startFlags.StringVar(&minFeeStr, flagMinFee, "0 IOV", "minimal anti-spam fee")
 	startFlags.BoolVar(&options.Debug, flagDebug, false, "call stack returned on error")
+	startFlags.Int64Var(&options.Pruning.KeepRecent, flagKeepRecent, iavlstore.DefaultHistory,
+		"number of recent state versions to keep, 0 keeps all versions")
+	startFlags.Int64Var(&options.Pruning.KeepEvery, flagKeepEvery, 0,
+		"if greater than 0, every state version that is a multiple of it is kept forever")
 	err := startFlags.Parse(args)
 
 	if err != nil {
 		return addr, options, err
 	}
+	if err := options.Pruning.Validate(); err != nil {
+		return addr, options, errors.Wrap(err, "pruning")
+	}
 
 	options.MinFee, err = coin.ParseHumanFormat(minFeeStr)
 
@@ -65,7 +79,7 @@ func StartCmd(gen AppGenerator, logger log.Logger, home string, args []string) e
 		return err
 	}
 
-	logger.Info("Starting ABCI app", "bind", addr)
+	logger.Info("Starting ABCI app", "bind", addr, "pruning", options.Pruning.String())
 
 	svr, err := server.NewServer(addr, "socket", app)
 	if err != nil {
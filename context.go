@@ -42,6 +42,7 @@ const (
 	contextKeyLogger
 	contextKeyTime
 	contextCommitInfo
+	contextKeyGasMeter
 )
 
 var (
@@ -0,0 +1,104 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// secp256k1halfN is used to reject malleable signatures. Only signatures
+// with S in the lower half of the curve order are valid.
+var secp256k1halfN = new(big.Int).Rsh(btcec.S256().N, 1)
+
+var _ PubKey = (*PublicKey_Secp256K1)(nil)
+
+// Verify verifies the signature was created with this message and public key.
+// Only compressed public keys and signatures in lower-S form are accepted.
+func (p *PublicKey_Secp256K1) Verify(message []byte, sig *Signature) bool {
+	secsig, ok := sig.GetSig().(*Signature_Secp256K1)
+	if !ok {
+		return false
+	}
+	if len(p.Secp256K1) != btcec.PubKeyBytesLenCompressed || len(secsig.Secp256K1) != 64 {
+		return false
+	}
+	publicKey, err := btcec.ParsePubKey(p.Secp256K1, btcec.S256())
+	if err != nil {
+		return false
+	}
+	signature := btcec.Signature{
+		R: new(big.Int).SetBytes(secsig.Secp256K1[:32]),
+		S: new(big.Int).SetBytes(secsig.Secp256K1[32:]),
+	}
+	if signature.S.Cmp(secp256k1halfN) > 0 {
+		return false
+	}
+	digest := sha256.Sum256(message)
+	return signature.Verify(digest[:], publicKey)
+}
+
+// Condition encodes the public key into a weave permission. Condition type
+// is limited to 8 characters, so "secp256k" is used instead of the full
+// curve name.
+func (p *PublicKey_Secp256K1) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "secp256k", p.Secp256K1)
+}
+
+var _ Signer = (*PrivateKey_Secp256K1)(nil)
+
+// Sign returns a matching signature for this private key. The SHA-256
+// digest of the message is signed and the signature is always in lower-S
+// form.
+func (p *PrivateKey_Secp256K1) Sign(message []byte) (*Signature, error) {
+	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	digest := sha256.Sum256(message)
+	// btcec signatures are deterministic (RFC6979) and canonical.
+	s, err := privateKey.Sign(digest[:])
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot sign: %s", err)
+	}
+	bz := make([]byte, 64)
+	r, sb := s.R.Bytes(), s.S.Bytes()
+	copy(bz[32-len(r):32], r)
+	copy(bz[64-len(sb):], sb)
+	sig := &Signature{
+		Sig: &Signature_Secp256K1{
+			Secp256K1: bz,
+		},
+	}
+	return sig, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Secp256K1) PublicKey() *PublicKey {
+	_, pub := btcec.PrivKeyFromBytes(btcec.S256(), p.Secp256K1)
+	return &PublicKey{
+		Pub: &PublicKey_Secp256K1{
+			Secp256K1: pub.SerializeCompressed(),
+		},
+	}
+}
+
+// GenPrivKeySecp256k1 returns a random new private key
+func GenPrivKeySecp256k1() *PrivateKey {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+	return PrivKeySecp256k1FromBytes(priv.Serialize())
+}
+
+// PrivKeySecp256k1FromBytes returns a private key for the given 32 bytes
+// long scalar, as used by Bitcoin and Ethereum wallets.
+func PrivKeySecp256k1FromBytes(raw []byte) *PrivateKey {
+	priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+	// Serialize pads the scalar to 32 bytes.
+	return &PrivateKey{
+		Priv: &PrivateKey_Secp256K1{
+			Secp256K1: priv.Serialize(),
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/iov-one/weave/weavetest/assert"
+	tmsecp256k1 "github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestSecp256k1Signing(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if public.Verify(msg, nil) {
+		t.Fatal("verified a nil signature of a message")
+	}
+
+	// Signatures of one algorithm are not valid for another.
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if public.Verify(msg, edsig) {
+		t.Fatal("verified an ed25519 signature")
+	}
+}
+
+func TestSecp256k1CompatibleWithTendermint(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	msg := []byte("foobar")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	var tmpub tmsecp256k1.PubKeySecp256k1
+	copy(tmpub[:], private.PublicKey().GetSecp256K1())
+	if !tmpub.VerifyBytes(msg, sig.GetSecp256K1()) {
+		t.Fatal("tendermint cannot verify the signature")
+	}
+
+	var tmpriv tmsecp256k1.PrivKeySecp256k1
+	copy(tmpriv[:], private.GetSecp256K1())
+	tmsig, err := tmpriv.Sign(msg)
+	assert.Nil(t, err)
+	if !private.PublicKey().Verify(msg, &Signature{Sig: &Signature_Secp256K1{Secp256K1: tmsig}}) {
+		t.Fatal("cannot verify tendermint signature")
+	}
+}
+
+func TestSecp256k1MalleableSignature(t *testing.T) {
+	private := GenPrivKeySecp256k1()
+	msg := []byte("foobar")
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+
+	// Replace S with N - S, which is a valid but malleated signature.
+	raw := sig.GetSecp256K1()
+	s := new(big.Int).SetBytes(raw[32:])
+	s.Sub(btcec.S256().N, s)
+	malleated := make([]byte, 64)
+	copy(malleated, raw[:32])
+	sb := s.Bytes()
+	copy(malleated[64-len(sb):], sb)
+
+	if private.PublicKey().Verify(msg, &Signature{Sig: &Signature_Secp256K1{Secp256K1: malleated}}) {
+		t.Fatal("verified a signature with high S value")
+	}
+}
+
+func TestSecp256k1Address(t *testing.T) {
+	// Private key and compressed public key from the Bitcoin wiki
+	// https://en.bitcoin.it/wiki/Technical_background_of_version_1_Bitcoin_addresses
+	raw, err := hex.DecodeString("18e14a7b6a307f426a94f8114701e7c8e774e7f9a47e2c2035db29a206321725")
+	assert.Nil(t, err)
+	pub := PrivKeySecp256k1FromBytes(raw).PublicKey()
+	wantPub, err := hex.DecodeString("0250863ad64a87ae8a2fe83c1af1a8403cb53f53e486d8511dad8a04887e5b2352")
+	assert.Nil(t, err)
+	assert.Equal(t, wantPub, pub.GetSecp256K1())
+
+	pub2 := GenPrivKeySecp256k1().PublicKey()
+	assert.Nil(t, pub.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+
+	bz, err := pub.Marshal()
+	assert.Nil(t, err)
+	var read PublicKey
+	assert.Nil(t, read.Unmarshal(bz))
+	assert.Equal(t, read.Address(), pub.Address())
+}
@@ -83,6 +83,9 @@ var (
 	// ErrIteratorDone is returned when an iterator hits the end of the data source.
 	ErrIteratorDone = Register(22, "iterator done")
 
+	// ErrOutOfGas is returned when a transaction exceeds its gas limit.
+	ErrOutOfGas = Register(23, "out of gas")
+
 	// ErrNetwork is returned on network failure (only for client libraries)
 	ErrNetwork = Register(100200, "network")
 
@@ -0,0 +1,94 @@
+package weave
+
+import (
+	"context"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// GasMeter tracks the amount of work performed while processing a single
+// transaction. Once the limit is reached, any further consumption fails.
+type GasMeter interface {
+	// ConsumeGas charges given amount of gas. It returns ErrOutOfGas if
+	// the total consumption would exceed the limit. Descriptor is used
+	// to describe what the gas was charged for.
+	ConsumeGas(amount int64, descriptor string) error
+	// GasConsumed returns the total amount of gas consumed so far.
+	GasConsumed() int64
+	// GasLimit returns the maximum amount of gas that can be consumed.
+	// Zero means there is no limit.
+	GasLimit() int64
+}
+
+// NewGasMeter returns a gas meter that allows to consume up to given amount
+// of gas. A limit of zero or less creates a meter without a limit, that only
+// counts the consumption.
+func NewGasMeter(limit int64) GasMeter {
+	if limit < 0 {
+		limit = 0
+	}
+	return &gasMeter{limit: limit}
+}
+
+type gasMeter struct {
+	limit    int64
+	consumed int64
+}
+
+var _ GasMeter = (*gasMeter)(nil)
+
+func (g *gasMeter) ConsumeGas(amount int64, descriptor string) error {
+	if amount < 0 {
+		return errors.Wrapf(errors.ErrHuman, "negative gas amount for %s", descriptor)
+	}
+	total := g.consumed + amount
+	if total < g.consumed {
+		return errors.Wrapf(errors.ErrOverflow, "gas consumption for %s", descriptor)
+	}
+	if g.limit > 0 && total > g.limit {
+		// Consume everything that was available so that the
+		// reported usage reflects the exhausted limit.
+		g.consumed = g.limit
+		return errors.Wrapf(errors.ErrOutOfGas, "%s requires %d, limit is %d", descriptor, total, g.limit)
+	}
+	g.consumed = total
+	return nil
+}
+
+func (g *gasMeter) GasConsumed() int64 {
+	return g.consumed
+}
+
+func (g *gasMeter) GasLimit() int64 {
+	return g.limit
+}
+
+// WithGasMeter sets the gas meter that tracks the work performed within this
+// Context.
+// Panics if a gas meter is already set.
+func WithGasMeter(ctx Context, meter GasMeter) Context {
+	if _, ok := GetGasMeter(ctx); ok {
+		panic("GasMeter already set")
+	}
+	return context.WithValue(ctx, contextKeyGasMeter, meter)
+}
+
+// GetGasMeter returns the gas meter set in this Context.
+// ok is false if no gas meter was set.
+func GetGasMeter(ctx Context) (GasMeter, bool) {
+	val, ok := ctx.Value(contextKeyGasMeter).(GasMeter)
+	return val, ok
+}
+
+// ConsumeGas charges given amount of gas from the gas meter present in the
+// Context. If no gas meter is present, this is a no-op.
+//
+// Handlers that perform expensive computations that are not reflected by
+// the database access can use this function to charge for that work.
+func ConsumeGas(ctx Context, amount int64, descriptor string) error {
+	meter, ok := GetGasMeter(ctx)
+	if !ok {
+		return nil
+	}
+	return meter.ConsumeGas(amount, descriptor)
+}
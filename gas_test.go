@@ -0,0 +1,70 @@
+package weave
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGasMeter(t *testing.T) {
+	cases := map[string]struct {
+		limit        int64
+		consume      []int64
+		wantErr      *errors.Error
+		wantConsumed int64
+	}{
+		"no limit": {
+			limit:        0,
+			consume:      []int64{1000, 2000, 3000},
+			wantConsumed: 6000,
+		},
+		"within limit": {
+			limit:        100,
+			consume:      []int64{40, 60},
+			wantConsumed: 100,
+		},
+		"limit exceeded": {
+			limit:        100,
+			consume:      []int64{40, 61},
+			wantErr:      errors.ErrOutOfGas,
+			wantConsumed: 100,
+		},
+		"negative amount": {
+			limit:        100,
+			consume:      []int64{-1},
+			wantErr:      errors.ErrHuman,
+			wantConsumed: 0,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			meter := NewGasMeter(tc.limit)
+			var err error
+			for _, amount := range tc.consume {
+				if err = meter.ConsumeGas(amount, "test"); err != nil {
+					break
+				}
+			}
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.wantConsumed, meter.GasConsumed())
+		})
+	}
+}
+
+func TestConsumeGasFromContext(t *testing.T) {
+	ctx := context.Background()
+	// Without a meter consumption is not tracked.
+	assert.Nil(t, ConsumeGas(ctx, 100, "test"))
+
+	meter := NewGasMeter(150)
+	ctx = WithGasMeter(ctx, meter)
+	assert.Nil(t, ConsumeGas(ctx, 100, "test"))
+	if err := ConsumeGas(ctx, 100, "test"); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %+v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package gconf
 
 import (
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -86,3 +88,25 @@ func InitConfig(db Store, opts weave.Options, pkg string, conf Configuration) er
 	}
 	return nil
 }
+
+// ExportConfig will load the configuration of the package from the database
+// and set it in opts["conf"][pkg], so that it can be loaded by InitConfig.
+// Configurations of other packages, already present in opts, are preserved.
+func ExportConfig(db ReadStore, opts weave.Options, pkg string, conf Configuration) error {
+	if err := Load(db, pkg, conf); err != nil {
+		return errors.Wrapf(err, "load configuration for %s", pkg)
+	}
+	confOptions := make(weave.Options)
+	if err := opts.ReadOptions("conf", &confOptions); err != nil {
+		return errors.Wrap(err, "read conf")
+	}
+	raw, err := json.Marshal(conf)
+	if err != nil {
+		return errors.Wrapf(err, "marshal configuration for %s", pkg)
+	}
+	confOptions[pkg] = raw
+	if opts["conf"], err = json.Marshal(confOptions); err != nil {
+		return errors.Wrap(err, "marshal conf")
+	}
+	return nil
+}
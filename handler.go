@@ -126,3 +126,10 @@ func FromInitChain(req abci.RequestInitChain) GenesisParams {
 type Initializer interface {
 	FromGenesis(opts Options, params GenesisParams, kv KVStore) error
 }
+
+// Exporter implementations are used to export the state of extensions
+// into the genesis file format, so that it can be loaded by the
+// Initializer of a new chain. Exported data is set in given opts.
+type Exporter interface {
+	ExportGenesis(db ReadOnlyKVStore, opts Options) error
+}
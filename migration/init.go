@@ -1,9 +1,13 @@
 package migration
 
 import (
+	"encoding/json"
+	"sort"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the InitStater interface to load data from
@@ -11,6 +15,14 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
+var _ weave.Exporter = Initializer{}
+
+// genesisSchema is the genesis file representation of a package schema
+// version.
+type genesisSchema struct {
+	Ver uint32 `json:"ver"`
+	Pkg string `json:"pkg"`
+}
 
 // FromGenesis will parse initial account info from genesis
 // and save it to the database
@@ -19,10 +31,7 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		return errors.Wrap(err, "migration config")
 	}
 
-	var packages []struct {
-		Ver uint32 `json:"ver"`
-		Pkg string `json:"pkg"`
-	}
+	var packages []genesisSchema
 	if err := opts.ReadOptions("initialize_schema", &packages); err != nil {
 		return errors.Wrap(err, "initialize schema")
 	}
@@ -60,3 +69,38 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+// ExportGenesis will write the configuration and the current schema version
+// of every package to opts, in the format expected by FromGenesis.
+func (Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	if err := gconf.ExportConfig(db, opts, "migration", &Configuration{}); err != nil {
+		return errors.Wrap(err, "migration config")
+	}
+
+	versions := make(map[string]uint32)
+	err := orm.ForEach(db, NewSchemaBucket().Bucket, func(obj orm.Object) error {
+		s, ok := obj.Value().(*Schema)
+		if !ok {
+			return errors.WithType(errors.ErrModel, obj.Value())
+		}
+		if s.Version > versions[s.Pkg] {
+			versions[s.Pkg] = s.Version
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "export schema")
+	}
+
+	packages := make([]genesisSchema, 0, len(versions))
+	for pkg, ver := range versions {
+		packages = append(packages, genesisSchema{Ver: ver, Pkg: pkg})
+	}
+	sort.Slice(packages, func(i, j int) bool { return packages[i].Pkg < packages[j].Pkg })
+	raw, err := json.Marshal(packages)
+	if err != nil {
+		return errors.Wrap(err, "marshal schema")
+	}
+	opts["initialize_schema"] = raw
+	return nil
+}
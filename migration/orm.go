@@ -117,7 +117,36 @@ func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []
 	if err != nil {
 		return nil, err
 	}
+	if err := m.migrateSlice(db, dest); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (m *ModelBucket) ByIndexPrefix(db weave.ReadOnlyKVStore, indexName string, prefix []byte, order orm.Order, limit int, dest orm.ModelSlicePtr) ([][]byte, error) {
+	keys, err := m.b.ByIndexPrefix(db, indexName, prefix, order, limit, dest)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.migrateSlice(db, dest); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (m *ModelBucket) ByIndexRange(db weave.ReadOnlyKVStore, indexName string, start, end []byte, order orm.Order, limit int, dest orm.ModelSlicePtr) ([][]byte, error) {
+	keys, err := m.b.ByIndexRange(db, indexName, start, end, order, limit, dest)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.migrateSlice(db, dest); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
 
+// migrateSlice migrates all models in the destination slice.
+func (m *ModelBucket) migrateSlice(db weave.ReadOnlyKVStore, dest orm.ModelSlicePtr) error {
 	// The correct type of the dest was already validated by the
 	// ModelBucket when getting data by index. We can safely skip checks -
 	// dest is a slice of models.
@@ -135,10 +164,36 @@ func (m *ModelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key []
 		}
 
 		if err := m.migrate(db, model); err != nil {
-			return nil, errors.Wrapf(err, "migrate %d element", i)
+			return errors.Wrapf(err, "migrate %d element", i)
 		}
 	}
-	return keys, nil
+	return nil
+}
+
+func (m *ModelBucket) Iterate(db weave.ReadOnlyKVStore, start, end []byte) (orm.ModelIterator, error) {
+	it, err := m.b.Iterate(db, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &modelIterator{ModelIterator: it, db: db, bucket: m}, nil
+}
+
+// modelIterator migrates every loaded model.
+type modelIterator struct {
+	orm.ModelIterator
+	db     weave.ReadOnlyKVStore
+	bucket *ModelBucket
+}
+
+func (it *modelIterator) LoadNext(dest orm.Model) ([]byte, error) {
+	key, err := it.ModelIterator.LoadNext(dest)
+	if err != nil {
+		return nil, err
+	}
+	if err := it.bucket.migrate(it.db, dest); err != nil {
+		return nil, errors.Wrap(err, "migrate")
+	}
+	return key, nil
 }
 
 func (m *ModelBucket) Put(db weave.KVStore, key []byte, model orm.Model) ([]byte, error) {
@@ -229,6 +229,23 @@ func TestSchemaVersionedModelBucket(t *testing.T) {
 	}
 	assert.Equal(t, wantv, setv)
 
+	// Iterate must migrate every loaded model.
+	it, err := b.Iterate(db, nil, nil)
+	if err != nil {
+		t.Fatalf("cannot iterate: %s", err)
+	}
+	defer it.Release()
+	var iterated []MyModel
+	for {
+		var m MyModel
+		if _, err := it.LoadNext(&m); errors.ErrIteratorDone.Is(err) {
+			break
+		} else if err != nil {
+			t.Fatalf("cannot load next: %s", err)
+		}
+		iterated = append(iterated, m)
+	}
+	assert.Equal(t, wantv, iterated)
 }
 
 func assertMyModelState(t testing.TB, m *MyModel, wantSchemaVersion uint32, wantCnt int) {
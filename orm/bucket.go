@@ -31,13 +31,15 @@ const (
 var isBucketName = regexp.MustCompile(`^[a-z_]{3,10}$`).MatchString
 
 type Bucket interface {
-	weave.QueryHandler
+	weave.CursorQueryHandler
 
 	DBKey(key []byte) []byte
 	Delete(db weave.KVStore, key []byte) error
 	Get(db weave.ReadOnlyKVStore, key []byte) (Object, error)
 	GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([]Object, error)
 	GetIndexedLike(db weave.ReadOnlyKVStore, name string, pattern Object) ([]Object, error)
+	GetIndexedPrefix(db weave.ReadOnlyKVStore, name string, prefix []byte, order Order, limit int) ([]Object, error)
+	GetIndexedRange(db weave.ReadOnlyKVStore, name string, start, end []byte, order Order, limit int) ([]Object, error)
 	Parse(key, value []byte) (Object, error)
 	Register(name string, r weave.QueryRouter)
 	Save(db weave.KVStore, model Object) error
@@ -115,23 +117,42 @@ func (b bucket) Register(name string, r weave.QueryRouter) {
 
 // Query handles queries from the QueryRouter.
 func (b bucket) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := b.QueryWithCursor(db, mod, data)
+	return models, err
+}
+
+// QueryWithCursor handles queries from the QueryRouter. Prefix queries
+// accept QueryOptions and return the primary key of the last returned
+// entry as the cursor if the result was truncated. Range queries expect a
+// serialized RangeQuery with primary keys as the range bounds and return
+// the RangeQuery to continue with as the cursor.
+func (b bucket) QueryWithCursor(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, []byte, error) {
+	mod, opts, err := weave.SplitQueryMod(mod)
+	if err != nil {
+		return nil, nil, err
+	}
 	switch mod {
 	case weave.KeyQueryMod:
 		key := b.DBKey(data)
 		value, err := db.Get(key)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if value == nil {
-			return nil, nil
+			return nil, nil, nil
 		}
 		res := []weave.Model{{Key: key, Value: value}}
-		return res, nil
+		return res, nil, nil
 	case weave.PrefixQueryMod:
-		prefix := b.DBKey(data)
-		return queryPrefix(db, prefix)
+		return queryPrefix(db, b.prefix, data, opts)
+	case weave.RangeQueryMod:
+		q, err := parseRangeQuery(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		return queryRange(db, b.prefix, q)
 	default:
-		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+		return nil, nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
 	}
 }
 
@@ -293,6 +314,65 @@ func (b bucket) GetIndexedLike(db weave.ReadOnlyKVStore, name string, pattern Ob
 	return b.readRefs(db, refs)
 }
 
+// GetIndexedPrefix queries the named index for all keys that begin with the
+// given prefix. See Index.ScanPrefix
+func (b bucket) GetIndexedPrefix(db weave.ReadOnlyKVStore, name string, prefix []byte, order Order, limit int) ([]Object, error) {
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return nil, errors.Wrap(ErrInvalidIndex, name)
+	}
+	refs, err := idx.ScanPrefix(db, prefix, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	return b.readRefs(db, refs)
+}
+
+// GetIndexedRange queries the named index for all keys within the
+// [start, end) range. See Index.ScanRange
+func (b bucket) GetIndexedRange(db weave.ReadOnlyKVStore, name string, start, end []byte, order Order, limit int) ([]Object, error) {
+	idx := b.indexes.Get(name)
+	if idx == nil {
+		return nil, errors.Wrap(ErrInvalidIndex, name)
+	}
+	refs, err := idx.ScanRange(db, start, end, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	return b.readRefs(db, refs)
+}
+
+// ForEach calls fn for every object stored in the bucket, in ascending key
+// order. Iteration stops at the first error returned by fn.
+//
+// This function reads all entities stored in the bucket and should not be
+// used by the handlers. It is meant for processes like the genesis export.
+func ForEach(db weave.ReadOnlyKVStore, b Bucket, fn func(Object) error) error {
+	prefix := b.DBKey(nil)
+	itr, err := db.Iterator(prefixRange(prefix))
+	if err != nil {
+		return err
+	}
+	defer itr.Release()
+
+	for {
+		key, value, err := itr.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		obj, err := b.Parse(joinKey(key[len(prefix):], nil), value)
+		if err != nil {
+			return err
+		}
+		if err := fn(obj); err != nil {
+			return err
+		}
+	}
+}
+
 func (b bucket) readRefs(db weave.ReadOnlyKVStore, refs [][]byte) ([]Object, error) {
 	if len(refs) == 0 {
 		return nil, nil
@@ -445,6 +445,93 @@ func TestBucketQuery(t *testing.T) {
 	}
 }
 
+func TestBucketRangeQuery(t *testing.T) {
+	bucket := NewBucket("spec", NewSimpleObj(nil, new(Counter))).
+		WithIndex("uniq", count, true)
+	qr := weave.NewQueryRouter()
+	bucket.Register("special", qr)
+
+	db := store.MemStore()
+	var models []weave.Model
+	for i, key := range []string{"a", "b", "c", "d", "e"} {
+		obj := NewSimpleObj([]byte(key), NewCounter(int64(10-i)))
+		assert.Nil(t, bucket.Save(db, obj))
+		val, err := obj.Value().Marshal()
+		assert.Nil(t, err)
+		models = append(models, weave.Model{Key: bucket.DBKey(obj.Key()), Value: val})
+	}
+	a, b, c, d, e := models[0], models[1], models[2], models[3], models[4]
+
+	cases := map[string]struct {
+		path     string
+		query    RangeQuery
+		expected [][]weave.Model
+	}{
+		"whole bucket": {
+			path:     "/special",
+			query:    RangeQuery{},
+			expected: [][]weave.Model{{a, b, c, d, e}},
+		},
+		"bounded range": {
+			path:     "/special",
+			query:    RangeQuery{Start: []byte("b"), End: []byte("d")},
+			expected: [][]weave.Model{{b, c}},
+		},
+		"paginated": {
+			path:     "/special",
+			query:    RangeQuery{Limit: 2},
+			expected: [][]weave.Model{{a, b}, {c, d}, {e}},
+		},
+		"paginated reverse": {
+			path:     "/special",
+			query:    RangeQuery{Limit: 2, Reverse: true},
+			expected: [][]weave.Model{{e, d}, {c, b}, {a}},
+		},
+		"paginated reverse bounded range": {
+			path:     "/special",
+			query:    RangeQuery{Start: []byte("b"), End: []byte("e"), Limit: 2, Reverse: true},
+			expected: [][]weave.Model{{d, c}, {b}},
+		},
+		"index range": {
+			path:     "/special/uniq",
+			query:    RangeQuery{Start: encodeSequence(7), End: encodeSequence(10)},
+			expected: [][]weave.Model{{d, c, b}},
+		},
+		"paginated index": {
+			path:     "/special/uniq",
+			query:    RangeQuery{Limit: 3},
+			expected: [][]weave.Model{{e, d, c}, {b, a}},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			qh, ok := qr.Handler(tc.path).(weave.CursorQueryHandler)
+			if !ok {
+				t.Fatal("query handler does not support cursor")
+			}
+			data, err := tc.query.Marshal()
+			assert.Nil(t, err)
+			for i, want := range tc.expected {
+				res, cursor, err := qh.QueryWithCursor(db, weave.RangeQueryMod, data)
+				assert.Nil(t, err)
+				assert.Equal(t, want, res)
+				if last := i == len(tc.expected)-1; last != (cursor == nil) {
+					t.Fatalf("unexpected cursor for page %d: %x", i, cursor)
+				}
+				data = cursor
+			}
+		})
+	}
+
+	// Range must not be empty.
+	data, err := (&RangeQuery{Start: []byte("c"), End: []byte("a")}).Marshal()
+	assert.Nil(t, err)
+	if _, err := bucket.Query(db, weave.RangeQueryMod, data); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 // Make sure saving indexes is a deterministic process. That is all writes
 // happen in the same order.
 func TestBucketIndexDeterministic(t *testing.T) {
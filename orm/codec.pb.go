@@ -167,29 +167,112 @@ func (m *VersionedIDRef) GetVersion() uint32 {
 	return 0
 }
 
+// RangeQuery is the query data used together with the range query modifier.
+// It describes a range of keys to iterate over, in ascending or descending
+// order.
+type RangeQuery struct {
+	// Start is the first key of the range (inclusive). An empty value means
+	// the range starts with the first key.
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the last key of the range (exclusive). An empty value means the
+	// range ends with the last key.
+	End []byte `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Limit is the maximum number of entries returned. When the result is
+	// truncated, a cursor is returned that continues the iteration. Zero means
+	// the server default. Server can return less entries than requested.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Reverse when set iterates over the range in descending order.
+	Reverse bool `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"`
+}
+
+func (m *RangeQuery) Reset()         { *m = RangeQuery{} }
+func (m *RangeQuery) String() string { return proto.CompactTextString(m) }
+func (*RangeQuery) ProtoMessage()    {}
+func (*RangeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4aef1e59ada91b17, []int{3}
+}
+func (m *RangeQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RangeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RangeQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RangeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeQuery.Merge(m, src)
+}
+func (m *RangeQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RangeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeQuery proto.InternalMessageInfo
+
+func (m *RangeQuery) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *RangeQuery) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *RangeQuery) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RangeQuery) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MultiRef)(nil), "orm.MultiRef")
 	proto.RegisterType((*Counter)(nil), "orm.Counter")
 	proto.RegisterType((*VersionedIDRef)(nil), "orm.VersionedIDRef")
+	proto.RegisterType((*RangeQuery)(nil), "orm.RangeQuery")
 }
 
 func init() { proto.RegisterFile("orm/codec.proto", fileDescriptor_4aef1e59ada91b17) }
 
 var fileDescriptor_4aef1e59ada91b17 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcf, 0x2f, 0xca, 0xd5,
-	0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xce, 0x2f, 0xca,
-	0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xf3, 0xf5, 0x41, 0x2c, 0x88, 0x94, 0x92, 0x1c, 0x17,
-	0x87, 0x6f, 0x69, 0x4e, 0x49, 0x66, 0x50, 0x6a, 0x9a, 0x90, 0x10, 0x17, 0x4b, 0x51, 0x6a, 0x5a,
-	0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0x4f, 0x10, 0x98, 0xad, 0x24, 0xcf, 0xc5, 0xee, 0x9c, 0x5f,
-	0x9a, 0x57, 0x92, 0x5a, 0x24, 0x24, 0xc2, 0xc5, 0x9a, 0x0c, 0x62, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0x30, 0x07, 0x41, 0x38, 0x4a, 0x4e, 0x5c, 0x7c, 0x61, 0xa9, 0x45, 0xc5, 0x99, 0xf9, 0x79, 0xa9,
-	0x29, 0x9e, 0x2e, 0x20, 0x63, 0xc4, 0xb8, 0x98, 0x32, 0x53, 0x24, 0x58, 0x14, 0x18, 0x35, 0x78,
-	0x9c, 0xd8, 0x1e, 0xdd, 0x93, 0x67, 0xf2, 0x74, 0x09, 0x62, 0xca, 0x4c, 0x11, 0x92, 0xe0, 0x62,
-	0x2f, 0x83, 0xa8, 0x94, 0x60, 0x55, 0x60, 0xd4, 0xe0, 0x0d, 0x82, 0x71, 0x9d, 0x24, 0x4e, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0xec, 0x4a, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb1, 0x84, 0x9f, 0xbd, 0xd3, 0x00, 0x00, 0x00,
+	// 255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0xbb, 0x6a, 0xc3, 0x30,
+	0x18, 0x85, 0x2d, 0x2b, 0x37, 0x7e, 0xdc, 0x0b, 0x22, 0x14, 0xd1, 0x41, 0x31, 0x9e, 0x3c, 0x35,
+	0x43, 0xdf, 0xc0, 0xcd, 0x92, 0xa1, 0x43, 0x35, 0x74, 0x4f, 0xad, 0x3f, 0x46, 0x10, 0x5b, 0x45,
+	0x96, 0x0b, 0x7d, 0x8b, 0x3e, 0x56, 0xc7, 0x8c, 0x9d, 0x4a, 0xb1, 0x5f, 0xa4, 0x48, 0x22, 0xdb,
+	0xf7, 0x49, 0x87, 0x73, 0x84, 0xe0, 0xc6, 0xd8, 0x76, 0x5b, 0x1b, 0x85, 0xf5, 0xc3, 0xbb, 0x35,
+	0xce, 0x30, 0x6a, 0x6c, 0x7b, 0xbf, 0x6e, 0x4c, 0x63, 0x82, 0x6f, 0x3d, 0xc5, 0xab, 0x42, 0xc0,
+	0xea, 0x79, 0x38, 0x39, 0x2d, 0xf1, 0xc8, 0x18, 0xcc, 0x2c, 0x1e, 0x7b, 0x4e, 0x72, 0x5a, 0x66,
+	0x32, 0x70, 0xb1, 0x81, 0xe5, 0x93, 0x19, 0x3a, 0x87, 0x96, 0xad, 0x61, 0x5e, 0x7b, 0xe4, 0x24,
+	0x27, 0x25, 0x95, 0x51, 0x8a, 0x0a, 0xae, 0x5f, 0xd1, 0xf6, 0xda, 0x74, 0xa8, 0xf6, 0x3b, 0x5f,
+	0x73, 0x07, 0xa9, 0x56, 0x7c, 0x96, 0x93, 0x32, 0xab, 0x16, 0xe3, 0xef, 0x26, 0xdd, 0xef, 0x64,
+	0xaa, 0x15, 0xe3, 0xb0, 0xfc, 0x88, 0x49, 0x3e, 0xcf, 0x49, 0x79, 0x25, 0x2f, 0x5a, 0x28, 0x00,
+	0x79, 0xe8, 0x1a, 0x7c, 0x19, 0xd0, 0x7e, 0xfa, 0x9d, 0xde, 0x1d, 0x6c, 0xdc, 0xc9, 0x64, 0x14,
+	0x76, 0x0b, 0x14, 0x3b, 0xc5, 0xd3, 0x70, 0xe6, 0xd1, 0xe7, 0x4e, 0xba, 0xd5, 0x8e, 0xd3, 0xd0,
+	0x16, 0xc5, 0xaf, 0x58, 0xf4, 0xc5, 0x18, 0x9e, 0xb0, 0x92, 0x17, 0xad, 0xf8, 0xf7, 0x28, 0xc8,
+	0x79, 0x14, 0xe4, 0x6f, 0x14, 0xe4, 0x6b, 0x12, 0xc9, 0x79, 0x12, 0xc9, 0xcf, 0x24, 0x92, 0xb7,
+	0x45, 0xf8, 0x8b, 0xc7, 0xff, 0x01, 0x00, 0x5d, 0xa8, 0xca, 0x48, 0x39, 0x01, 0x00, 0x00,
 }
 
 func (m *MultiRef) Marshal() (dAtA []byte, err error) {
@@ -270,6 +353,51 @@ func (m *VersionedIDRef) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RangeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangeQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Start) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Start)))
+		i += copy(dAtA[i:], m.Start)
+	}
+	if len(m.End) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.End)))
+		i += copy(dAtA[i:], m.End)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Limit))
+	}
+	if m.Reverse {
+		dAtA[i] = 0x20
+		i++
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -322,6 +450,29 @@ func (m *VersionedIDRef) Size() (n int) {
 	return n
 }
 
+func (m *RangeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.End)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCodec(uint64(m.Limit))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -598,6 +749,166 @@ func (m *VersionedIDRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RangeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RangeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RangeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start[:0], dAtA[iNdEx:postIndex]...)
+			if m.Start == nil {
+				m.Start = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = append(m.End[:0], dAtA[iNdEx:postIndex]...)
+			if m.End == nil {
+				m.End = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
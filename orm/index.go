@@ -27,7 +27,7 @@ type Index struct {
 	refKey func([]byte) []byte
 }
 
-var _ weave.QueryHandler = Index{}
+var _ weave.CursorQueryHandler = Index{}
 
 // NewIndex constructs an index with single key Indexer.
 // Indexer calculates the index for an object
@@ -164,11 +164,17 @@ func (i Index) GetAt(db weave.ReadOnlyKVStore, index []byte) ([][]byte, error) {
 	if val == nil {
 		return nil, nil
 	}
+	return i.refs(val)
+}
+
+// refs decodes the value of an index entry into a list of all pk it
+// references.
+func (i Index) refs(val []byte) ([][]byte, error) {
 	if i.unique {
 		return [][]byte{val}, nil
 	}
 	var data = new(MultiRef)
-	err = data.Unmarshal(val)
+	err := data.Unmarshal(val)
 	if err != nil {
 		return nil, err
 	}
@@ -206,26 +212,159 @@ func (i Index) GetPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([][]byte, err
 	return data, nil
 }
 
+// Order defines the order in which index scan results are returned.
+type Order int
+
+const (
+	// Ascending order returns results sorted by the index value, smallest
+	// first.
+	Ascending Order = iota
+	// Descending order returns results sorted by the index value, biggest
+	// first.
+	Descending
+)
+
+// ScanPrefix returns references of all entries with an index value that
+// begins with a given prefix. References are ordered by the index value.
+// At most limit references are returned, zero means no limit.
+func (i Index) ScanPrefix(db weave.ReadOnlyKVStore, prefix []byte, order Order, limit int) ([][]byte, error) {
+	start, end := prefixRange(i.IndexKey(prefix))
+	return i.scan(db, start, end, order, limit)
+}
+
+// ScanRange returns references of all entries with an index value within
+// the [start, end) range. An empty start or end means the range is not
+// bounded on that side. References are ordered by the index value. At most
+// limit references are returned, zero means no limit.
+func (i Index) ScanRange(db weave.ReadOnlyKVStore, start, end []byte, order Order, limit int) ([][]byte, error) {
+	if len(start) != 0 && len(end) != 0 && bytes.Compare(start, end) >= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range start must be before the end")
+	}
+	dbStart, dbEnd := prefixRange(i.id)
+	if len(start) != 0 {
+		dbStart = i.IndexKey(start)
+	}
+	if len(end) != 0 {
+		dbEnd = i.IndexKey(end)
+	}
+	return i.scan(db, dbStart, dbEnd, order, limit)
+}
+
+// scan iterates over index entries stored within [start, end) database key
+// range and returns references in requested order. References of a non
+// unique index entry are returned in the same order as the entries.
+func (i Index) scan(db weave.ReadOnlyKVStore, start, end []byte, order Order, limit int) ([][]byte, error) {
+	if limit < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "negative limit")
+	}
+
+	var (
+		itr weave.Iterator
+		err error
+	)
+	switch order {
+	case Ascending:
+		itr, err = db.Iterator(start, end)
+	case Descending:
+		itr, err = db.ReverseIterator(start, end)
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "unknown order: %d", order)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Release()
+
+	var res [][]byte
+	for limit == 0 || len(res) < limit {
+		_, value, err := itr.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		refs, err := i.refs(value)
+		if err != nil {
+			return nil, err
+		}
+		if order == Descending {
+			for l, r := 0, len(refs)-1; l < r; l, r = l+1, r-1 {
+				refs[l], refs[r] = refs[r], refs[l]
+			}
+		}
+		res = append(res, refs...)
+	}
+	if limit != 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res, nil
+}
+
 // Query handles queries from the QueryRouter
 func (i Index) Query(db weave.ReadOnlyKVStore, mod string,
 	data []byte) ([]weave.Model, error) {
+	models, _, err := i.QueryWithCursor(db, mod, data)
+	return models, err
+}
+
+// QueryWithCursor handles queries from the QueryRouter. Prefix queries
+// accept QueryOptions and return the index value of the last returned entry
+// as the cursor if the result was truncated. Range queries expect a
+// serialized RangeQuery with index values as the range bounds and return the
+// RangeQuery to continue with as the cursor. Limit is applied to the index
+// entries, so for non unique indexes more objects can be returned.
+func (i Index) QueryWithCursor(db weave.ReadOnlyKVStore, mod string,
+	data []byte) ([]weave.Model, []byte, error) {
 
+	mod, opts, err := weave.SplitQueryMod(mod)
+	if err != nil {
+		return nil, nil, err
+	}
 	switch mod {
 	case weave.KeyQueryMod:
 		refs, err := i.GetAt(db, data)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		return i.loadRefs(db, refs)
+		res, err := i.loadRefs(db, refs)
+		return res, nil, err
 	case weave.PrefixQueryMod:
-		refs, err := i.GetPrefix(db, data)
+		entries, cursor, err := queryPrefix(db, i.id, data, opts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+		return i.loadEntries(db, entries, cursor)
+	case weave.RangeQueryMod:
+		q, err := parseRangeQuery(data)
+		if err != nil {
+			return nil, nil, err
 		}
-		return i.loadRefs(db, refs)
+		entries, cursor, err := queryRange(db, i.id, q)
+		if err != nil {
+			return nil, nil, err
+		}
+		return i.loadEntries(db, entries, cursor)
 	default:
-		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+		return nil, nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
+	}
+}
+
+// loadEntries loads all objects referenced by given index entries.
+func (i Index) loadEntries(db weave.ReadOnlyKVStore, entries []weave.Model, cursor []byte) ([]weave.Model, []byte, error) {
+	var refs [][]byte
+	for _, e := range entries {
+		r, err := i.refs(e.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		refs = append(refs, r...)
+	}
+	res, err := i.loadRefs(db, refs)
+	if err != nil {
+		return nil, nil, err
 	}
+	return res, cursor, nil
 }
 
 func (i Index) loadRefs(db weave.ReadOnlyKVStore,
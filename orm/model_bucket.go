@@ -1,6 +1,7 @@
 package orm
 
 import (
+	"bytes"
 	"reflect"
 
 	"github.com/iov-one/weave"
@@ -48,6 +49,21 @@ type ModelBucket interface {
 	// modified.
 	ByIndex(db weave.ReadOnlyKVStore, indexName string, key []byte, dest ModelSlicePtr) (keys [][]byte, err error)
 
+	// ByIndexPrefix returns objects with the secondary index value that
+	// begins with given prefix. Objects are sorted by the index value in
+	// given order and at most limit objects are returned. Zero limit means
+	// no limit.
+	// All matching entities are appended to given destination slice.
+	ByIndexPrefix(db weave.ReadOnlyKVStore, indexName string, prefix []byte, order Order, limit int, dest ModelSlicePtr) (keys [][]byte, err error)
+
+	// ByIndexRange returns objects with the secondary index value within
+	// the [start, end) range. An empty start or end means the range is not
+	// bounded on that side. Objects are sorted by the index value in given
+	// order and at most limit objects are returned. Zero limit means no
+	// limit.
+	// All matching entities are appended to given destination slice.
+	ByIndexRange(db weave.ReadOnlyKVStore, indexName string, start, end []byte, order Order, limit int, dest ModelSlicePtr) (keys [][]byte, err error)
+
 	// Put saves given model in the database. Before inserting into
 	// database, model is validated using its Validate method.
 	// If the key is nil or zero length then a sequence generator is used
@@ -66,11 +82,31 @@ type ModelBucket interface {
 	// checks the existence of it.
 	Has(db weave.KVStore, key []byte) error
 
+	// Iterate returns an iterator over all entities with the primary key
+	// within the [start, end) range, in ascending key order. An empty start
+	// or end means the range is not bounded on that side.
+	// Returned iterator must be released after use.
+	Iterate(db weave.ReadOnlyKVStore, start, end []byte) (ModelIterator, error)
+
 	// Register registers this buckets content to be accessible via query
 	// requests under the given name.
 	Register(name string, r weave.QueryRouter)
 }
 
+// ModelIterator allows to lazily load entities stored in a ModelBucket, one
+// at a time.
+type ModelIterator interface {
+	// LoadNext loads the next entity into given destination model and
+	// returns its primary key.
+	// This method returns ErrIteratorDone if there are no more entities.
+	// If given model type cannot be used to contain stored entity, ErrType
+	// is returned.
+	LoadNext(dest Model) (key []byte, err error)
+
+	// Release releases the iterator, allowing it to do any needed cleanup.
+	Release()
+}
+
 // NewModelBucket returns a ModelBucket instance. This implementation relies on
 // a bucket instance. Final implementation should operate directly on the
 // KVStore instead.
@@ -152,6 +188,28 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 	if err != nil {
 		return nil, err
 	}
+	return mb.appendObjects(objs, destination)
+}
+
+func (mb *modelBucket) ByIndexPrefix(db weave.ReadOnlyKVStore, indexName string, prefix []byte, order Order, limit int, destination ModelSlicePtr) ([][]byte, error) {
+	objs, err := mb.b.GetIndexedPrefix(db, indexName, prefix, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	return mb.appendObjects(objs, destination)
+}
+
+func (mb *modelBucket) ByIndexRange(db weave.ReadOnlyKVStore, indexName string, start, end []byte, order Order, limit int, destination ModelSlicePtr) ([][]byte, error) {
+	objs, err := mb.b.GetIndexedRange(db, indexName, start, end, order, limit)
+	if err != nil {
+		return nil, err
+	}
+	return mb.appendObjects(objs, destination)
+}
+
+// appendObjects appends values of all objects to the destination slice and
+// returns their keys.
+func (mb *modelBucket) appendObjects(objs []Object, destination ModelSlicePtr) ([][]byte, error) {
 	if len(objs) == 0 {
 		return nil, nil
 	}
@@ -192,7 +250,55 @@ func (mb *modelBucket) ByIndex(db weave.ReadOnlyKVStore, indexName string, key [
 		keys = append(keys, obj.Key())
 	}
 	return keys, nil
+}
+
+func (mb *modelBucket) Iterate(db weave.ReadOnlyKVStore, start, end []byte) (ModelIterator, error) {
+	if len(start) != 0 && len(end) != 0 && bytes.Compare(start, end) >= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range start must be before the end")
+	}
+	// As long as we rely on the Bucket implementation to access the
+	// database, we must refine the keys.
+	prefix := mb.b.DBKey(nil)
+	dbStart, dbEnd := prefixRange(prefix)
+	if len(start) != 0 {
+		dbStart = mb.b.DBKey(start)
+	}
+	if len(end) != 0 {
+		dbEnd = mb.b.DBKey(end)
+	}
+	it, err := db.Iterator(dbStart, dbEnd)
+	if err != nil {
+		return nil, err
+	}
+	return &modelIterator{it: it, prefix: prefix, model: mb.model}, nil
+}
+
+type modelIterator struct {
+	it     weave.Iterator
+	prefix []byte
+	model  reflect.Type
+}
+
+var _ ModelIterator = (*modelIterator)(nil)
+
+func (mi *modelIterator) LoadNext(dest Model) ([]byte, error) {
+	if tp := reflect.TypeOf(dest); tp.Kind() != reflect.Ptr || tp.Elem() != mi.model {
+		return nil, errors.Wrapf(errors.ErrType, "this bucket operates on %s model and cannot return %T", mi.model, dest)
+	}
+	key, value, err := mi.it.Next()
+	if err != nil {
+		return nil, err
+	}
+	// Unmarshal is merging, so the destination must be reset first.
+	reflect.ValueOf(dest).Elem().Set(reflect.Zero(mi.model))
+	if err := dest.Unmarshal(value); err != nil {
+		return nil, errors.Wrap(errors.ErrState, err.Error())
+	}
+	return joinKey(key[len(mi.prefix):], nil), nil
+}
 
+func (mi *modelIterator) Release() {
+	mi.it.Release()
 }
 
 func (mb *modelBucket) Put(db weave.KVStore, key []byte, m Model) ([]byte, error) {
@@ -2,6 +2,7 @@ package orm
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -186,6 +187,101 @@ func TestModelBucketByIndex(t *testing.T) {
 	}
 }
 
+func TestModelBucketByIndexScan(t *testing.T) {
+	db := store.MemStore()
+
+	// Index by the thousands, padded so that the lexicographical order
+	// of the index values is the same as the numerical order.
+	indexByBigValue := func(obj Object) ([]byte, error) {
+		c, ok := obj.Value().(*Counter)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+		}
+		raw := fmt.Sprintf("%03d", c.Count/1000)
+		return []byte(raw), nil
+	}
+	b := NewModelBucket("cnts", &Counter{}, WithIndex("value", indexByBigValue, false))
+	for _, cnt := range []int64{1001, 2001, 4001, 4002, 12001} {
+		if _, err := b.Put(db, nil, &Counter{Count: cnt}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+
+	cases := map[string]struct {
+		Query    func(dest *[]Counter) ([][]byte, error)
+		WantErr  *errors.Error
+		WantRes  []Counter
+		WantKeys [][]byte
+	}{
+		"prefix ascending": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexPrefix(db, "value", []byte("00"), Ascending, 0, dest)
+			},
+			WantRes:  []Counter{{Count: 1001}, {Count: 2001}, {Count: 4001}, {Count: 4002}},
+			WantKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3), weavetest.SequenceID(4)},
+		},
+		"prefix descending with limit": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexPrefix(db, "value", []byte("0"), Descending, 2, dest)
+			},
+			WantRes:  []Counter{{Count: 12001}, {Count: 4002}},
+			WantKeys: [][]byte{weavetest.SequenceID(5), weavetest.SequenceID(4)},
+		},
+		"prefix without matches": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexPrefix(db, "value", []byte("9"), Ascending, 0, dest)
+			},
+			WantRes:  nil,
+			WantKeys: nil,
+		},
+		"range ascending": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexRange(db, "value", []byte("002"), []byte("012"), Ascending, 0, dest)
+			},
+			WantRes:  []Counter{{Count: 2001}, {Count: 4001}, {Count: 4002}},
+			WantKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(3), weavetest.SequenceID(4)},
+		},
+		"range descending with limit": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexRange(db, "value", nil, []byte("012"), Descending, 3, dest)
+			},
+			WantRes:  []Counter{{Count: 4002}, {Count: 4001}, {Count: 2001}},
+			WantKeys: [][]byte{weavetest.SequenceID(4), weavetest.SequenceID(3), weavetest.SequenceID(2)},
+		},
+		"range without an end": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexRange(db, "value", []byte("005"), nil, Ascending, 0, dest)
+			},
+			WantRes:  []Counter{{Count: 12001}},
+			WantKeys: [][]byte{weavetest.SequenceID(5)},
+		},
+		"invalid range": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexRange(db, "value", []byte("005"), []byte("001"), Ascending, 0, dest)
+			},
+			WantErr: errors.ErrInput,
+		},
+		"non existing index name": {
+			Query: func(dest *[]Counter) ([][]byte, error) {
+				return b.ByIndexRange(db, "xyz", nil, nil, Ascending, 0, dest)
+			},
+			WantErr: ErrInvalidIndex,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var dest []Counter
+			keys, err := tc.Query(&dest)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assert.Equal(t, tc.WantKeys, keys)
+			assert.Equal(t, tc.WantRes, dest)
+		})
+	}
+}
+
 func TestModelBucketPutWrongModelType(t *testing.T) {
 	db := store.MemStore()
 	b := NewModelBucket("cnts", &Counter{})
@@ -254,3 +350,94 @@ func TestModelBucketHas(t *testing.T) {
 		t.Fatalf("a non exists entity must return ErrNotFound: %s", err)
 	}
 }
+
+func TestModelBucketIterate(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	for _, key := range []string{"a", "b", "c", "d"} {
+		if _, err := b.Put(db, []byte(key), &Counter{Count: int64(key[0])}); err != nil {
+			t.Fatalf("cannot save counter instance: %s", err)
+		}
+	}
+	// An entity stored in another bucket must not be returned.
+	other := NewModelBucket("others", &Counter{})
+	if _, err := other.Put(db, []byte("a"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	cases := map[string]struct {
+		Start    string
+		End      string
+		WantErr  *errors.Error
+		WantKeys []string
+	}{
+		"all entities": {
+			WantKeys: []string{"a", "b", "c", "d"},
+		},
+		"bounded range": {
+			Start:    "b",
+			End:      "d",
+			WantKeys: []string{"b", "c"},
+		},
+		"range without an end": {
+			Start:    "c",
+			WantKeys: []string{"c", "d"},
+		},
+		"empty range": {
+			Start:    "x",
+			WantKeys: nil,
+		},
+		"invalid range": {
+			Start:   "d",
+			End:     "a",
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			it, err := b.Iterate(db, []byte(tc.Start), []byte(tc.End))
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			defer it.Release()
+
+			var keys []string
+			for {
+				var c Counter
+				key, err := it.LoadNext(&c)
+				if errors.ErrIteratorDone.Is(err) {
+					break
+				}
+				if err != nil {
+					t.Fatalf("cannot load next: %s", err)
+				}
+				assert.Equal(t, int64(key[0]), c.Count)
+				keys = append(keys, string(key))
+			}
+			assert.Equal(t, tc.WantKeys, keys)
+		})
+	}
+}
+
+func TestModelBucketIterateWrongModelType(t *testing.T) {
+	db := store.MemStore()
+	b := NewModelBucket("cnts", &Counter{})
+	if _, err := b.Put(db, []byte("counter"), &Counter{Count: 1}); err != nil {
+		t.Fatalf("cannot save counter instance: %s", err)
+	}
+
+	it, err := b.Iterate(db, nil, nil)
+	if err != nil {
+		t.Fatalf("cannot create iterator: %s", err)
+	}
+	defer it.Release()
+
+	var ref MultiRef
+	if _, err := it.LoadNext(&ref); !errors.ErrType.Is(err) {
+		t.Fatalf("unexpected error when loading wrong model type value: %s", err)
+	}
+}
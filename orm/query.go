@@ -1,32 +1,63 @@
 package orm
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
 
 // RegisterQuery will register a root query (literal keys)
-// under "/"
+// under "/". Key, prefix and range queries are supported.
 func RegisterQuery(qr weave.QueryRouter) {
 	// this never writes, just used to query unprefixed keys
 	bucket{}.Register("", qr)
 }
 
-// consumeIterator will read all remaining data into an
-// array and close the iterator
-func consumeIterator(itr weave.Iterator) ([]weave.Model, error) {
+// MaxQueryResults is the maximum number of entries returned by a single
+// prefix or range query. If more entries match the query, the result is
+// truncated and a cursor is returned that allows to continue the query.
+var MaxQueryResults uint32 = 1000
+
+// queryLimit returns the number of entries a query can return, given the
+// number requested by the client.
+func queryLimit(requested uint32) int {
+	if requested == 0 || requested > MaxQueryResults {
+		return int(MaxQueryResults)
+	}
+	return int(requested)
+}
+
+// iterate reads at most limit entries from the [start, end) range. It
+// returns true if there are more entries left in that range.
+func iterate(db weave.ReadOnlyKVStore, start, end []byte, reverse bool, limit int) ([]weave.Model, bool, error) {
+	var (
+		itr weave.Iterator
+		err error
+	)
+	if reverse {
+		itr, err = db.ReverseIterator(start, end)
+	} else {
+		itr, err = db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, err
+	}
 	defer itr.Release()
 
 	var res []weave.Model
-	key, value, err := itr.Next()
-	for err == nil {
+	for {
+		key, value, err := itr.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return res, false, nil
+		case err != nil:
+			return nil, false, err
+		case len(res) == limit:
+			return res, true, nil
+		}
 		res = append(res, weave.Model{Key: key, Value: value})
-		key, value, err = itr.Next()
-	}
-	if !errors.ErrIteratorDone.Is(err) {
-		return nil, err
 	}
-	return res, nil
 }
 
 // prefixRange turns a prefix into (start, end) to create
@@ -56,11 +87,76 @@ func prefixRange(prefix []byte) ([]byte, []byte) {
 	return prefix, end
 }
 
-// queryPrefix returns a prefix query as Models
-func queryPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([]weave.Model, error) {
-	iter, err := db.Iterator(prefixRange(prefix))
+// queryPrefix returns a prefix query as Models. Keys are matched within
+// the key space of given namespace. The result is paginated according to
+// the options. If truncated, the returned cursor is the last returned key
+// (without the namespace) and can be used as the After option.
+func queryPrefix(db weave.ReadOnlyKVStore, namespace, prefix []byte, opts weave.QueryOptions) ([]weave.Model, []byte, error) {
+	start, end := prefixRange(joinKey(namespace, prefix))
+	if len(opts.After) != 0 {
+		// Continue right after the last returned key.
+		after := joinKey(joinKey(namespace, opts.After), []byte{0})
+		if bytes.Compare(after, start) > 0 {
+			start = after
+		}
+	}
+	res, more, err := iterate(db, start, end, false, queryLimit(opts.Limit))
+	if err != nil || !more {
+		return res, nil, err
+	}
+	last := res[len(res)-1].Key
+	return res, joinKey(last[len(namespace):], nil), nil
+}
+
+// queryRange iterates over the range of keys described by the query within
+// the key space of given namespace. The result is limited to q.Limit
+// entries. If truncated, the returned cursor is a serialized RangeQuery that
+// continues the iteration.
+func queryRange(db weave.ReadOnlyKVStore, namespace []byte, q *RangeQuery) ([]weave.Model, []byte, error) {
+	start, end := prefixRange(namespace)
+	if len(q.Start) != 0 {
+		start = joinKey(namespace, q.Start)
+	}
+	if len(q.End) != 0 {
+		end = joinKey(namespace, q.End)
+	}
+
+	res, more, err := iterate(db, start, end, q.Reverse, queryLimit(q.Limit))
+	if err != nil || !more {
+		return res, nil, err
+	}
+
+	last := res[len(res)-1].Key[len(namespace):]
+	next := *q
+	if q.Reverse {
+		// End is exclusive, so the last returned key will be skipped.
+		next.End = joinKey(last, nil)
+	} else {
+		next.Start = joinKey(last, []byte{0})
+	}
+	cursor, err := next.Marshal()
 	if err != nil {
-		return nil, err
+		return nil, nil, errors.Wrap(err, "cursor")
 	}
-	return consumeIterator(iter)
+	return res, cursor, nil
+}
+
+// parseRangeQuery decodes range query data.
+func parseRangeQuery(data []byte) (*RangeQuery, error) {
+	var q RangeQuery
+	if err := q.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot decode range query")
+	}
+	if len(q.Start) != 0 && len(q.End) != 0 && bytes.Compare(q.Start, q.End) >= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "range start must be before the end")
+	}
+	return &q, nil
+}
+
+// joinKey returns a new slice that is a concatenation of both keys.
+func joinKey(a, b []byte) []byte {
+	out := make([]byte, len(a)+len(b))
+	copy(out, a)
+	copy(out[len(a):], b)
+	return out
 }
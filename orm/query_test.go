@@ -74,9 +74,56 @@ func TestQueryPrefix(t *testing.T) {
 				assert.Nil(t, db.Set(m.Key, m.Value))
 			}
 
-			res, err := queryPrefix(db, tc.prefix)
+			res, _, err := queryPrefix(db, nil, tc.prefix, weave.QueryOptions{})
 			assert.Nil(t, err)
 			assert.Equal(t, tc.expected, res)
 		})
 	}
 }
+
+func TestQueryPrefixPagination(t *testing.T) {
+	defer func(max uint32) { MaxQueryResults = max }(MaxQueryResults)
+	MaxQueryResults = 3
+
+	db := store.MemStore()
+	var models []weave.Model
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		m := weave.Model{Key: []byte("ns:" + key), Value: []byte(key)}
+		assert.Nil(t, db.Set(m.Key, m.Value))
+		models = append(models, m)
+	}
+	assert.Nil(t, db.Set([]byte("other:x"), []byte("x")))
+
+	cases := map[string]struct {
+		limit    uint32
+		expected [][]weave.Model
+	}{
+		"server maximum": {
+			limit:    0,
+			expected: [][]weave.Model{models[:3], models[3:]},
+		},
+		"limit above server maximum": {
+			limit:    10,
+			expected: [][]weave.Model{models[:3], models[3:]},
+		},
+		"custom limit": {
+			limit:    2,
+			expected: [][]weave.Model{models[:2], models[2:4], models[4:]},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			opts := weave.QueryOptions{Limit: tc.limit}
+			for i, want := range tc.expected {
+				res, cursor, err := queryPrefix(db, []byte("ns:"), nil, opts)
+				assert.Nil(t, err)
+				assert.Equal(t, want, res)
+				if last := i == len(tc.expected)-1; last != (cursor == nil) {
+					t.Fatalf("unexpected cursor for page %d: %q", i, cursor)
+				}
+				opts.After = cursor
+			}
+		})
+	}
+}
@@ -35,6 +35,22 @@ func (s *Sequence) NextInt(db weave.KVStore) (int64, error) {
 	return val, err
 }
 
+// ReserveVal makes sure that the sequence never returns given value or any
+// lower value. It returns given value encoded the same way as NextVal does.
+// This is useful when entities with known IDs are inserted, for example
+// during the genesis initialization.
+func (s *Sequence) ReserveVal(db weave.KVStore, val int64) ([]byte, error) {
+	cur, _, err := s.increment(db, 0)
+	if err != nil {
+		return nil, err
+	}
+	raw := encodeSequence(val)
+	if cur >= val {
+		return raw, nil
+	}
+	return raw, db.Set(s.id, raw)
+}
+
 func (s *Sequence) increment(db weave.KVStore, inc int64) (int64, []byte, error) {
 	raw, err := db.Get(s.id)
 	if err != nil {
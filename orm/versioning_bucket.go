@@ -43,9 +43,20 @@ func (b VersioningBucket) GetLatestVersion(db weave.ReadOnlyKVStore, id []byte)
 		return nil, nil, errors.Wrap(err, "failed to marshal versioned ID ref")
 	}
 	dbKeyLength := len(b.DBKey(prefix)) - len(prefix)
-	matches, err := b.Query(db, weave.PrefixQueryMod, prefix)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "prefix query")
+	var (
+		matches []weave.Model
+		opts    weave.QueryOptions
+	)
+	for {
+		page, cursor, err := b.QueryWithCursor(db, weave.QueryMod(weave.PrefixQueryMod, opts), prefix)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "prefix query")
+		}
+		matches = append(matches, page...)
+		if cursor == nil {
+			break
+		}
+		opts.After = cursor
 	}
 	// find highest version for that ID
 	var highestVersion VersionedIDRef
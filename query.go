@@ -1,19 +1,84 @@
 package weave
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/weave/errors"
 )
 
 const (
 	// KeyQueryMod means to query for exact match (key)
 	KeyQueryMod = ""
-	// PrefixQueryMod means to query for anything with this prefix
+	// PrefixQueryMod means to query for anything with this prefix. The
+	// result can be paginated using QueryOptions.
 	PrefixQueryMod = "prefix"
-	// RangeQueryMod means to expect complex range query
-	// TODO: implement
+	// RangeQueryMod means to expect complex range query. Query data
+	// describes the range, its encoding depends on the query handler.
 	RangeQueryMod = "range"
 )
 
+// QueryOptions are optional parameters of a query. They are appended to
+// the query modifier using the URL query format, for example
+// "prefix&limit=10&after=0a0b".
+type QueryOptions struct {
+	// Limit is the maximum number of results to return. Zero means the
+	// server default. A server can return less results than requested.
+	Limit uint32
+	// After is a cursor returned by a previous query. When provided, the
+	// result starts right after the entry described by the cursor.
+	After []byte
+}
+
+// String returns the URL query encoded options.
+func (o QueryOptions) String() string {
+	v := make(url.Values)
+	if o.Limit != 0 {
+		v.Set("limit", strconv.FormatUint(uint64(o.Limit), 10))
+	}
+	if len(o.After) != 0 {
+		v.Set("after", hex.EncodeToString(o.After))
+	}
+	return v.Encode()
+}
+
+// QueryMod returns the query modifier with given options appended.
+func QueryMod(mod string, opts QueryOptions) string {
+	if enc := opts.String(); enc != "" {
+		return mod + "&" + enc
+	}
+	return mod
+}
+
+// SplitQueryMod splits the query modifier into its name and options.
+func SplitQueryMod(mod string) (string, QueryOptions, error) {
+	var opts QueryOptions
+	chunks := strings.SplitN(mod, "&", 2)
+	if len(chunks) == 1 {
+		return mod, opts, nil
+	}
+	v, err := url.ParseQuery(chunks[1])
+	if err != nil {
+		return "", opts, errors.Wrap(errors.ErrInput, "cannot parse query options")
+	}
+	if raw := v.Get("limit"); raw != "" {
+		limit, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return "", opts, errors.Wrap(errors.ErrInput, "invalid limit")
+		}
+		opts.Limit = uint32(limit)
+	}
+	if raw := v.Get("after"); raw != "" {
+		if opts.After, err = hex.DecodeString(raw); err != nil {
+			return "", opts, errors.Wrap(errors.ErrInput, "invalid after")
+		}
+	}
+	return chunks[0], opts, nil
+}
+
 // Model groups together key and value to return
 type Model struct {
 	Key   []byte
@@ -33,6 +98,14 @@ type QueryHandler interface {
 	Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error)
 }
 
+// CursorQueryHandler is a QueryHandler that can return a partial result. If
+// the result is not complete, a non empty cursor is returned that must be
+// used as the query data in order to continue the query.
+type CursorQueryHandler interface {
+	QueryHandler
+	QueryWithCursor(db ReadOnlyKVStore, mod string, data []byte) (models []Model, cursor []byte, err error)
+}
+
 // QueryRegister is a function that adds some handlers
 // to this router
 type QueryRegister func(QueryRouter)
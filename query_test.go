@@ -0,0 +1,54 @@
+package weave
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSplitQueryMod(t *testing.T) {
+	cases := map[string]struct {
+		mod      string
+		wantMod  string
+		wantOpts QueryOptions
+		wantErr  *errors.Error
+	}{
+		"key query": {
+			mod:     KeyQueryMod,
+			wantMod: KeyQueryMod,
+		},
+		"no options": {
+			mod:     PrefixQueryMod,
+			wantMod: PrefixQueryMod,
+		},
+		"all options": {
+			mod:      "prefix&after=0a0b&limit=20",
+			wantMod:  PrefixQueryMod,
+			wantOpts: QueryOptions{Limit: 20, After: []byte{0x0a, 0x0b}},
+		},
+		"invalid limit": {
+			mod:     "prefix&limit=-1",
+			wantErr: errors.ErrInput,
+		},
+		"invalid after": {
+			mod:     "prefix&after=xyz",
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			mod, opts, err := SplitQueryMod(tc.mod)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.wantMod, mod)
+			assert.Equal(t, tc.wantOpts, opts)
+			assert.Equal(t, tc.mod, QueryMod(mod, opts))
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package weave
 
+import (
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
 //////////////////////////////////////////////////////////
 // Defines all public interfaces for interacting with stores
 //
@@ -132,16 +136,16 @@ type CommitKVStore interface {
 	// returns nil iff key doesn't exist. Panics on nil key.
 	Get(key []byte) ([]byte, error)
 
-	// TODO: Get with proof, also historical queries
-	// GetVersionedWithProof(key []byte, version int64) (value []byte)
-
-	// func (b *Bonsai) GetWithProof(key []byte) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetWithProof(key)
-	// }
+	// GetVersionedWithProof returns the value stored under the key in the
+	// state committed at given version, together with a merkle proof of
+	// its existence (or absence if the value is nil). The proof can be
+	// verified against the root hash of that version.
+	GetVersionedWithProof(key []byte, version int64) ([]byte, merkle.ProofOp, error)
 
-	// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-	// }
+	// ReadOnlyAtVersion returns a read-only view of the state as it was
+	// committed at given version. An error is returned if that version
+	// was not committed yet or if it was already pruned.
+	ReadOnlyAtVersion(version int64) (ReadOnlyKVStore, error)
 
 	// Get a CacheWrap to perform actions
 	// TODO: add Batch to atomic writes and efficiency
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"github.com/iov-one/weave"
+)
+
+// GasConfig defines the amount of gas charged for each store operation.
+type GasConfig struct {
+	// HasCost is charged for every Has call.
+	HasCost int64
+	// ReadCostFlat is charged for every Get call.
+	ReadCostFlat int64
+	// ReadCostPerByte is charged for every byte of the key and the value
+	// returned by Get.
+	ReadCostPerByte int64
+	// WriteCostFlat is charged for every Set call.
+	WriteCostFlat int64
+	// WriteCostPerByte is charged for every byte of the key and the value
+	// written by Set.
+	WriteCostPerByte int64
+	// DeleteCost is charged for every Delete call.
+	DeleteCost int64
+	// IterNextCostFlat is charged for every entry returned by an iterator.
+	IterNextCostFlat int64
+	// IterNextCostPerByte is charged for every byte of the key and the
+	// value returned by an iterator.
+	IterNextCostPerByte int64
+}
+
+// DefaultGasConfig returns the gas costs used when no custom configuration
+// is provided.
+func DefaultGasConfig() GasConfig {
+	return GasConfig{
+		HasCost:             1000,
+		ReadCostFlat:        1000,
+		ReadCostPerByte:     3,
+		WriteCostFlat:       2000,
+		WriteCostPerByte:    30,
+		DeleteCost:          1000,
+		IterNextCostFlat:    30,
+		IterNextCostPerByte: 3,
+	}
+}
+
+// GasRules declares how much gas is charged for store operations and how much
+// gas can be consumed. Gas rules decide the outcome of transaction processing,
+// so they must be the same on all nodes. They are part of the state and must
+// never be configured per node.
+type GasRules struct {
+	// Costs declares the amount of gas charged for store operations.
+	Costs GasConfig
+	// TxLimit is the maximum amount of gas a single transaction can
+	// consume. Zero means no limit.
+	TxLimit int64
+	// BlockLimit is the maximum amount of gas all transactions of a block
+	// can consume. Zero means no limit.
+	BlockLimit int64
+}
+
+// GasRulesLoader returns the gas rules that apply to given state.
+type GasRulesLoader func(db ReadOnlyKVStore) (GasRules, error)
+
+// DefaultGasRules is a GasRulesLoader that always returns the default costs
+// without any limits.
+func DefaultGasRules(ReadOnlyKVStore) (GasRules, error) {
+	return GasRules{Costs: DefaultGasConfig()}, nil
+}
+
+// GasKVStore wraps a KVStore and charges gas from the meter for every
+// operation. Once the meter runs out of gas, all operations fail with
+// errors.ErrOutOfGas.
+//
+// Cache wraps created from this store charge for the writes when they are
+// written back. All writes of a cache wrap are charged at once, so that running
+// out of gas never leaves the store partially written.
+type GasKVStore struct {
+	kv    KVStore
+	meter weave.GasMeter
+	conf  GasConfig
+}
+
+var _ CacheableKVStore = (*GasKVStore)(nil)
+
+// NewGasKVStore returns a store that charges gas for every operation
+// performed on kv.
+func NewGasKVStore(kv KVStore, meter weave.GasMeter, conf GasConfig) *GasKVStore {
+	return &GasKVStore{
+		kv:    kv,
+		meter: meter,
+		conf:  conf,
+	}
+}
+
+// Get charges for the read and the size of the returned data.
+func (g *GasKVStore) Get(key []byte) ([]byte, error) {
+	if err := g.meter.ConsumeGas(g.conf.ReadCostFlat, "read"); err != nil {
+		return nil, err
+	}
+	value, err := g.kv.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	size := int64(len(key) + len(value))
+	if err := g.meter.ConsumeGas(g.conf.ReadCostPerByte*size, "read bytes"); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Has charges a flat fee for the check.
+func (g *GasKVStore) Has(key []byte) (bool, error) {
+	if err := g.meter.ConsumeGas(g.conf.HasCost, "has"); err != nil {
+		return false, err
+	}
+	return g.kv.Has(key)
+}
+
+// Set charges for the write and the size of the written data.
+func (g *GasKVStore) Set(key, value []byte) error {
+	if err := g.meter.ConsumeGas(g.conf.WriteCostFlat, "write"); err != nil {
+		return err
+	}
+	size := int64(len(key) + len(value))
+	if err := g.meter.ConsumeGas(g.conf.WriteCostPerByte*size, "write bytes"); err != nil {
+		return err
+	}
+	return g.kv.Set(key, value)
+}
+
+// Delete charges a flat fee for the removal.
+func (g *GasKVStore) Delete(key []byte) error {
+	if err := g.meter.ConsumeGas(g.conf.DeleteCost, "delete"); err != nil {
+		return err
+	}
+	return g.kv.Delete(key)
+}
+
+// Iterator returns an iterator that charges for every returned entry.
+func (g *GasKVStore) Iterator(start, end []byte) (Iterator, error) {
+	it, err := g.kv.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &gasIterator{Iterator: it, meter: g.meter, conf: g.conf}, nil
+}
+
+// ReverseIterator returns an iterator that charges for every returned entry.
+func (g *GasKVStore) ReverseIterator(start, end []byte) (Iterator, error) {
+	it, err := g.kv.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &gasIterator{Iterator: it, meter: g.meter, conf: g.conf}, nil
+}
+
+// NewBatch returns a batch that charges for all writes before any of them is
+// written.
+func (g *GasKVStore) NewBatch() Batch {
+	return &gasBatch{batch: g.kv.NewBatch(), meter: g.meter, conf: g.conf}
+}
+
+// CacheWrap returns a cache that charges for reads when they reach this
+// store and for writes when the cache is written.
+func (g *GasKVStore) CacheWrap() KVCacheWrap {
+	return NewBTreeCacheWrap(g, g.NewBatch(), nil)
+}
+
+// gasIterator charges gas for every entry it returns.
+type gasIterator struct {
+	Iterator
+	meter weave.GasMeter
+	conf  GasConfig
+}
+
+func (g *gasIterator) Next() ([]byte, []byte, error) {
+	key, value, err := g.Iterator.Next()
+	if err != nil {
+		return nil, nil, err
+	}
+	size := int64(len(key) + len(value))
+	cost := g.conf.IterNextCostFlat + g.conf.IterNextCostPerByte*size
+	if err := g.meter.ConsumeGas(cost, "iterate"); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+// gasBatch counts the cost of all collected operations and charges it when
+// written. Nothing is written if the meter runs out of gas.
+type gasBatch struct {
+	batch Batch
+	meter weave.GasMeter
+	conf  GasConfig
+	cost  int64
+}
+
+func (b *gasBatch) Set(key, value []byte) error {
+	size := int64(len(key) + len(value))
+	b.cost += b.conf.WriteCostFlat + b.conf.WriteCostPerByte*size
+	return b.batch.Set(key, value)
+}
+
+func (b *gasBatch) Delete(key []byte) error {
+	b.cost += b.conf.DeleteCost
+	return b.batch.Delete(key)
+}
+
+func (b *gasBatch) Write() error {
+	if err := b.meter.ConsumeGas(b.cost, "write batch"); err != nil {
+		return err
+	}
+	b.cost = 0
+	return b.batch.Write()
+}
@@ -0,0 +1,132 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGasKVStore(t *testing.T) {
+	conf := GasConfig{
+		HasCost:             5,
+		ReadCostFlat:        10,
+		ReadCostPerByte:     2,
+		WriteCostFlat:       100,
+		WriteCostPerByte:    3,
+		DeleteCost:          1000,
+		IterNextCostFlat:    10000,
+		IterNextCostPerByte: 4,
+	}
+
+	cases := map[string]struct {
+		op      func(KVStore) error
+		wantGas int64
+	}{
+		"has": {
+			op: func(db KVStore) error {
+				_, err := db.Has([]byte("a"))
+				return err
+			},
+			wantGas: 5,
+		},
+		"get existing": {
+			op: func(db KVStore) error {
+				_, err := db.Get([]byte("a"))
+				return err
+			},
+			// key and value are 1 and 3 bytes
+			wantGas: 10 + 2*4,
+		},
+		"get missing": {
+			op: func(db KVStore) error {
+				_, err := db.Get([]byte("xyz"))
+				return err
+			},
+			wantGas: 10 + 2*3,
+		},
+		"set": {
+			op: func(db KVStore) error {
+				return db.Set([]byte("c"), []byte("12345"))
+			},
+			wantGas: 100 + 3*6,
+		},
+		"delete": {
+			op: func(db KVStore) error {
+				return db.Delete([]byte("a"))
+			},
+			wantGas: 1000,
+		},
+		"iterate": {
+			op: func(db KVStore) error {
+				it, err := db.Iterator(nil, nil)
+				if err != nil {
+					return err
+				}
+				defer it.Release()
+				for {
+					if _, _, err := it.Next(); err != nil {
+						if errors.ErrIteratorDone.Is(err) {
+							return nil
+						}
+						return err
+					}
+				}
+			},
+			// two entries, 4 bytes each
+			wantGas: 2 * (10000 + 4*4),
+		},
+		"cache wrap write": {
+			op: func(db KVStore) error {
+				cache := db.(CacheableKVStore).CacheWrap()
+				if err := cache.Set([]byte("c"), []byte("12345")); err != nil {
+					return err
+				}
+				return cache.Write()
+			},
+			wantGas: 100 + 3*6,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			base := MemStore()
+			assert.Nil(t, base.Set([]byte("a"), []byte("one")))
+			assert.Nil(t, base.Set([]byte("b"), []byte("two")))
+
+			meter := weave.NewGasMeter(0)
+			db := NewGasKVStore(base, meter, conf)
+			assert.Nil(t, tc.op(db))
+			assert.Equal(t, tc.wantGas, meter.GasConsumed())
+
+			// The same operation must fail when the limit is one
+			// gas unit too low.
+			meter = weave.NewGasMeter(tc.wantGas - 1)
+			db = NewGasKVStore(base, meter, conf)
+			if err := tc.op(db); !errors.ErrOutOfGas.Is(err) {
+				t.Fatalf("want out of gas error, got %+v", err)
+			}
+		})
+	}
+}
+
+func TestGasKVStoreCacheWriteIsAtomic(t *testing.T) {
+	conf := GasConfig{WriteCostFlat: 100}
+	base := MemStore()
+
+	// The limit allows to write only one of the two keys.
+	meter := weave.NewGasMeter(150)
+	cache := NewGasKVStore(base, meter, conf).CacheWrap()
+	assert.Nil(t, cache.Set([]byte("a"), []byte("one")))
+	assert.Nil(t, cache.Set([]byte("b"), []byte("two")))
+	if err := cache.Write(); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %+v", err)
+	}
+
+	for _, key := range []string{"a", "b"} {
+		if ok, err := base.Has([]byte(key)); err != nil || ok {
+			t.Fatalf("want %q to not be written: %v", key, err)
+		}
+	}
+}
@@ -2,22 +2,27 @@ package iavl
 
 import (
 	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 )
 
-// TODO: make these configurable?
 const (
-	DefaultCacheSize int   = 10000
-	DefaultHistory   int64 = 20
+	DefaultCacheSize int = 10000
+	// DefaultHistory is the number of recent versions kept by
+	// PruneDefault strategy.
+	DefaultHistory int64 = 20
 )
 
 // CommitStore manages a iavl committed state
 type CommitStore struct {
-	tree       *iavl.MutableTree
-	numHistory int64
+	tree    *iavl.MutableTree
+	pruning PruningStrategy
+	// db is the tree node storage. It is nil if the store was created
+	// from an already loaded tree.
+	db dbm.DB
 }
 
 var _ store.CommitKVStore = CommitStore{}
@@ -32,7 +37,7 @@ func NewCommitStore(path, name string) CommitStore {
 	}
 
 	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	commit := CommitStore{tree, DefaultHistory}
+	commit := CommitStore{tree, PruneDefault, db}
 
 	err = commit.LoadLatestVersion()
 	if err != nil {
@@ -42,17 +47,44 @@ func NewCommitStore(path, name string) CommitStore {
 	return commit
 }
 
+// WithPruning returns a copy of the store that deletes old versions on
+// commit, according to given strategy. All already committed versions that
+// the strategy does not keep are deleted immediately, so that a node
+// restarted with a more restrictive strategy does not keep them forever.
+func (s CommitStore) WithPruning(p PruningStrategy) CommitStore {
+	s.pruning = p
+	if err := s.prune(); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// prune deletes all committed versions that are not kept by the pruning
+// strategy.
+func (s CommitStore) prune() error {
+	latest := s.tree.Version()
+	for v := int64(1); v < latest; v++ {
+		if s.pruning.keep(v, latest) || !s.tree.VersionExists(v) {
+			continue
+		}
+		if err := s.tree.DeleteVersion(v); err != nil {
+			return errors.Wrapf(errors.ErrDatabase, "cannot delete version %d: %s", v, err)
+		}
+	}
+	return nil
+}
+
 // NewCommitStoreFromTree accepts a preloaded MutableTree and wraps it
 // Mainly designed for test code... or devs who want full control
 func NewCommitStoreFromTree(tree *iavl.MutableTree) CommitStore {
-	return CommitStore{tree, DefaultHistory}
+	return CommitStore{tree, PruneDefault, nil}
 }
 
 // MockCommitStore creates a new in-memory store for testing
 func MockCommitStore() CommitStore {
 	var db dbm.DB = dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	return CommitStore{tree, DefaultHistory}
+	return CommitStore{tree, PruneDefault, db}
 }
 
 // Get returns the value at last committed state
@@ -67,6 +99,55 @@ func (s CommitStore) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// ReadOnlyAtVersion returns a read-only view of the state as it was
+// committed at given version.
+// Returns error if the version was not committed yet or was already pruned.
+func (s CommitStore) ReadOnlyAtVersion(version int64) (store.ReadOnlyKVStore, error) {
+	if err := s.checkVersion(version); err != nil {
+		return nil, err
+	}
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrDatabase, "cannot load version %d: %s", version, err)
+	}
+	return readOnlyAdapter{tree: tree}, nil
+}
+
+// GetVersionedWithProof returns the value stored under the key at given
+// committed version, together with an IAVL proof of existence. If the
+// key does not exist, nil value and a proof of absence is returned.
+func (s CommitStore) GetVersionedWithProof(key []byte, version int64) ([]byte, merkle.ProofOp, error) {
+	if len(key) == 0 {
+		return nil, merkle.ProofOp{}, errors.Wrap(errors.ErrDatabase, "nil key")
+	}
+	if err := s.checkVersion(version); err != nil {
+		return nil, merkle.ProofOp{}, err
+	}
+	val, proof, err := s.tree.GetVersionedWithProof(key, version)
+	if err != nil {
+		return nil, merkle.ProofOp{}, errors.Wrapf(errors.ErrDatabase, "cannot build proof: %s", err)
+	}
+	if val == nil {
+		return nil, iavl.NewIAVLAbsenceOp(key, proof).ProofOp(), nil
+	}
+	return val, iavl.NewIAVLValueOp(key, proof).ProofOp(), nil
+}
+
+// checkVersion returns an error if given version cannot be read, because
+// it was not committed yet or it was already pruned.
+func (s CommitStore) checkVersion(version int64) error {
+	if version <= 0 {
+		return errors.Wrapf(errors.ErrInput, "invalid version %d", version)
+	}
+	if latest := s.tree.Version(); version > latest {
+		return errors.Wrapf(errors.ErrInput, "version %d not committed yet, latest is %d", version, latest)
+	}
+	if !s.tree.VersionExists(version) {
+		return errors.Wrapf(errors.ErrNotFound, "version %d was pruned, pruning strategy is to %s", version, s.pruning)
+	}
+	return nil
+}
+
 // Commit the next version to disk, and returns info
 func (s CommitStore) Commit() (store.CommitID, error) {
 	hash, version, err := s.tree.SaveVersion()
@@ -74,9 +155,9 @@ func (s CommitStore) Commit() (store.CommitID, error) {
 		panic(err)
 	}
 
-	// Potentially release an old version of history
-	if s.numHistory > 0 && (s.numHistory < version) {
-		toRelease := version - s.numHistory
+	// Potentially release an old version of history. A store restored
+	// from a snapshot does not have versions older than the snapshot.
+	if toRelease := s.pruning.release(version); toRelease > 0 && s.tree.VersionExists(toRelease) {
 		err = s.tree.DeleteVersion(toRelease)
 		if err != nil {
 			panic(err)
@@ -133,10 +214,6 @@ func (s CommitStore) CacheWrap() store.KVCacheWrap {
 	return s.Adapter().CacheWrap()
 }
 
-// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-// }
-
 // TODO: create batch and reader and wrap the rest in btree...
 
 // adapter converts the working iavl.Tree to match these interfaces
@@ -199,3 +276,46 @@ func (a adapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
 
 	return iter, nil
 }
+
+// readOnlyAdapter converts an immutable iavl.Tree, representing a single
+// committed version, to match the ReadOnlyKVStore interface
+type readOnlyAdapter struct {
+	tree *iavl.ImmutableTree
+}
+
+var _ store.ReadOnlyKVStore = readOnlyAdapter{}
+
+// Get returns nil iff key doesn't exist. Panics on nil key.
+func (a readOnlyAdapter) Get(key []byte) ([]byte, error) {
+	_, val := a.tree.Get(key)
+	return val, nil
+}
+
+// Has checks if a key exists. Panics on nil key.
+func (a readOnlyAdapter) Has(key []byte) (bool, error) {
+	return a.tree.Has(key), nil
+}
+
+// Iterator over a domain of keys in ascending order. End is exclusive.
+// Start must be less than end, or the Iterator is invalid.
+func (a readOnlyAdapter) Iterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, true, iter.add)
+		iter.Release()
+	}()
+
+	return iter, nil
+}
+
+// ReverseIterator over a domain of keys in descending order. End is exclusive.
+// Start must be greater than end, or the Iterator is invalid.
+func (a readOnlyAdapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, false, iter.add)
+		iter.Release()
+	}()
+
+	return iter, nil
+}
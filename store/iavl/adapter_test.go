@@ -6,6 +6,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -73,7 +74,7 @@ func TestCommitOverwrite(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			commit, close := makeCommitStore()
 			// only one to trigger a cleanup
-			commit.numHistory = 1
+			commit = commit.WithPruning(PruningStrategy{KeepRecent: 1})
 
 			id, err := commit.LatestVersion()
 			assert.Nil(t, err)
@@ -128,6 +129,45 @@ func TestCommitOverwrite(t *testing.T) {
 	}
 }
 
+func TestReadOnlyAtVersion(t *testing.T) {
+	commit, close := makeCommitStore()
+	defer close()
+	commit = commit.WithPruning(PruningStrategy{KeepRecent: 2})
+
+	key := []byte("counter")
+	for i := byte(1); i <= 4; i++ {
+		c := commit.CacheWrap()
+		assert.Nil(t, c.Set(key, []byte{i}))
+		assert.Nil(t, c.Write())
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+
+	for version := int64(3); version <= 4; version++ {
+		ro, err := commit.ReadOnlyAtVersion(version)
+		assert.Nil(t, err)
+		suite.AssertGetHas(t, ro, key, []byte{byte(version)}, true)
+
+		it, err := ro.Iterator(nil, nil)
+		assert.Nil(t, err)
+		k, v, err := it.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, key, k)
+		assert.Equal(t, []byte{byte(version)}, v)
+		it.Release()
+	}
+
+	if _, err := commit.ReadOnlyAtVersion(2); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want pruned version to be not found, got %+v", err)
+	}
+	if _, err := commit.ReadOnlyAtVersion(5); !errors.ErrInput.Is(err) {
+		t.Fatalf("want future version to be rejected, got %+v", err)
+	}
+	if _, err := commit.ReadOnlyAtVersion(0); !errors.ErrInput.Is(err) {
+		t.Fatalf("want zero version to be rejected, got %+v", err)
+	}
+}
+
 // randKeys returns a slice of count keys, all of a given size
 func randKeys(count, size int) [][]byte {
 	res := make([][]byte, count)
@@ -0,0 +1,71 @@
+package iavl
+
+import (
+	"fmt"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// PruningStrategy defines which committed versions are kept on disk. Only
+// the versions that are kept can be used to serve historical queries.
+//
+// The zero value keeps all versions.
+type PruningStrategy struct {
+	// KeepRecent is the number of the most recent versions that are kept.
+	// Zero means that versions are never deleted.
+	KeepRecent int64
+	// KeepEvery, if greater than zero, makes every version that is a
+	// multiple of it kept forever, in addition to the most recent ones.
+	KeepEvery int64
+}
+
+var (
+	// PruneNothing keeps all versions.
+	PruneNothing = PruningStrategy{}
+	// PruneDefault keeps only the DefaultHistory most recent versions.
+	PruneDefault = PruningStrategy{KeepRecent: DefaultHistory}
+)
+
+// Validate returns an error if the strategy is not valid.
+func (p PruningStrategy) Validate() error {
+	if p.KeepRecent < 0 {
+		return errors.Wrap(errors.ErrInput, "number of recent versions to keep must not be negative")
+	}
+	if p.KeepEvery < 0 {
+		return errors.Wrap(errors.ErrInput, "keep every interval must not be negative")
+	}
+	if p.KeepEvery > 0 && p.KeepRecent == 0 {
+		return errors.Wrap(errors.ErrInput, "keep every interval requires the number of recent versions to keep")
+	}
+	return nil
+}
+
+func (p PruningStrategy) String() string {
+	switch {
+	case p.KeepRecent == 0:
+		return "keep all versions"
+	case p.KeepEvery == 0:
+		return fmt.Sprintf("keep last %d versions", p.KeepRecent)
+	default:
+		return fmt.Sprintf("keep last %d versions and every %d-th version", p.KeepRecent, p.KeepEvery)
+	}
+}
+
+// release returns the version that should be deleted after given version
+// was committed or zero if no version should be deleted.
+func (p PruningStrategy) release(version int64) int64 {
+	old := version - p.KeepRecent
+	if old <= 0 || p.keep(old, version) {
+		return 0
+	}
+	return old
+}
+
+// keep returns true if given version should be kept when latest is the most
+// recently committed version.
+func (p PruningStrategy) keep(version, latest int64) bool {
+	if p.KeepRecent <= 0 || version > latest-p.KeepRecent {
+		return true
+	}
+	return p.KeepEvery > 0 && version%p.KeepEvery == 0
+}
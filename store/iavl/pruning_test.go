@@ -0,0 +1,102 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestPruningStrategy(t *testing.T) {
+	cases := map[string]struct {
+		strategy PruningStrategy
+		wantKept []int64
+	}{
+		"keep all": {
+			strategy: PruneNothing,
+			wantKept: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+		"keep last three": {
+			strategy: PruningStrategy{KeepRecent: 3},
+			wantKept: []int64{8, 9, 10},
+		},
+		"keep last one": {
+			strategy: PruningStrategy{KeepRecent: 1},
+			wantKept: []int64{10},
+		},
+		"keep last two and every fourth": {
+			strategy: PruningStrategy{KeepRecent: 2, KeepEvery: 4},
+			wantKept: []int64{4, 8, 9, 10},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Nil(t, tc.strategy.Validate())
+
+			commit, close := makeCommitStore()
+			defer close()
+			commit = commit.WithPruning(tc.strategy)
+			commitCounter(t, commit, 10)
+			assert.Equal(t, tc.wantKept, keptVersions(t, commit, 10))
+		})
+
+		// A node restarted with a different strategy must release all
+		// versions that the new strategy does not keep.
+		t.Run(testName+" on start", func(t *testing.T) {
+			commit, close := makeCommitStore()
+			defer close()
+			commitCounter(t, commit.WithPruning(PruneNothing), 10)
+
+			commit = commit.WithPruning(tc.strategy)
+			assert.Equal(t, tc.wantKept, keptVersions(t, commit, 10))
+		})
+	}
+}
+
+// commitCounter commits n versions, each updating the same key.
+func commitCounter(t testing.TB, commit CommitStore, n byte) {
+	t.Helper()
+	for i := byte(1); i <= n; i++ {
+		assert.Nil(t, commit.Adapter().Set([]byte("counter"), []byte{i}))
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+}
+
+// keptVersions returns all versions up to latest that were not pruned.
+func keptVersions(t testing.TB, commit CommitStore, latest int64) []int64 {
+	t.Helper()
+	var kept []int64
+	for v := int64(1); v <= latest; v++ {
+		_, err := commit.ReadOnlyAtVersion(v)
+		switch {
+		case err == nil:
+			kept = append(kept, v)
+		case !errors.ErrNotFound.Is(err):
+			t.Fatalf("version %d: unexpected error: %+v", v, err)
+		}
+	}
+	return kept
+}
+
+func TestPruningStrategyValidate(t *testing.T) {
+	cases := map[string]struct {
+		strategy PruningStrategy
+		wantErr  *errors.Error
+	}{
+		"keep all":             {strategy: PruneNothing},
+		"default":              {strategy: PruneDefault},
+		"keep every":           {strategy: PruningStrategy{KeepRecent: 1, KeepEvery: 100}},
+		"negative recent":      {strategy: PruningStrategy{KeepRecent: -1}, wantErr: errors.ErrInput},
+		"negative every":       {strategy: PruningStrategy{KeepRecent: 1, KeepEvery: -1}, wantErr: errors.ErrInput},
+		"every without recent": {strategy: PruningStrategy{KeepEvery: 100}, wantErr: errors.ErrInput},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.strategy.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+package iavl
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+const (
+	// DefaultSnapshotChunkSize is the maximum size in bytes of a single
+	// snapshot chunk file.
+	DefaultSnapshotChunkSize = 16 << 20
+
+	// SnapshotManifestFile is the name of the file that describes the
+	// snapshot content.
+	SnapshotManifestFile = "manifest.json"
+)
+
+// SnapshotManifest describes a snapshot of the state at a single version.
+//
+// The app hash of an iavl tree depends not only on the key/value pairs but
+// also on the shape of the tree and on the version at which each node was
+// created. A snapshot is therefore a list of all tree nodes, including the
+// leaves that hold the key/value pairs. Nodes are written in pre-order and
+// split into chunk files.
+type SnapshotManifest struct {
+	// Version is the height of the state stored in the snapshot.
+	Version int64 `json:"version"`
+	// Hash is the app hash of the state stored in the snapshot.
+	Hash cmn.HexBytes `json:"hash"`
+	// Chunks are the snapshot files, in order.
+	Chunks []SnapshotChunk `json:"chunks"`
+}
+
+// SnapshotChunk describes a single snapshot file.
+type SnapshotChunk struct {
+	// File is the name of the chunk file, relative to the snapshot
+	// directory.
+	File string `json:"file"`
+	// Hash is the sha256 checksum of the chunk file content.
+	Hash cmn.HexBytes `json:"hash"`
+	// Nodes is the number of tree nodes stored in the chunk.
+	Nodes int `json:"nodes"`
+}
+
+// CreateSnapshot writes the state committed at given version to the
+// directory. Chunk files are at most chunkSize bytes long, unless a single
+// tree node is bigger than that.
+func (s CommitStore) CreateSnapshot(dir string, version int64, chunkSize int) (*SnapshotManifest, error) {
+	if s.db == nil {
+		return nil, errors.Wrap(errors.ErrState, "store was created without a database")
+	}
+	if chunkSize <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "chunk size must be greater than zero")
+	}
+	if err := s.checkVersion(version); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(errors.ErrDatabase, "cannot create snapshot directory: %s", err)
+	}
+	manifestPath := filepath.Join(dir, SnapshotManifestFile)
+	if _, err := os.Stat(manifestPath); err == nil {
+		return nil, errors.Wrapf(errors.ErrDuplicate, "snapshot already exists in %s", dir)
+	}
+
+	root := s.db.Get(rootKey(version))
+	w := chunkWriter{
+		dir:       dir,
+		chunkSize: chunkSize,
+		manifest:  &SnapshotManifest{Version: version, Hash: root},
+	}
+
+	// Traverse the tree in pre-order, so that every node is written
+	// after its parent. This allows to verify each node during restore.
+	var pending [][]byte
+	if len(root) != 0 {
+		pending = append(pending, root)
+	}
+	for len(pending) != 0 {
+		hash := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		raw := s.db.Get(nodeKey(hash))
+		if raw == nil {
+			return nil, errors.Wrapf(errors.ErrNotFound, "node %X", hash)
+		}
+		n, err := decodeNode(raw)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.add(raw); err != nil {
+			return nil, err
+		}
+		if !n.isLeaf() {
+			pending = append(pending, n.rightHash, n.leftHash)
+		}
+	}
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+
+	raw, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal manifest")
+	}
+	if err := ioutil.WriteFile(manifestPath, raw, 0600); err != nil {
+		return nil, errors.Wrapf(errors.ErrDatabase, "cannot write manifest: %s", err)
+	}
+	return w.manifest, nil
+}
+
+// RestoreSnapshot loads the state from a snapshot directory created by
+// CreateSnapshot. The store must be empty. Every chunk is verified against
+// its checksum and every tree node against the app hash stored in the
+// manifest. Nothing is written to the database until the whole snapshot is
+// verified, so a failed restore leaves the store empty. Once restored, the
+// store contains only the snapshot version and new versions can be committed
+// on top of it.
+func (s CommitStore) RestoreSnapshot(dir string) (store.CommitID, error) {
+	if s.db == nil {
+		return store.CommitID{}, errors.Wrap(errors.ErrState, "store was created without a database")
+	}
+	if s.tree.Version() != 0 {
+		return store.CommitID{}, errors.Wrapf(errors.ErrState, "store is not empty, latest version is %d", s.tree.Version())
+	}
+
+	m, err := ReadSnapshotManifest(dir)
+	if err != nil {
+		return store.CommitID{}, err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	// Nodes that are referenced by an already restored node (or by
+	// the manifest in case of the root) but were not restored yet.
+	expected := make(map[string]struct{})
+	if len(m.Hash) != 0 {
+		expected[string(m.Hash)] = struct{}{}
+	}
+	for _, c := range m.Chunks {
+		if err := restoreChunk(batch, dir, c, expected); err != nil {
+			return store.CommitID{}, errors.Wrapf(err, "chunk %s", c.File)
+		}
+	}
+	if len(expected) != 0 {
+		return store.CommitID{}, errors.Wrapf(errors.ErrState, "snapshot incomplete, %d nodes missing", len(expected))
+	}
+
+	root := []byte(m.Hash)
+	if root == nil {
+		root = []byte{}
+	}
+	// All nodes were verified against the app hash, because each of
+	// them was expected by its already verified parent.
+	batch.Set(rootKey(m.Version), root)
+	batch.WriteSync()
+	if err := s.LoadVersion(m.Version); err != nil {
+		return store.CommitID{}, errors.Wrapf(errors.ErrDatabase, "cannot load restored version: %s", err)
+	}
+	id, err := s.LatestVersion()
+	if err != nil {
+		return store.CommitID{}, err
+	}
+	if id.Version != m.Version || !bytes.Equal(id.Hash, m.Hash) {
+		return store.CommitID{}, errors.Wrapf(errors.ErrState,
+			"restored state %d/%X does not match snapshot %d/%X", id.Version, id.Hash, m.Version, m.Hash)
+	}
+	return id, nil
+}
+
+// restoreChunk verifies all nodes stored in the chunk and adds them to the
+// batch.
+func restoreChunk(batch dbm.Batch, dir string, c SnapshotChunk, expected map[string]struct{}) error {
+	if filepath.Base(c.File) != c.File {
+		return errors.Wrap(errors.ErrInput, "chunk file must be located in the snapshot directory")
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dir, c.File))
+	if err != nil {
+		return errors.Wrapf(errors.ErrDatabase, "cannot read: %s", err)
+	}
+	if sum := sha256.Sum256(content); !bytes.Equal(sum[:], c.Hash) {
+		return errors.Wrap(errors.ErrState, "checksum mismatch")
+	}
+
+	var nodes int
+	for len(content) != 0 {
+		size, n := binary.Uvarint(content)
+		if n <= 0 || uint64(len(content)-n) < size {
+			return errors.Wrap(errors.ErrState, "malformed node record")
+		}
+		raw := content[n : n+int(size)]
+		content = content[n+int(size):]
+
+		node, err := decodeNode(raw)
+		if err != nil {
+			return err
+		}
+		hash := node.hash()
+		if _, ok := expected[string(hash)]; !ok {
+			return errors.Wrapf(errors.ErrState, "unexpected node %X", hash)
+		}
+		delete(expected, string(hash))
+		if !node.isLeaf() {
+			expected[string(node.leftHash)] = struct{}{}
+			expected[string(node.rightHash)] = struct{}{}
+		}
+		batch.Set(nodeKey(hash), raw)
+		nodes++
+	}
+	if nodes != c.Nodes {
+		return errors.Wrapf(errors.ErrState, "want %d nodes, got %d", c.Nodes, nodes)
+	}
+	return nil
+}
+
+// ReadSnapshotManifest loads the manifest of the snapshot stored in given
+// directory.
+func ReadSnapshotManifest(dir string) (*SnapshotManifest, error) {
+	raw, err := ioutil.ReadFile(filepath.Join(dir, SnapshotManifestFile))
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "cannot read manifest: %s", err)
+	}
+	var m SnapshotManifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot parse manifest: %s", err)
+	}
+	if m.Version <= 0 {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid version %d", m.Version)
+	}
+	return &m, nil
+}
+
+// chunkWriter splits node records into chunk files and registers them in the
+// manifest.
+type chunkWriter struct {
+	dir       string
+	chunkSize int
+	manifest  *SnapshotManifest
+
+	buf   bytes.Buffer
+	nodes int
+}
+
+// add appends a length prefixed node record to the current chunk.
+func (w *chunkWriter) add(raw []byte) error {
+	var prefix [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(prefix[:], uint64(len(raw)))
+	if w.nodes != 0 && w.buf.Len()+n+len(raw) > w.chunkSize {
+		if err := w.flush(); err != nil {
+			return err
+		}
+	}
+	w.buf.Write(prefix[:n])
+	w.buf.Write(raw)
+	w.nodes++
+	return nil
+}
+
+// flush writes the current chunk to a file.
+func (w *chunkWriter) flush() error {
+	if w.nodes == 0 {
+		return nil
+	}
+	name := fmt.Sprintf("%06d.chunk", len(w.manifest.Chunks))
+	if err := ioutil.WriteFile(filepath.Join(w.dir, name), w.buf.Bytes(), 0600); err != nil {
+		return errors.Wrapf(errors.ErrDatabase, "cannot write chunk: %s", err)
+	}
+	sum := sha256.Sum256(w.buf.Bytes())
+	w.manifest.Chunks = append(w.manifest.Chunks, SnapshotChunk{
+		File:  name,
+		Hash:  sum[:],
+		Nodes: w.nodes,
+	})
+	w.buf.Reset()
+	w.nodes = 0
+	return nil
+}
+
+// node is a decoded iavl tree node, as stored in the database.
+type node struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+// decodeNode parses a node serialized by the iavl library.
+func decodeNode(raw []byte) (*node, error) {
+	var (
+		n   node
+		err error
+		i   int
+	)
+	if n.height, i, err = amino.DecodeInt8(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node height: %s", err)
+	}
+	raw = raw[i:]
+	if n.size, i, err = amino.DecodeVarint(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node size: %s", err)
+	}
+	raw = raw[i:]
+	if n.version, i, err = amino.DecodeVarint(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node version: %s", err)
+	}
+	raw = raw[i:]
+	if n.key, i, err = amino.DecodeByteSlice(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node key: %s", err)
+	}
+	raw = raw[i:]
+	if n.isLeaf() {
+		if n.value, _, err = amino.DecodeByteSlice(raw); err != nil {
+			return nil, errors.Wrapf(errors.ErrState, "cannot decode node value: %s", err)
+		}
+		return &n, nil
+	}
+	if n.leftHash, i, err = amino.DecodeByteSlice(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node left hash: %s", err)
+	}
+	raw = raw[i:]
+	if n.rightHash, _, err = amino.DecodeByteSlice(raw); err != nil {
+		return nil, errors.Wrapf(errors.ErrState, "cannot decode node right hash: %s", err)
+	}
+	return &n, nil
+}
+
+func (n *node) isLeaf() bool {
+	return n.height == 0
+}
+
+// hash computes the node hash the same way the iavl library does.
+func (n *node) hash() []byte {
+	var buf bytes.Buffer
+	// Writing to a buffer never fails.
+	_ = amino.EncodeInt8(&buf, n.height)
+	_ = amino.EncodeVarint(&buf, n.size)
+	_ = amino.EncodeVarint(&buf, n.version)
+	if n.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, n.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(n.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, n.leftHash)
+		_ = amino.EncodeByteSlice(&buf, n.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
+
+// nodeKey returns the database key of a node, as used by the iavl library.
+func nodeKey(hash []byte) []byte {
+	return append([]byte{'n'}, hash...)
+}
+
+// rootKey returns the database key of the root hash of given version, as
+// used by the iavl library.
+func rootKey(version int64) []byte {
+	key := make([]byte, 9)
+	key[0] = 'r'
+	binary.BigEndian.PutUint64(key[1:], uint64(version))
+	return key
+}
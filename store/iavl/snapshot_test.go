@@ -0,0 +1,194 @@
+package iavl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSnapshotRestore(t *testing.T) {
+	src, cleanup := makeCommitStore()
+	defer cleanup()
+
+	// Each block overwrites some keys and deletes some of the previous
+	// ones, so that the tree contains nodes of different versions.
+	ks := randKeys(200, 16)
+	blocks := make([]func(store.KVStore), 5)
+	for i := range blocks {
+		i := i
+		vals := randKeys(len(ks), 24)
+		blocks[i] = func(db store.KVStore) {
+			for j := i; j < len(ks); j += i + 1 {
+				assert.Nil(t, db.Set(ks[j], vals[j]))
+			}
+			for j := 0; j < i*10; j += 7 {
+				assert.Nil(t, db.Delete(ks[j]))
+			}
+		}
+	}
+	var ids []store.CommitID
+	for _, apply := range blocks {
+		apply(src.Adapter())
+		id, err := src.Commit()
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+
+	const snapVersion = 3
+	dir, err := ioutil.TempDir("", "iavl-snapshot-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	manifest, err := src.CreateSnapshot(dir, snapVersion, 2048)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(snapVersion), manifest.Version)
+	assert.Equal(t, []byte(ids[snapVersion-1].Hash), []byte(manifest.Hash))
+	if len(manifest.Chunks) < 2 {
+		t.Fatalf("want several chunks, got %d", len(manifest.Chunks))
+	}
+
+	dst, cleanupDst := makeCommitStore()
+	defer cleanupDst()
+	id, err := dst.RestoreSnapshot(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, ids[snapVersion-1], id)
+
+	want, err := src.ReadOnlyAtVersion(snapVersion)
+	assert.Nil(t, err)
+	got, err := dst.ReadOnlyAtVersion(snapVersion)
+	assert.Nil(t, err)
+	assert.Equal(t, readAll(t, want), readAll(t, got))
+
+	// Applying the same blocks on top of the restored state must
+	// result in the same app hashes as the original chain.
+	for i := snapVersion; i < len(blocks); i++ {
+		blocks[i](dst.Adapter())
+		id, err := dst.Commit()
+		assert.Nil(t, err)
+		assert.Equal(t, ids[i], id)
+	}
+
+	// The restored store is not empty anymore.
+	if _, err := dst.RestoreSnapshot(dir); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	// Creating a snapshot twice in the same directory is not allowed.
+	if _, err := src.CreateSnapshot(dir, snapVersion, 2048); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("want duplicate error, got %+v", err)
+	}
+}
+
+func TestRestoreCorruptedSnapshot(t *testing.T) {
+	cases := map[string]struct {
+		corrupt func(t *testing.T, dir string, m *SnapshotManifest)
+		wantErr *errors.Error
+	}{
+		"modified chunk": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				path := filepath.Join(dir, m.Chunks[0].File)
+				raw, err := ioutil.ReadFile(path)
+				assert.Nil(t, err)
+				raw[len(raw)-1]++
+				assert.Nil(t, ioutil.WriteFile(path, raw, 0600))
+			},
+			wantErr: errors.ErrState,
+		},
+		"missing chunk": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				assert.Nil(t, os.Remove(filepath.Join(dir, m.Chunks[1].File)))
+			},
+			wantErr: errors.ErrDatabase,
+		},
+		"chunk removed from manifest": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				m.Chunks = m.Chunks[:len(m.Chunks)-1]
+				writeManifest(t, dir, m)
+			},
+			wantErr: errors.ErrState,
+		},
+		"chunks reordered": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				m.Chunks[0], m.Chunks[1] = m.Chunks[1], m.Chunks[0]
+				writeManifest(t, dir, m)
+			},
+			wantErr: errors.ErrState,
+		},
+		"different app hash": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				m.Hash[0]++
+				writeManifest(t, dir, m)
+			},
+			wantErr: errors.ErrState,
+		},
+		"missing manifest": {
+			corrupt: func(t *testing.T, dir string, m *SnapshotManifest) {
+				assert.Nil(t, os.Remove(filepath.Join(dir, SnapshotManifestFile)))
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			src, cleanup := makeCommitStore()
+			defer cleanup()
+			db := src.Adapter()
+			for _, k := range randKeys(100, 16) {
+				assert.Nil(t, db.Set(k, randBytes(32)))
+			}
+			_, err := src.Commit()
+			assert.Nil(t, err)
+
+			dir, err := ioutil.TempDir("", "iavl-snapshot-")
+			assert.Nil(t, err)
+			defer os.RemoveAll(dir)
+			m, err := src.CreateSnapshot(dir, 1, 1024)
+			assert.Nil(t, err)
+
+			tc.corrupt(t, dir, m)
+
+			dst, cleanupDst := makeCommitStore()
+			defer cleanupDst()
+			if _, err := dst.RestoreSnapshot(dir); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			// Nodes of the chunks that were verified before the
+			// failure must not be written.
+			it := dst.db.Iterator(nil, nil)
+			defer it.Close()
+			if it.Valid() {
+				t.Fatalf("store is not empty, found %X key", it.Key())
+			}
+		})
+	}
+}
+
+func writeManifest(t *testing.T, dir string, m *SnapshotManifest) {
+	t.Helper()
+	raw, err := json.Marshal(m)
+	assert.Nil(t, err)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, SnapshotManifestFile), raw, 0600))
+}
+
+func readAll(t *testing.T, db store.ReadOnlyKVStore) []Model {
+	t.Helper()
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	defer it.Release()
+	var res []Model
+	for {
+		k, v, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			return res
+		}
+		assert.Nil(t, err)
+		res = append(res, Model{Key: k, Value: v})
+	}
+}
@@ -131,7 +131,7 @@ func StoreValidatorUpdates(store KVStore, vu ValidatorUpdates) error {
 	return errors.Wrap(err, "kvstore save")
 }
 
-func GetValidatorUpdates(store KVStore) (ValidatorUpdates, error) {
+func GetValidatorUpdates(store ReadOnlyKVStore) (ValidatorUpdates, error) {
 	vu := ValidatorUpdates{}
 	b, err := store.Get([]byte(storeKey))
 	if err != nil {
@@ -25,11 +25,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// HashAlgorithm is the hash function used to compute the preimage hash.
+type HashAlgorithm int32
+
+const (
+	// sha256, 32 bytes long hash. Used by most of the atomic swap
+	// implementations.
+	HashAlgorithm_SHA256 HashAlgorithm = 0
+	// keccak256, 32 bytes long hash, as used by Ethereum HTLC contracts.
+	HashAlgorithm_Keccak256 HashAlgorithm = 1
+	// ripemd160 of sha256, 20 bytes long hash, as used by Bitcoin HASH160
+	// scripts.
+	HashAlgorithm_Hash160 HashAlgorithm = 2
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_SHA256",
+	1: "HASH_ALGORITHM_KECCAK256",
+	2: "HASH_ALGORITHM_HASH160",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_SHA256":    0,
+	"HASH_ALGORITHM_KECCAK256": 1,
+	"HASH_ALGORITHM_HASH160":   2,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ad79b700d8686a3f, []int{0}
+}
+
 // Swap is designed to hold some coins for atomic swap, locked by preimage_hash
 type Swap struct {
 	// metadata is used for schema versioning support
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// sha256 hash of preimage, 32 bytes long
+	// hash of preimage, computed using hash_algorithm
 	PreimageHash []byte `protobuf:"bytes,2,opt,name=preimage_hash,json=preimageHash,proto3" json:"preimage_hash,omitempty"`
 	// source is a sender address
 	Source github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
@@ -47,6 +81,13 @@ type Swap struct {
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,8,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// ID of the task that returns the coins to the source once the swap
+	// expires. It is empty for swaps created before the automatic return was
+	// introduced.
+	ReturnTaskID []byte `protobuf:"bytes,9,opt,name=return_task_id,json=returnTaskId,proto3" json:"return_task_id,omitempty"`
+	// Hash function used to compute the preimage_hash. Algorithms other
+	// than sha256 require schema version 2.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,10,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=aswap.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
 func (m *Swap) Reset()         { *m = Swap{} }
@@ -131,11 +172,25 @@ func (m *Swap) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Swap) GetReturnTaskID() []byte {
+	if m != nil {
+		return m.ReturnTaskID
+	}
+	return nil
+}
+
+func (m *Swap) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_SHA256
+}
+
 // CreateMsg creates a Swap with some coins.
 type CreateMsg struct {
 	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Source   github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
-	// sha256 hash of preimage, 32 bytes long
+	// hash of preimage, computed using hash_algorithm
 	PreimageHash []byte                           `protobuf:"bytes,3,opt,name=preimage_hash,json=preimageHash,proto3" json:"preimage_hash,omitempty"`
 	Destination  github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
 	// amount may contain multiple token types
@@ -144,6 +199,9 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Hash function used to compute the preimage_hash. Algorithms other
+	// than sha256 require schema version 2.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,8,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=aswap.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
@@ -228,6 +286,13 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_SHA256
+}
+
 // ReleaseMsg releases the tokens to the destination.
 // This operation is authorized by preimage, which is sent raw and then hashed on the backend.
 type ReleaseMsg struct {
@@ -349,6 +414,7 @@ func (m *ReturnMsg) GetSwapID() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("aswap.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
 	proto.RegisterType((*Swap)(nil), "aswap.Swap")
 	proto.RegisterType((*CreateMsg)(nil), "aswap.CreateMsg")
 	proto.RegisterType((*ReleaseMsg)(nil), "aswap.ReleaseMsg")
@@ -358,35 +424,45 @@ func init() {
 func init() { proto.RegisterFile("x/aswap/codec.proto", fileDescriptor_ad79b700d8686a3f) }
 
 var fileDescriptor_ad79b700d8686a3f = []byte{
-	// 435 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xb3, 0xf9, 0x70, 0x92, 0x49, 0x11, 0x68, 0xe1, 0xb0, 0xca, 0xc1, 0x31, 0x2e, 0x48,
-	0x96, 0x10, 0xb6, 0x54, 0xae, 0x08, 0x44, 0x8a, 0x10, 0x3d, 0xf4, 0xb2, 0xc0, 0x11, 0x55, 0x5b,
-	0x7b, 0xe4, 0xac, 0xc4, 0xee, 0x46, 0xde, 0x75, 0x53, 0xf1, 0x14, 0x3c, 0x16, 0xc7, 0x1e, 0x39,
-	0xa0, 0x08, 0x25, 0x8f, 0xc0, 0xad, 0x27, 0xe4, 0xc4, 0x29, 0x11, 0x1f, 0x87, 0x54, 0xca, 0x6d,
-	0xfc, 0x9f, 0xf9, 0xcf, 0x58, 0xbf, 0xd9, 0x81, 0xfb, 0x97, 0x89, 0xb0, 0x33, 0x31, 0x4d, 0x52,
-	0x93, 0x61, 0x1a, 0x4f, 0x0b, 0xe3, 0x0c, 0xed, 0xac, 0xa4, 0xe1, 0x60, 0x4b, 0x1b, 0xde, 0x4b,
-	0x8d, 0xd4, 0xdb, 0x55, 0xc3, 0x07, 0xb9, 0xc9, 0xcd, 0x2a, 0x4c, 0xaa, 0x68, 0xad, 0x86, 0x3f,
-	0x9b, 0xd0, 0x7e, 0x37, 0x13, 0x53, 0xfa, 0x04, 0x7a, 0x0a, 0x9d, 0xc8, 0x84, 0x13, 0x8c, 0x04,
-	0x24, 0x1a, 0x1c, 0xdd, 0x8d, 0x67, 0x28, 0x2e, 0x30, 0x3e, 0xad, 0x65, 0x7e, 0x53, 0x40, 0x0f,
-	0xe1, 0xce, 0xb4, 0x40, 0xa9, 0x44, 0x8e, 0x67, 0x13, 0x61, 0x27, 0xac, 0x19, 0x90, 0xe8, 0x80,
-	0x1f, 0x6c, 0xc4, 0xb7, 0xc2, 0x4e, 0xe8, 0x73, 0xf0, 0xac, 0x29, 0x8b, 0x14, 0x59, 0xab, 0xca,
-	0x8e, 0x1f, 0x5d, 0xcf, 0x47, 0x41, 0x2e, 0xdd, 0xa4, 0x3c, 0x8f, 0x53, 0xa3, 0x12, 0x69, 0x2e,
-	0x9e, 0x1a, 0x8d, 0xc9, 0x7a, 0xca, 0xab, 0x2c, 0x2b, 0xd0, 0x5a, 0x5e, 0x7b, 0xe8, 0x1b, 0x18,
-	0x64, 0x68, 0x9d, 0xd4, 0xc2, 0x49, 0xa3, 0x59, 0x67, 0x87, 0x16, 0xdb, 0x46, 0xfa, 0x12, 0xba,
-	0x4e, 0x2a, 0x34, 0xa5, 0x63, 0x5e, 0x40, 0xa2, 0xd6, 0xf8, 0xf1, 0xf5, 0x7c, 0xf4, 0xf0, 0xbf,
-	0x3d, 0x3e, 0x68, 0x79, 0xf9, 0x5e, 0x2a, 0xe4, 0x1b, 0x17, 0xa5, 0xd0, 0x56, 0xa8, 0x0c, 0xeb,
-	0x06, 0x24, 0xea, 0xf3, 0x55, 0x4c, 0x5f, 0x40, 0x57, 0xac, 0x87, 0xb1, 0xde, 0x0e, 0x3f, 0xb6,
-	0x31, 0x85, 0xdf, 0x9b, 0xd0, 0x3f, 0x2e, 0x50, 0x38, 0x3c, 0xb5, 0xf9, 0x6e, 0xe8, 0x7f, 0x53,
-	0x6d, 0xde, 0x82, 0xea, 0x5f, 0x8b, 0x6b, 0xfd, 0x63, 0x71, 0x7f, 0xa0, 0x6f, 0xdf, 0x16, 0x7d,
-	0x08, 0x9e, 0x50, 0xa6, 0xd4, 0x8e, 0x75, 0x82, 0x56, 0x34, 0x38, 0x82, 0xb8, 0x7a, 0x94, 0xf1,
-	0xb1, 0x91, 0x9a, 0xd7, 0x99, 0xbd, 0xac, 0x27, 0xfc, 0x0c, 0xc0, 0xf1, 0x13, 0x0a, 0xbb, 0x3b,
-	0xde, 0x43, 0xe8, 0x56, 0xc7, 0x74, 0x26, 0xb3, 0x9a, 0x2f, 0x2c, 0xe6, 0x23, 0xaf, 0xba, 0x90,
-	0x93, 0xd7, 0xdc, 0xab, 0x52, 0x27, 0x19, 0x1d, 0x42, 0x6f, 0x03, 0xac, 0x06, 0x78, 0xf3, 0x1d,
-	0x7e, 0x84, 0x3e, 0x47, 0x57, 0x16, 0x7a, 0x2f, 0xa3, 0xc7, 0xec, 0xeb, 0xc2, 0x27, 0x57, 0x0b,
-	0x9f, 0xfc, 0x58, 0xf8, 0xe4, 0xcb, 0xd2, 0x6f, 0x5c, 0x2d, 0xfd, 0xc6, 0xb7, 0xa5, 0xdf, 0x38,
-	0xf7, 0x56, 0x07, 0xfd, 0xec, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x9e, 0x36, 0x8b, 0x23,
-	0x04, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x4f, 0x13, 0x4f,
+	0x14, 0xee, 0x52, 0xd8, 0xb6, 0xaf, 0x2d, 0xbf, 0x66, 0x7e, 0x68, 0x26, 0x7b, 0x68, 0xd7, 0x22,
+	0xb1, 0x91, 0xb8, 0x85, 0x1a, 0x7b, 0xd1, 0x68, 0x4a, 0x51, 0xdb, 0x20, 0x31, 0x19, 0xf0, 0x68,
+	0x9a, 0x61, 0x77, 0xb2, 0x3b, 0x81, 0xdd, 0x69, 0x76, 0xa7, 0x40, 0xfc, 0x13, 0x88, 0x07, 0xaf,
+	0x1e, 0xf8, 0x7f, 0x3c, 0x72, 0xf4, 0xd4, 0x98, 0x72, 0xf1, 0x6f, 0xe0, 0x64, 0x66, 0xb7, 0x85,
+	0x0a, 0x9a, 0x58, 0x12, 0x6e, 0xf3, 0xde, 0xf7, 0xbd, 0xf7, 0x66, 0xdf, 0xf7, 0xcd, 0xc2, 0xff,
+	0xc7, 0x75, 0x1a, 0x1d, 0xd1, 0x7e, 0xdd, 0x16, 0x0e, 0xb3, 0xad, 0x7e, 0x28, 0xa4, 0x40, 0x0b,
+	0x71, 0xca, 0xc8, 0x4f, 0xe5, 0x8c, 0x92, 0x2d, 0x78, 0x30, 0xcd, 0x32, 0x96, 0x5c, 0xe1, 0x8a,
+	0xf8, 0x58, 0x57, 0xa7, 0x24, 0x5b, 0xfd, 0x99, 0x86, 0xf9, 0x9d, 0x23, 0xda, 0x47, 0xab, 0x90,
+	0xf5, 0x99, 0xa4, 0x0e, 0x95, 0x14, 0x6b, 0xa6, 0x56, 0xcb, 0x37, 0xfe, 0xb3, 0x8e, 0x18, 0x3d,
+	0x64, 0xd6, 0xf6, 0x38, 0x4d, 0x2e, 0x09, 0x68, 0x19, 0x8a, 0xfd, 0x90, 0x71, 0x9f, 0xba, 0xac,
+	0xe7, 0xd1, 0xc8, 0xc3, 0x73, 0xa6, 0x56, 0x2b, 0x90, 0xc2, 0x24, 0xd9, 0xa1, 0x91, 0x87, 0x5e,
+	0x80, 0x1e, 0x89, 0x41, 0x68, 0x33, 0x9c, 0x56, 0xe8, 0xc6, 0xc3, 0x8b, 0x61, 0xc5, 0x74, 0xb9,
+	0xf4, 0x06, 0x7b, 0x96, 0x2d, 0xfc, 0x3a, 0x17, 0x87, 0x4f, 0x44, 0xc0, 0xea, 0xc9, 0x94, 0x96,
+	0xe3, 0x84, 0x2c, 0x8a, 0xc8, 0xb8, 0x06, 0xbd, 0x81, 0xbc, 0xc3, 0x22, 0xc9, 0x03, 0x2a, 0xb9,
+	0x08, 0xf0, 0xc2, 0x0c, 0x2d, 0xa6, 0x0b, 0xd1, 0x2b, 0xc8, 0x48, 0xee, 0x33, 0x31, 0x90, 0x58,
+	0x37, 0xb5, 0x5a, 0x7a, 0x63, 0xe5, 0x62, 0x58, 0x79, 0xf0, 0xd7, 0x1e, 0x1f, 0x02, 0x7e, 0xbc,
+	0xcb, 0x7d, 0x46, 0x26, 0x55, 0x08, 0xc1, 0xbc, 0xcf, 0x7c, 0x81, 0x33, 0xa6, 0x56, 0xcb, 0x91,
+	0xf8, 0x8c, 0x5e, 0x42, 0x86, 0x26, 0xc3, 0x70, 0x76, 0x86, 0x8b, 0x4d, 0x8a, 0x50, 0x13, 0x16,
+	0x43, 0x26, 0x07, 0x61, 0xd0, 0x93, 0x34, 0xda, 0xef, 0x71, 0x07, 0xe7, 0xe2, 0x36, 0xa5, 0xd1,
+	0xb0, 0x52, 0x20, 0x31, 0xb2, 0x4b, 0xa3, 0xfd, 0xee, 0x26, 0x29, 0x84, 0x57, 0x91, 0x83, 0x9e,
+	0xc3, 0xa2, 0x5a, 0x77, 0x8f, 0x1e, 0xb8, 0x22, 0xe4, 0xd2, 0xf3, 0x31, 0x98, 0x5a, 0x6d, 0xb1,
+	0xb1, 0x64, 0xc5, 0x16, 0xb0, 0xd4, 0xde, 0x5b, 0x13, 0x8c, 0x14, 0xbd, 0xe9, 0xb0, 0xfa, 0x35,
+	0x0d, 0xb9, 0x76, 0xc8, 0xa8, 0x64, 0xdb, 0x91, 0x3b, 0x9b, 0xde, 0x57, 0x52, 0xce, 0xdd, 0x42,
+	0xca, 0x1b, 0x6e, 0x49, 0xff, 0xc1, 0x2d, 0xd7, 0xf4, 0x9e, 0xbf, 0xad, 0xde, 0x55, 0xd0, 0xa9,
+	0x2f, 0x06, 0x81, 0xc4, 0x0b, 0x66, 0xba, 0x96, 0x6f, 0x80, 0xa5, 0x5e, 0x82, 0xd5, 0x16, 0x3c,
+	0x20, 0x63, 0xe4, 0x6e, 0x3c, 0x71, 0x53, 0x9b, 0xec, 0xbf, 0x6b, 0xf3, 0x09, 0x80, 0xb0, 0x03,
+	0x46, 0xa3, 0xd9, 0xb5, 0x59, 0x86, 0x8c, 0xea, 0xaf, 0x4c, 0x94, 0x88, 0x03, 0xa3, 0x61, 0x45,
+	0x57, 0x6f, 0xba, 0xbb, 0x49, 0x74, 0x05, 0x75, 0x1d, 0x64, 0x40, 0x76, 0xb2, 0xed, 0xf1, 0xf6,
+	0x2f, 0xe3, 0xea, 0x47, 0xc8, 0x25, 0x96, 0xbb, 0x93, 0xd1, 0x8f, 0x3f, 0x6b, 0x50, 0xfc, 0xed,
+	0xdb, 0xd1, 0x0a, 0xdc, 0xeb, 0xb4, 0x76, 0x3a, 0xbd, 0xd6, 0xbb, 0xb7, 0xef, 0x49, 0x77, 0xb7,
+	0xb3, 0xdd, 0xdb, 0xe9, 0xb4, 0x1a, 0xcf, 0x9a, 0xa5, 0x94, 0x01, 0x27, 0xa7, 0xa6, 0x9e, 0x44,
+	0x68, 0x15, 0xf0, 0x35, 0xda, 0xd6, 0xeb, 0x76, 0xbb, 0xb5, 0xa5, 0x98, 0x9a, 0x51, 0x3c, 0x39,
+	0x35, 0x73, 0x5b, 0xcc, 0xb6, 0xe9, 0xbe, 0x22, 0x3f, 0x82, 0xfb, 0xd7, 0xc8, 0x2a, 0x5c, 0x6f,
+	0xae, 0x95, 0xe6, 0x8c, 0xfc, 0xc9, 0xa9, 0x99, 0x51, 0x57, 0x58, 0x6f, 0xae, 0x6d, 0xe0, 0x6f,
+	0xa3, 0xb2, 0x76, 0x36, 0x2a, 0x6b, 0x3f, 0x46, 0x65, 0xed, 0xcb, 0x79, 0x39, 0x75, 0x76, 0x5e,
+	0x4e, 0x7d, 0x3f, 0x2f, 0xa7, 0xf6, 0xf4, 0xf8, 0x8f, 0xf8, 0xf4, 0xd7, 0x00, 0xf7, 0xd3, 0x6c,
+	0x00, 0x64, 0x05, 0x00, 0x00,
 }
 
 func (m *Swap) Marshal() (dAtA []byte, err error) {
@@ -449,6 +525,17 @@ func (m *Swap) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
 		i += copy(dAtA[i:], m.Address)
 	}
+	if len(m.ReturnTaskID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ReturnTaskID)))
+		i += copy(dAtA[i:], m.ReturnTaskID)
+	}
+	if m.HashAlgorithm != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HashAlgorithm))
+	}
 	return i, nil
 }
 
@@ -518,6 +605,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
 		i += copy(dAtA[i:], m.Memo)
 	}
+	if m.HashAlgorithm != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.HashAlgorithm))
+	}
 	return i, nil
 }
 
@@ -637,6 +729,13 @@ func (m *Swap) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = len(m.ReturnTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovCodec(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -675,6 +774,9 @@ func (m *CreateMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovCodec(uint64(m.HashAlgorithm))
+	}
 	return n
 }
 
@@ -981,6 +1083,59 @@ func (m *Swap) Unmarshal(dAtA []byte) error {
 				m.Address = []byte{}
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnTaskID = append(m.ReturnTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnTaskID == nil {
+				m.ReturnTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1257,6 +1412,25 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -11,9 +11,15 @@ supplying a valid preimage, or returned back to the sender when the swap times o
 Note, that when swap timed out it is no longer possible for the recipient to retrieve
 the funds.
 
+By default the preimage hash is computed using sha256. To swap with chains
+that use a different hash function, keccak256 (Ethereum HTLC contracts) and
+hash160 (Bitcoin HASH160 scripts) are supported as well. Choosing the hash
+algorithm requires the schema version 2 of this package.
+
 The algorithm is as follows:
 1. Sender generates a preimage, stores it in a secure place.
-2. Sender makes a sha256 hash out of the preimage.
+2. Sender makes a hash out of the preimage, using one of the supported hash
+algorithms: sha256, keccak256 or hash160.
 3. With this hash sender creates a Swap.
 4. Sender can release the funds to the recipient by supplying a valid preimage, if the swap
 didn't time out.
@@ -21,6 +27,12 @@ didn't time out.
 swapID.
 6. Swap is deleted on successful retrieval for either step 4 or step 5.
 
+Step 5 does not require any action from the sender. When a swap is created, a
+return message is scheduled for execution at the timeout. Once the swap times
+out, the funds are returned to the sender automatically and the outcome is
+stored in the cron task result. The task is cancelled when the swap is
+released or returned manually.
+
 
 */
 package aswap
@@ -3,6 +3,7 @@ package aswap
 import (
 	"bytes"
 	"crypto/sha256"
+	"fmt"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -10,6 +11,7 @@ import (
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 )
 
 const (
@@ -20,14 +22,15 @@ const (
 )
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+// all handlers in this package. Given scheduler is used to automatically
+// return the coins to the source once the swap expires.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("aswap", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseSwapHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnSwapHandler{auth, bucket, cashctrl, scheduler})
 }
 
 // RegisterQuery will register this bucket as "/aswaps"
@@ -37,9 +40,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateSwapHandler creates a swap
 type CreateSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateSwapHandler{}
@@ -69,14 +73,27 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 		return nil, errors.Wrap(err, "cannot acquire key")
 	}
 	swap := &Swap{
-		Metadata:     &weave.Metadata{Schema: 1},
-		Source:       msg.Source,
-		Destination:  msg.Destination,
-		Timeout:      msg.Timeout,
-		Memo:         msg.Memo,
-		PreimageHash: msg.PreimageHash,
-		Address:      swapAddr(key, msg.PreimageHash),
+		// Message was migrated to the current schema version and the
+		// swap uses the same format.
+		Metadata:      &weave.Metadata{Schema: msg.Metadata.Schema},
+		Source:        msg.Source,
+		Destination:   msg.Destination,
+		Timeout:       msg.Timeout,
+		Memo:          msg.Memo,
+		PreimageHash:  msg.PreimageHash,
+		HashAlgorithm: msg.HashAlgorithm,
+		Address:       swapAddr(key, msg.PreimageHash),
 	}
+	returnMsg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		SwapID:   key,
+	}
+	// Return message requires no authentication.
+	taskID, err := h.scheduler.Schedule(db, swap.Timeout.Time(), nil, returnMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+	swap.ReturnTaskID = taskID
 	if _, err := h.bucket.Put(db, key, swap); err != nil {
 		return nil, errors.Wrap(err, "cannot save swap entity")
 	}
@@ -109,9 +126,10 @@ func (h CreateSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 
 // ReleaseSwapHandler releases the amount to destination.
 type ReleaseSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseSwapHandler{}
@@ -149,6 +167,9 @@ func (h ReleaseSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	if err := h.bucket.Delete(db, swapID); err != nil {
 		return nil, err
 	}
+	if err := cron.DeleteTask(db, h.scheduler, swap.ReturnTaskID); err != nil {
+		return nil, err
+	}
 
 	return &weave.DeliverResult{}, nil
 }
@@ -165,8 +186,10 @@ func (h ReleaseSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 		return nil, nil, errors.Wrap(err, "cannot load swap entity from the store")
 	}
 
-	preimageHash := HashBytes(msg.Preimage)
-
+	preimageHash, err := swap.HashAlgorithm.Hash(msg.Preimage)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot hash preimage")
+	}
 	if !bytes.Equal(swap.PreimageHash, preimageHash) {
 		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "invalid preimageHash")
 	}
@@ -180,9 +203,10 @@ func (h ReleaseSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx wea
 
 // ReturnSwapHandler returns funds to the sender when swap timed out.
 type ReturnSwapHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnSwapHandler{}
@@ -218,8 +242,15 @@ func (h ReturnSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	if err := h.bucket.Delete(db, msg.SwapID); err != nil {
 		return nil, err
 	}
+	// When returned manually, the scheduled return task is no longer
+	// needed.
+	if err := cron.DeleteTask(db, h.scheduler, swap.ReturnTaskID); err != nil {
+		return nil, err
+	}
 
-	return &weave.DeliverResult{}, nil
+	// When executed by cron, the log is stored in the task result.
+	log := fmt.Sprintf("swap returned %s to %s", available, swap.Source)
+	return &weave.DeliverResult{Log: log}, nil
 }
 
 // validate does all common pre-processing between Check and Deliver.
@@ -241,6 +272,7 @@ func (h ReturnSwapHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 	return &msg, &swap, nil
 }
 
+// HashBytes returns the sha256 hash of given preimage.
 func HashBytes(preimage []byte) []byte {
 	hash := sha256.Sum256(preimage)
 	return hash[:]
@@ -2,6 +2,7 @@ package aswap
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -37,7 +38,7 @@ var (
 )
 
 func init() {
-	RegisterRoutes(r, auth, ctrl)
+	RegisterRoutes(r, auth, ctrl, &weavetest.Cron{})
 }
 
 func TestCreateHandler(t *testing.T) {
@@ -368,6 +369,206 @@ func TestReturnHandler(t *testing.T) {
 
 }
 
+func TestReleaseWithHashAlgorithm(t *testing.T) {
+	initialCoins, err := coin.CombineCoins(swapAmount)
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		// Schema version of the aswap package.
+		PkgSchema uint32
+		Algorithm HashAlgorithm
+		// Algorithm used to compute the preimage hash of the swap.
+		HashedWith     HashAlgorithm
+		WantCreateErr  *errors.Error
+		WantReleaseErr *errors.Error
+	}{
+		"sha256": {
+			PkgSchema:  2,
+			Algorithm:  HashAlgorithm_SHA256,
+			HashedWith: HashAlgorithm_SHA256,
+		},
+		"keccak256": {
+			PkgSchema:  2,
+			Algorithm:  HashAlgorithm_Keccak256,
+			HashedWith: HashAlgorithm_Keccak256,
+		},
+		"hash160": {
+			PkgSchema:  2,
+			Algorithm:  HashAlgorithm_Hash160,
+			HashedWith: HashAlgorithm_Hash160,
+		},
+		"preimage hash computed with a different algorithm": {
+			PkgSchema:      2,
+			Algorithm:      HashAlgorithm_Keccak256,
+			HashedWith:     HashAlgorithm_SHA256,
+			WantReleaseErr: errors.ErrUnauthorized,
+		},
+		"package schema must be upgraded": {
+			PkgSchema:     1,
+			Algorithm:     HashAlgorithm_Keccak256,
+			HashedWith:    HashAlgorithm_Keccak256,
+			WantCreateErr: errors.ErrSchema,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "aswap", "cash")
+			for v := uint32(2); v <= tc.PkgSchema; v++ {
+				_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+					Metadata: &weave.Metadata{Schema: 1},
+					Pkg:      "aswap",
+					Version:  v,
+				})
+				assert.Nil(t, err)
+			}
+			setBalance(t, db, alice.Address(), initialCoins)
+
+			hash, err := tc.HashedWith.Hash(preimage)
+			assert.Nil(t, err)
+			createMsg := &CreateMsg{
+				Metadata:      &weave.Metadata{Schema: 2},
+				Source:        alice.Address(),
+				Destination:   bob.Address(),
+				PreimageHash:  hash,
+				HashAlgorithm: tc.Algorithm,
+				Amount:        []*coin.Coin{&swapAmount},
+				Timeout:       weave.AsUnixTime(blockNow.Add(time.Hour)),
+			}
+			ctx := weave.WithHeight(context.Background(), 500)
+			ctx = weave.WithBlockTime(ctx, blockNow)
+			createCtx := authenticator.SetConditions(ctx, alice)
+			if _, err := r.Deliver(createCtx, db, &weavetest.Tx{Msg: createMsg}); !tc.WantCreateErr.Is(err) {
+				t.Fatalf("unexpected create error: %+v", err)
+			}
+			if tc.WantCreateErr != nil {
+				return
+			}
+
+			releaseMsg := &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 2},
+				SwapID:   defaultSequenceId,
+				Preimage: preimage,
+			}
+			if _, err := r.Deliver(ctx, db, &weavetest.Tx{Msg: releaseMsg}); !tc.WantReleaseErr.Is(err) {
+				t.Fatalf("unexpected release error: %+v", err)
+			}
+			if tc.WantReleaseErr != nil {
+				return
+			}
+			coins := checkBalance(t, db, bob.Address())
+			assert.Equal(t, true, coins.Equals(initialCoins))
+		})
+	}
+}
+
+func TestAutomaticReturn(t *testing.T) {
+	initialCoins, err := coin.CombineCoins(swapAmount)
+	assert.Nil(t, err)
+	timeout := weave.AsUnixTime(blockNow.Add(time.Hour))
+
+	cases := map[string]struct {
+		// Message delivered after the swap is created and before cron
+		// executes due tasks.
+		Msg     weave.Msg
+		MsgTime time.Time
+		// Address that is expected to hold the swapped coins.
+		WantOwner weave.Address
+		// When true, the return task is expected to be due once the
+		// swap expires.
+		WantExecuted bool
+	}{
+		"expired swap is returned to the source": {
+			WantOwner:    alice.Address(),
+			WantExecuted: true,
+		},
+		"released swap is not returned": {
+			Msg: &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   defaultSequenceId,
+				Preimage: preimage,
+			},
+			MsgTime:      blockNow,
+			WantOwner:    bob.Address(),
+			WantExecuted: false,
+		},
+		"manually returned swap cancels the return task": {
+			Msg: &ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   defaultSequenceId,
+			},
+			MsgTime:      timeout.Time(),
+			WantOwner:    alice.Address(),
+			WantExecuted: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "aswap", "cash")
+
+			scheduler := &weavetest.Cron{}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, ctrl, scheduler)
+
+			setBalance(t, db, alice.Address(), initialCoins)
+			ctx := weave.WithHeight(context.Background(), 500)
+			ctx = weave.WithBlockTime(ctx, blockNow)
+			createMsg := &CreateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Source:       alice.Address(),
+				Destination:  bob.Address(),
+				PreimageHash: preimageHash,
+				Amount:       []*coin.Coin{&swapAmount},
+				Timeout:      timeout,
+			}
+			createCtx := authenticator.SetConditions(ctx, alice)
+			if _, err := rt.Deliver(createCtx, db, &weavetest.Tx{Msg: createMsg}); err != nil {
+				t.Fatalf("cannot create swap: %s", err)
+			}
+			var swap Swap
+			assert.Nil(t, bucket.One(db, defaultSequenceId, &swap))
+			if len(swap.ReturnTaskID) == 0 {
+				t.Fatal("return task not scheduled")
+			}
+
+			if tc.Msg != nil {
+				msgCtx := weave.WithBlockTime(ctx, tc.MsgTime)
+				if _, err := rt.Deliver(msgCtx, db, &weavetest.Tx{Msg: tc.Msg}); err != nil {
+					t.Fatalf("cannot deliver message: %s", err)
+				}
+			}
+
+			// Cron executes tasks scheduled before the block time.
+			tickCtx := weave.WithBlockTime(ctx, timeout.Time().Add(time.Second))
+			executed := scheduler.Tick(tickCtx, db).Tags
+			assert.Equal(t, tc.WantExecuted, len(executed) == 1)
+			if tc.WantExecuted {
+				assert.Equal(t, swap.ReturnTaskID, executed[0].Value)
+				// Execute the task the way cron does, without any
+				// signature.
+				returnMsg := &ReturnMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					SwapID:   defaultSequenceId,
+				}
+				res, err := rt.Deliver(tickCtx, db, &weavetest.Tx{Msg: returnMsg})
+				if err != nil {
+					t.Fatalf("cannot execute return task: %s", err)
+				}
+				if !strings.Contains(res.Log, "swap returned") {
+					t.Fatalf("unexpected return result log: %q", res.Log)
+				}
+			}
+
+			coins := checkBalance(t, db, tc.WantOwner)
+			assert.Equal(t, true, coins.Equals(initialCoins))
+			assert.IsErr(t, errors.ErrNotFound, bucket.Has(db, defaultSequenceId))
+		})
+	}
+}
+
 func setBalance(t testing.TB, db weave.KVStore, addr weave.Address, coins coin.Coins) {
 	t.Helper()
 
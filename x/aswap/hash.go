@@ -0,0 +1,64 @@
+package aswap
+
+import (
+	"crypto/sha256"
+
+	"github.com/iov-one/weave/errors"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/sha3"
+)
+
+// Validate returns an error if the hash algorithm is not supported.
+func (a HashAlgorithm) Validate() error {
+	if _, ok := HashAlgorithm_name[int32(a)]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unsupported hash algorithm %d", a)
+	}
+	return nil
+}
+
+// Hash returns the preimage hash computed using this algorithm.
+func (a HashAlgorithm) Hash(preimage []byte) ([]byte, error) {
+	switch a {
+	case HashAlgorithm_SHA256:
+		return HashBytes(preimage), nil
+	case HashAlgorithm_Keccak256:
+		h := sha3.NewLegacyKeccak256()
+		h.Write(preimage)
+		return h.Sum(nil), nil
+	case HashAlgorithm_Hash160:
+		sum := sha256.Sum256(preimage)
+		h := ripemd160.New()
+		h.Write(sum[:])
+		return h.Sum(nil), nil
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "unsupported hash algorithm %d", a)
+	}
+}
+
+// hashSize returns the length of the hash in bytes. Zero is returned for
+// unsupported algorithms.
+func (a HashAlgorithm) hashSize() int {
+	switch a {
+	case HashAlgorithm_SHA256, HashAlgorithm_Keccak256:
+		return 32
+	case HashAlgorithm_Hash160:
+		return 20
+	default:
+		return 0
+	}
+}
+
+// validatePreimageHash returns an error if the preimage hash cannot be
+// produced by given algorithm. Schema version 1 supports only sha256.
+func validatePreimageHash(schema uint32, algorithm HashAlgorithm, preimageHash []byte) error {
+	if err := algorithm.Validate(); err != nil {
+		return errors.Field("HashAlgorithm", err, "")
+	}
+	if schema < 2 && algorithm != HashAlgorithm_SHA256 {
+		return errors.Field("HashAlgorithm", errors.ErrSchema, "schema version 2 is required")
+	}
+	if len(preimageHash) != algorithm.hashSize() {
+		return errors.Field("PreimageHash", errors.ErrInput, "preimage hash has to be exactly %d bytes", algorithm.hashSize())
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package aswap
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestHashAlgorithm(t *testing.T) {
+	cases := map[string]struct {
+		Algorithm HashAlgorithm
+		Preimage  string
+		WantHash  string
+		WantErr   *errors.Error
+	}{
+		"sha256": {
+			Algorithm: HashAlgorithm_SHA256,
+			Preimage:  "abc",
+			WantHash:  "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		},
+		"keccak256": {
+			Algorithm: HashAlgorithm_Keccak256,
+			Preimage:  "abc",
+			WantHash:  "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45",
+		},
+		"hash160": {
+			Algorithm: HashAlgorithm_Hash160,
+			Preimage:  "abc",
+			WantHash:  "bb1be98c142444d7a56aa3981c3942a978e4dc33",
+		},
+		"unknown algorithm": {
+			Algorithm: 42,
+			Preimage:  "abc",
+			WantErr:   errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Algorithm.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+			hash, err := tc.Algorithm.Hash([]byte(tc.Preimage))
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected hash error: %s", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.WantHash, hex.EncodeToString(hash))
+			assert.Equal(t, tc.Algorithm.hashSize(), len(hash))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package aswap
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
+)
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// Initializer fulfils the Initializer interface to load data from the genesis file
+type Initializer struct {
+	Minter cash.CoinMinter
+	// Scheduler is used to schedule the automatic return of swaps that
+	// are loaded without a return task.
+	Scheduler weave.Scheduler
+}
+
+// genesisSwap is the genesis file representation of a swap.
+type genesisSwap struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID            uint64         `json:"id,omitempty"`
+	Source        weave.Address  `json:"source"`
+	Destination   weave.Address  `json:"destination"`
+	PreimageHash  []byte         `json:"preimage_hash"`
+	HashAlgorithm HashAlgorithm  `json:"hash_algorithm,omitempty"`
+	Timeout       weave.UnixTime `json:"timeout"`
+	Memo          string         `json:"memo,omitempty"`
+	Amount        []*coin.Coin   `json:"amount,omitempty"`
+	// ReturnTaskID is optional. If not set, a new return task is
+	// scheduled. Otherwise the task must be already present in the cron
+	// queue.
+	ReturnTaskID []byte `json:"return_task_id,omitempty"`
+}
+
+// FromGenesis will parse initial swap info from genesis and save it in the
+// database. The return of each swap that has no return task declared is
+// scheduled.
+func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var swaps []genesisSwap
+	if err := opts.ReadOptions("aswap", &swaps); err != nil {
+		return err
+	}
+	bucket := NewBucket()
+	for _, s := range swaps {
+		var (
+			key []byte
+			err error
+		)
+		if s.ID != 0 {
+			key, err = swapSeq.ReserveVal(kv, int64(s.ID))
+		} else {
+			key, err = swapSeq.NextVal(kv)
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot acquire key")
+		}
+		if err := bucket.Has(kv, key); err == nil {
+			return errors.Wrapf(errors.ErrDuplicate, "swap with id %d", s.ID)
+		}
+		// Algorithms other than sha256 were introduced by the schema
+		// version 2.
+		var schema uint32 = 1
+		if s.HashAlgorithm != HashAlgorithm_SHA256 {
+			schema = 2
+		}
+		swap := Swap{
+			Metadata:      &weave.Metadata{Schema: schema},
+			Source:        s.Source,
+			Destination:   s.Destination,
+			PreimageHash:  s.PreimageHash,
+			HashAlgorithm: s.HashAlgorithm,
+			Timeout:       s.Timeout,
+			Memo:          s.Memo,
+			Address:       swapAddr(key, s.PreimageHash),
+			ReturnTaskID:  s.ReturnTaskID,
+		}
+		if len(swap.ReturnTaskID) == 0 {
+			msg := &ReturnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				SwapID:   key,
+			}
+			// Return message requires no authentication.
+			taskID, err := i.Scheduler.Schedule(kv, swap.Timeout.Time(), nil, msg)
+			if err != nil {
+				return errors.Wrap(err, "cannot schedule return task")
+			}
+			swap.ReturnTaskID = taskID
+		} else if ok, err := kv.Has(swap.ReturnTaskID); err != nil {
+			return errors.Wrap(err, "cannot check return task")
+		} else if !ok {
+			return errors.Wrapf(errors.ErrNotFound, "return task of swap with id %d", s.ID)
+		}
+		if _, err := bucket.Put(kv, key, &swap); err != nil {
+			return errors.Wrap(err, "cannot save swap")
+		}
+		for _, c := range s.Amount {
+			if err := i.Minter.CoinMint(kv, swap.Address, *c); err != nil {
+				return errors.Wrap(err, "failed to issue coins")
+			}
+		}
+	}
+	return nil
+}
+
+// ExportGenesis will write all swaps to opts, in the format expected by
+// FromGenesis. Swap IDs are preserved so that the swap addresses do not
+// change. Amount is not exported, because the funds are held by the swap
+// address and exported together with all other balances by the cash
+// extension. The return task is exported by the cron extension, so only its
+// ID is written.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	it, err := NewBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate swaps")
+	}
+	defer it.Release()
+
+	swaps := []genesisSwap{}
+	for {
+		var s Swap
+		key, err := it.LoadNext(&s)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load swap")
+		}
+		swaps = append(swaps, genesisSwap{
+			ID:            binary.BigEndian.Uint64(key),
+			Source:        s.Source,
+			Destination:   s.Destination,
+			PreimageHash:  s.PreimageHash,
+			HashAlgorithm: s.HashAlgorithm,
+			Timeout:       s.Timeout,
+			Memo:          s.Memo,
+			ReturnTaskID:  s.ReturnTaskID,
+		})
+	}
+	raw, err := json.Marshal(swaps)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal swaps")
+	}
+	opts["aswap"] = raw
+	return nil
+}
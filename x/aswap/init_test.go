@@ -0,0 +1,137 @@
+package aswap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestGenesisKey(t *testing.T) {
+	const genesis = `
+{
+  "aswap": [
+    {
+      "id": 7,
+      "amount": [
+        {
+          "ticker": "IOV",
+          "whole": 123456789
+        }
+      ],
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "preimage_hash": "zJEWbvoMp/8T8n2WJ7zq8bCD6PfqtjwNeE9hFeOfzsU=",
+      "timeout": "2034-11-10T23:00:00Z"
+    }
+  ]}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "aswap", "cash")
+
+	cashCtrl := cash.NewController(cash.NewBucket())
+	ini := Initializer{Minter: cashCtrl, Scheduler: &weavetest.Cron{}}
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	bucket := NewBucket()
+	var s Swap
+	assert.Nil(t, bucket.One(db, weavetest.SequenceID(7), &s))
+	if len(s.ReturnTaskID) == 0 {
+		t.Fatal("swap return not scheduled")
+	}
+	assert.Equal(t, swapAddr(weavetest.SequenceID(7), s.PreimageHash), s.Address)
+
+	balance, err := cashCtrl.Balance(db, s.Address)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(balance))
+	assert.Equal(t, coin.Coin{Ticker: "IOV", Whole: 123456789}, *balance[0])
+
+	// The next created swap must not reuse the loaded swap ID.
+	next, err := swapSeq.NextVal(db)
+	assert.Nil(t, err)
+	assert.Equal(t, weavetest.SequenceID(8), next)
+
+	exported := make(weave.Options)
+	assert.Nil(t, ini.ExportGenesis(db, exported))
+	var swaps []genesisSwap
+	assert.Nil(t, json.Unmarshal(exported["aswap"], &swaps))
+	want := []genesisSwap{
+		{
+			ID:           7,
+			Source:       s.Source,
+			Destination:  s.Destination,
+			PreimageHash: s.PreimageHash,
+			Timeout:      s.Timeout,
+			ReturnTaskID: s.ReturnTaskID,
+		},
+	}
+	assert.Equal(t, want, swaps)
+}
+
+func TestGenesisHashAlgorithm(t *testing.T) {
+	const genesis = `
+{
+  "aswap": [
+    {
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "preimage_hash": "zJEWbvoMp/8T8n2WJ7zq8bCD6PfqtjwNeE9hFeOfzsU=",
+      "hash_algorithm": 1,
+      "timeout": "2034-11-10T23:00:00Z"
+    }
+  ]}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "aswap", "cash")
+	_, err := migration.NewSchemaBucket().Create(db, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "aswap",
+		Version:  2,
+	})
+	assert.Nil(t, err)
+
+	ini := Initializer{Minter: cash.NewController(cash.NewBucket()), Scheduler: &weavetest.Cron{}}
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	var s Swap
+	assert.Nil(t, NewBucket().One(db, weavetest.SequenceID(1), &s))
+	assert.Equal(t, HashAlgorithm_Keccak256, s.HashAlgorithm)
+}
+
+func TestGenesisMissingReturnTask(t *testing.T) {
+	const genesis = `
+{
+  "aswap": [
+    {
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "preimage_hash": "zJEWbvoMp/8T8n2WJ7zq8bCD6PfqtjwNeE9hFeOfzsU=",
+      "timeout": "2034-11-10T23:00:00Z",
+      "return_task_id": "bm90IGEgdGFzaw=="
+    }
+  ]}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "aswap", "cash")
+
+	ini := Initializer{Minter: cash.NewController(cash.NewBucket()), Scheduler: &weavetest.Cron{}}
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want ErrNotFound, got %+v", err)
+	}
+}
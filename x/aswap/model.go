@@ -8,6 +8,9 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Swap{}, migration.NoModification)
+	// Zero value of the hash algorithm introduced by the version 2 is
+	// sha256, the only algorithm supported by the version 1.
+	migration.MustRegister(2, &Swap{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*Swap)(nil)
@@ -18,9 +21,7 @@ func (s *Swap) Validate() error {
 	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
 	errs = errors.AppendField(errs, "Source", s.Source.Validate())
 	errs = errors.AppendField(errs, "Destination", s.Destination.Validate())
-	if len(s.PreimageHash) != preimageHashSize {
-		errs = errors.Append(errs, errors.Field("PreimageHash", errors.ErrInput, "preimage hash has to be exactly %d bytes", preimageHashSize))
-	}
+	errs = errors.Append(errs, validatePreimageHash(s.Metadata.GetSchema(), s.HashAlgorithm, s.PreimageHash))
 	if s.Timeout == 0 {
 		// Zero timeout is a valid value that dates to 1970-01-01. We
 		// know that this value is in the past and makes no sense. Most
@@ -38,13 +39,15 @@ func (s *Swap) Validate() error {
 // Copy makes a new swap
 func (s *Swap) Copy() orm.CloneableData {
 	return &Swap{
-		Metadata:     s.Metadata.Copy(),
-		PreimageHash: s.PreimageHash,
-		Source:       s.Source,
-		Destination:  s.Destination,
-		Timeout:      s.Timeout,
-		Memo:         s.Memo,
-		Address:      s.Address.Clone(),
+		Metadata:      s.Metadata.Copy(),
+		PreimageHash:  s.PreimageHash,
+		Source:        s.Source,
+		Destination:   s.Destination,
+		Timeout:       s.Timeout,
+		Memo:          s.Memo,
+		Address:       s.Address.Clone(),
+		ReturnTaskID:  append([]byte(nil), s.ReturnTaskID...),
+		HashAlgorithm: s.HashAlgorithm,
 	}
 }
 
@@ -32,6 +32,20 @@ func TestSwap(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"Hash160 hash": {
+			Mutator: func(msg *aswap.Swap) {
+				msg.Metadata.Schema = 2
+				msg.HashAlgorithm = aswap.HashAlgorithm_Hash160
+				msg.PreimageHash = make([]byte, 20)
+			},
+		},
+		"Hash algorithm not supported by schema 1": {
+			Mutator: func(msg *aswap.Swap) {
+				msg.HashAlgorithm = aswap.HashAlgorithm_Hash160
+				msg.PreimageHash = make([]byte, 20)
+			},
+			Exp: errors.ErrSchema,
+		},
 		"Invalid destination": {
 			Mutator: func(msg *aswap.Swap) {
 				msg.Destination = nil
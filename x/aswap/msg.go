@@ -11,14 +11,19 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReleaseMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReturnMsg{}, migration.NoModification)
+
+	// Schema version 2 introduces the hash algorithm choice. Its zero
+	// value is sha256, the only algorithm supported by the version 1, so
+	// no data modification is needed.
+	migration.MustRegister(2, &CreateMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReleaseMsg{}, migration.NoModification)
+	migration.MustRegister(2, &ReturnMsg{}, migration.NoModification)
 }
 
 const (
 	maxMemoSize int = 128
 	// preimage size in bytes
 	preimageSize int = 32
-	// preimageHash size in bytes
-	preimageHashSize int = 32
 )
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -30,9 +35,7 @@ func (CreateMsg) Path() string {
 func (m *CreateMsg) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
-	if len(m.PreimageHash) != preimageHashSize {
-		errs = errors.Append(errs, errors.Field("PreimageHash", errors.ErrInput, "preimage hash has to be exactly %d bytes", preimageHashSize))
-	}
+	errs = errors.Append(errs, validatePreimageHash(m.Metadata.GetSchema(), m.HashAlgorithm, m.PreimageHash))
 
 	errs = errors.AppendField(errs, "Source", m.Source.Validate())
 	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
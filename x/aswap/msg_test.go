@@ -35,6 +35,39 @@ func TestCreateMsg(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"Keccak256 hash": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.Metadata.Schema = 2
+				msg.HashAlgorithm = aswap.HashAlgorithm_Keccak256
+			},
+		},
+		"Hash160 hash": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.Metadata.Schema = 2
+				msg.HashAlgorithm = aswap.HashAlgorithm_Hash160
+				msg.PreimageHash = make([]byte, 20)
+			},
+		},
+		"Invalid Hash160 hash size": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.Metadata.Schema = 2
+				msg.HashAlgorithm = aswap.HashAlgorithm_Hash160
+			},
+			Exp: errors.ErrInput,
+		},
+		"Hash algorithm not supported by schema 1": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.HashAlgorithm = aswap.HashAlgorithm_Keccak256
+			},
+			Exp: errors.ErrSchema,
+		},
+		"Unknown hash algorithm": {
+			Mutator: func(msg *aswap.CreateMsg) {
+				msg.Metadata.Schema = 2
+				msg.HashAlgorithm = 42
+			},
+			Exp: errors.ErrInput,
+		},
 		"Invalid destination": {
 			Mutator: func(msg *aswap.CreateMsg) {
 				msg.Destination = nil
@@ -232,6 +232,10 @@ type Configuration struct {
 	Owner            github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	CollectorAddress github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=collector_address,json=collectorAddress,proto3,casttype=github.com/iov-one/weave.Address" json:"collector_address,omitempty"`
 	MinimalFee       coin.Coin                        `protobuf:"bytes,4,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// Fee market enables adjusting the minimal fee at the end of every block,
+	// depending on how full the block was. When not set, the minimal fee is
+	// static.
+	FeeMarket *FeeMarket `protobuf:"bytes,5,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -295,6 +299,139 @@ func (m *Configuration) GetMinimalFee() coin.Coin {
 	return coin.Coin{}
 }
 
+func (m *Configuration) GetFeeMarket() *FeeMarket {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return nil
+}
+
+// FeeMarket declares how the minimal fee changes depending on the block
+// fullness. Block fullness is measured by the amount of gas consumed by all
+// transactions delivered in a block.
+// Minimal fee is never lower than the configured minimal fee.
+type FeeMarket struct {
+	// Target block gas is the amount of gas that a block should consume. If a
+	// block consumes more, the minimal fee increases. If less, the minimal fee
+	// decreases.
+	TargetBlockGas int64 `protobuf:"varint,1,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// Adjustment denominator limits the change of the minimal fee after a
+	// single block to 1/adjustment_denominator of its current value. Default
+	// is 8.
+	AdjustmentDenominator uint32 `protobuf:"varint,2,opt,name=adjustment_denominator,json=adjustmentDenominator,proto3" json:"adjustment_denominator,omitempty"`
+	// Max fee is an optional upper limit of the minimal fee.
+	MaxFee coin.Coin `protobuf:"bytes,3,opt,name=max_fee,json=maxFee,proto3" json:"max_fee"`
+}
+
+func (m *FeeMarket) Reset()         { *m = FeeMarket{} }
+func (m *FeeMarket) String() string { return proto.CompactTextString(m) }
+func (*FeeMarket) ProtoMessage()    {}
+func (*FeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{4}
+}
+func (m *FeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarket.Merge(m, src)
+}
+func (m *FeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarket proto.InternalMessageInfo
+
+func (m *FeeMarket) GetTargetBlockGas() int64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarket) GetAdjustmentDenominator() uint32 {
+	if m != nil {
+		return m.AdjustmentDenominator
+	}
+	return 0
+}
+
+func (m *FeeMarket) GetMaxFee() coin.Coin {
+	if m != nil {
+		return m.MaxFee
+	}
+	return coin.Coin{}
+}
+
+// FeeMarketState holds the minimal fee computed by the fee market at the end
+// of the last block.
+type FeeMarketState struct {
+	MinimalFee coin.Coin `protobuf:"bytes,1,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// Height of the block after which the minimal fee was computed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FeeMarketState) Reset()         { *m = FeeMarketState{} }
+func (m *FeeMarketState) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketState) ProtoMessage()    {}
+func (*FeeMarketState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7149e4b58e322390, []int{5}
+}
+func (m *FeeMarketState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketState.Merge(m, src)
+}
+func (m *FeeMarketState) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketState) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketState proto.InternalMessageInfo
+
+func (m *FeeMarketState) GetMinimalFee() coin.Coin {
+	if m != nil {
+		return m.MinimalFee
+	}
+	return coin.Coin{}
+}
+
+func (m *FeeMarketState) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type UpdateConfigurationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -304,7 +441,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7149e4b58e322390, []int{4}
+	return fileDescriptor_7149e4b58e322390, []int{6}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,41 +489,51 @@ func init() {
 	proto.RegisterType((*SendMsg)(nil), "cash.SendMsg")
 	proto.RegisterType((*FeeInfo)(nil), "cash.FeeInfo")
 	proto.RegisterType((*Configuration)(nil), "cash.Configuration")
+	proto.RegisterType((*FeeMarket)(nil), "cash.FeeMarket")
+	proto.RegisterType((*FeeMarketState)(nil), "cash.FeeMarketState")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cash.UpdateConfigurationMsg")
 }
 
 func init() { proto.RegisterFile("x/cash/codec.proto", fileDescriptor_7149e4b58e322390) }
 
 var fileDescriptor_7149e4b58e322390 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x8d, 0x13, 0x27, 0x85, 0x31, 0x88, 0xb0, 0x20, 0x64, 0xe5, 0xe0, 0x5a, 0x16, 0x87, 0x20,
-	0x84, 0x2d, 0xc2, 0xad, 0xe2, 0x42, 0x2a, 0x45, 0xe2, 0xd0, 0x03, 0x2e, 0x9c, 0xab, 0xed, 0x7a,
-	0xec, 0xac, 0x14, 0xef, 0x44, 0xf6, 0xa6, 0x85, 0x1f, 0xe0, 0xcc, 0x67, 0xf5, 0xd8, 0x23, 0xa7,
-	0x0a, 0x25, 0x7f, 0xc1, 0x01, 0xa1, 0x5d, 0x5b, 0x55, 0xa2, 0x9e, 0x7c, 0x1b, 0xbf, 0x99, 0xf7,
-	0x3c, 0xef, 0xed, 0x2e, 0xb0, 0xef, 0x89, 0xe0, 0xf5, 0x32, 0x11, 0x94, 0xa1, 0x88, 0xd7, 0x15,
-	0x69, 0x62, 0xae, 0x41, 0x26, 0xde, 0x1e, 0x34, 0x19, 0x0b, 0x92, 0x6a, 0x7f, 0x68, 0xf2, 0xb2,
-	0xa0, 0x82, 0x6c, 0x99, 0x98, 0xaa, 0x41, 0xa3, 0xaf, 0x30, 0x38, 0x47, 0xcd, 0xde, 0xc2, 0xa3,
-	0x12, 0x35, 0xcf, 0xb8, 0xe6, 0xbe, 0x13, 0x3a, 0x53, 0x6f, 0xf6, 0x2c, 0xbe, 0x46, 0x7e, 0x85,
-	0xf1, 0x59, 0x0b, 0xa7, 0xf7, 0x03, 0x2c, 0x84, 0xa1, 0x51, 0xaf, 0xfd, 0x7e, 0x38, 0x98, 0x7a,
-	0x33, 0x88, 0xcd, 0x57, 0x7c, 0x4a, 0x52, 0xa5, 0x4d, 0x23, 0xfa, 0xd9, 0x87, 0xa3, 0x73, 0x54,
-	0xd9, 0x59, 0x5d, 0x74, 0x93, 0xfe, 0x08, 0xa3, 0x9a, 0x36, 0x95, 0x40, 0xbf, 0x1f, 0x3a, 0xd3,
-	0x27, 0xf3, 0xd7, 0x7f, 0xef, 0x8e, 0xc3, 0x42, 0xea, 0xe5, 0xe6, 0x32, 0x16, 0x54, 0x26, 0x92,
-	0xae, 0xde, 0x91, 0xc2, 0xa4, 0x11, 0xf8, 0x94, 0x65, 0x15, 0xd6, 0x75, 0xda, 0x72, 0xd8, 0x02,
-	0xbc, 0x0c, 0x6b, 0x2d, 0x15, 0xd7, 0x92, 0x94, 0x3f, 0xe8, 0x20, 0xb1, 0x4f, 0x64, 0x11, 0x8c,
-	0x78, 0x49, 0x1b, 0xa5, 0x7d, 0xd7, 0x2e, 0xbc, 0xef, 0xb0, 0xed, 0x30, 0x06, 0x6e, 0x89, 0x25,
-	0xf9, 0xc3, 0xd0, 0x99, 0x3e, 0x4e, 0x6d, 0xcd, 0xc6, 0x30, 0xa8, 0x30, 0xf7, 0x47, 0xe6, 0xbf,
-	0xa9, 0x29, 0x23, 0x84, 0xa3, 0x05, 0xe2, 0x67, 0x95, 0x13, 0x3b, 0x81, 0xe1, 0x9a, 0xff, 0xc0,
-	0xaa, 0x93, 0xb3, 0x86, 0xc2, 0x02, 0x70, 0x73, 0xc4, 0xda, 0x3a, 0x3a, 0x5c, 0xc7, 0xe2, 0xd1,
-	0x3f, 0x07, 0x9e, 0x9e, 0x92, 0xca, 0x65, 0xb1, 0xa9, 0x1a, 0x0b, 0x9d, 0x52, 0x3f, 0x81, 0x21,
-	0x5d, 0xab, 0xae, 0xab, 0x59, 0x0a, 0xfb, 0x02, 0xcf, 0x05, 0xad, 0x56, 0x28, 0x34, 0x55, 0x17,
-	0xbc, 0xe9, 0x75, 0x4a, 0x7e, 0x7c, 0x4f, 0x6f, 0x11, 0xf6, 0x1e, 0xbc, 0x52, 0x2a, 0x59, 0xf2,
-	0xd5, 0x45, 0x8e, 0xf8, 0xf0, 0x0c, 0xe6, 0xee, 0xcd, 0xdd, 0x71, 0x2f, 0x85, 0x76, 0x68, 0x81,
-	0x18, 0xad, 0xe1, 0xd5, 0xb7, 0x75, 0xc6, 0x35, 0x1e, 0xa4, 0xd0, 0xf9, 0xfa, 0xbd, 0x31, 0x67,
-	0xa4, 0xc5, 0xd2, 0x06, 0xe1, 0xcd, 0x5e, 0xc4, 0xe6, 0x61, 0xc5, 0x07, 0x9a, 0x69, 0x33, 0x31,
-	0xf7, 0x6f, 0xb6, 0x81, 0x73, 0xbb, 0x0d, 0x9c, 0x3f, 0xdb, 0xc0, 0xf9, 0xb5, 0x0b, 0x7a, 0xb7,
-	0xbb, 0xa0, 0xf7, 0x7b, 0x17, 0xf4, 0x2e, 0x47, 0xf6, 0x65, 0x7d, 0xf8, 0x1f, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0x8c, 0x17, 0xa5, 0xaa, 0x03, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8d, 0xe3, 0xc4, 0xfd, 0x72, 0xfd, 0xb5, 0x84, 0x01, 0x22, 0x2b, 0x0b, 0xd7, 0xb2, 0x58,
+	0xa4, 0x42, 0x38, 0x22, 0x88, 0x4d, 0xc5, 0x86, 0x14, 0x05, 0xb1, 0xc8, 0x02, 0x07, 0x56, 0x2c,
+	0xac, 0x89, 0x7d, 0xed, 0x98, 0x66, 0x66, 0x22, 0x7b, 0xd2, 0x86, 0x17, 0x60, 0xcd, 0x8e, 0xb7,
+	0xe0, 0x39, 0xba, 0xec, 0x92, 0x55, 0x85, 0x92, 0xb7, 0x60, 0x85, 0xfc, 0x43, 0x9a, 0xb6, 0x42,
+	0xc2, 0xbb, 0x3b, 0xe7, 0xdc, 0x7b, 0x72, 0xcf, 0xc9, 0x8c, 0x81, 0xac, 0xfa, 0x3e, 0x4d, 0x67,
+	0x7d, 0x5f, 0x04, 0xe8, 0x3b, 0x8b, 0x44, 0x48, 0x41, 0x1a, 0x19, 0xd2, 0xd5, 0x77, 0xa0, 0x6e,
+	0xdb, 0x17, 0x31, 0xdf, 0x6d, 0xea, 0x3e, 0x8c, 0x44, 0x24, 0xf2, 0xb2, 0x9f, 0x55, 0x05, 0x6a,
+	0xbf, 0x07, 0x75, 0x82, 0x92, 0x3c, 0x81, 0xff, 0x18, 0x4a, 0x1a, 0x50, 0x49, 0x0d, 0xc5, 0x52,
+	0x7a, 0xfa, 0xe0, 0x9e, 0x73, 0x8e, 0xf4, 0x0c, 0x9d, 0x71, 0x09, 0xbb, 0xdb, 0x06, 0x62, 0x41,
+	0x33, 0x53, 0x4f, 0x8d, 0xba, 0xa5, 0xf6, 0xf4, 0x01, 0x38, 0xd9, 0xc9, 0x39, 0x11, 0x31, 0x77,
+	0x0b, 0xc2, 0xfe, 0x52, 0x87, 0xbd, 0x09, 0xf2, 0x60, 0x9c, 0x46, 0xd5, 0xa4, 0x5f, 0x82, 0x96,
+	0x8a, 0x65, 0xe2, 0xa3, 0x51, 0xb7, 0x94, 0xde, 0xff, 0xc3, 0xc7, 0xbf, 0xae, 0x0e, 0xad, 0x28,
+	0x96, 0xb3, 0xe5, 0xd4, 0xf1, 0x05, 0xeb, 0xc7, 0xe2, 0xec, 0xa9, 0xe0, 0xd8, 0x2f, 0x04, 0x5e,
+	0x05, 0x41, 0x82, 0x69, 0xea, 0x96, 0x33, 0x64, 0x04, 0x7a, 0x80, 0xa9, 0x8c, 0x39, 0x95, 0xb1,
+	0xe0, 0x86, 0x5a, 0x41, 0x62, 0x77, 0x90, 0xd8, 0xa0, 0x51, 0x26, 0x96, 0x5c, 0x1a, 0x0d, 0x4b,
+	0xb9, 0xe5, 0xb0, 0x64, 0x08, 0x81, 0x06, 0x43, 0x26, 0x8c, 0xa6, 0xa5, 0xf4, 0x5a, 0x6e, 0x5e,
+	0x93, 0x36, 0xa8, 0x09, 0x86, 0x86, 0x96, 0xfd, 0xae, 0x9b, 0x95, 0x36, 0xc2, 0xde, 0x08, 0xf1,
+	0x2d, 0x0f, 0x05, 0x39, 0x86, 0xe6, 0x82, 0x7e, 0xc6, 0xa4, 0x92, 0xb3, 0x62, 0x84, 0x98, 0xd0,
+	0x08, 0x11, 0x53, 0x43, 0xbd, 0xb3, 0x4e, 0x8e, 0xdb, 0xdf, 0xeb, 0xb0, 0x7f, 0x22, 0x78, 0x18,
+	0x47, 0xcb, 0xa4, 0xb0, 0x50, 0x29, 0xf5, 0x63, 0x68, 0x8a, 0x73, 0x5e, 0x75, 0xb5, 0x7c, 0x84,
+	0xbc, 0x83, 0xfb, 0xbe, 0x98, 0xcf, 0xd1, 0x97, 0x22, 0xf1, 0x68, 0xc1, 0x55, 0x4a, 0xbe, 0xbd,
+	0x1d, 0x2f, 0x11, 0xf2, 0x0c, 0x74, 0x16, 0xf3, 0x98, 0xd1, 0xb9, 0x17, 0x22, 0xde, 0xfd, 0x0f,
+	0x86, 0x8d, 0x8b, 0xab, 0xc3, 0x9a, 0x0b, 0x65, 0xd3, 0x08, 0x91, 0x38, 0x00, 0x21, 0xa2, 0xc7,
+	0x68, 0x72, 0x8a, 0xd2, 0x68, 0x96, 0x86, 0xb3, 0x67, 0xe1, 0x8c, 0x10, 0xc7, 0x39, 0xec, 0xb6,
+	0xc2, 0x3f, 0xa5, 0xfd, 0x4d, 0x81, 0xd6, 0x96, 0x20, 0x3d, 0x68, 0x4b, 0x9a, 0x44, 0x28, 0xbd,
+	0xe9, 0x5c, 0xf8, 0xa7, 0x5e, 0x44, 0xd3, 0x3c, 0x34, 0xd5, 0x3d, 0x28, 0xf0, 0x61, 0x06, 0xbf,
+	0xa1, 0x29, 0x79, 0x01, 0x1d, 0x1a, 0x7c, 0x5a, 0xa6, 0x92, 0x21, 0x97, 0x5e, 0x80, 0x5c, 0xb0,
+	0xec, 0xd2, 0x88, 0x22, 0xba, 0x7d, 0xf7, 0xd1, 0x35, 0xfb, 0xfa, 0x9a, 0x24, 0x47, 0xb0, 0xc7,
+	0xe8, 0x2a, 0x77, 0xa3, 0xfe, 0xc5, 0x8d, 0xc6, 0xe8, 0x6a, 0x84, 0x68, 0x7f, 0x84, 0x83, 0xed,
+	0x62, 0x13, 0x49, 0x25, 0xde, 0x8e, 0x43, 0xf9, 0x87, 0x38, 0x3a, 0xa0, 0xcd, 0x30, 0x8e, 0x66,
+	0x32, 0x5f, 0x4b, 0x75, 0xcb, 0x93, 0xbd, 0x80, 0xce, 0x87, 0x45, 0x40, 0x25, 0xde, 0xb8, 0x2c,
+	0x95, 0x5f, 0xe9, 0x51, 0x76, 0x95, 0xa5, 0x3f, 0xcb, 0xd5, 0xf5, 0xc1, 0x83, 0x22, 0xe8, 0x1b,
+	0x9a, 0x6e, 0xd1, 0x31, 0x34, 0x2e, 0xd6, 0xa6, 0x72, 0xb9, 0x36, 0x95, 0x9f, 0x6b, 0x53, 0xf9,
+	0xba, 0x31, 0x6b, 0x97, 0x1b, 0xb3, 0xf6, 0x63, 0x63, 0xd6, 0xa6, 0x5a, 0xfe, 0x01, 0x7a, 0xfe,
+	0x7b, 0x00, 0x25, 0xc6, 0xa2, 0xc5, 0xd1, 0x04, 0x00, 0x00,
 }
 
 func (m *Set) Marshal() (dAtA []byte, err error) {
@@ -570,6 +717,83 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n6
+	if m.FeeMarket != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FeeMarket.Size()))
+		n7, err := m.FeeMarket.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *FeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TargetBlockGas != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TargetBlockGas))
+	}
+	if m.AdjustmentDenominator != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdjustmentDenominator))
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MaxFee.Size()))
+	n8, err := m.MaxFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	return i, nil
+}
+
+func (m *FeeMarketState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
+	n9, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
 	return i, nil
 }
 
@@ -592,21 +816,21 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n10
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n8, err := m.Patch.MarshalTo(dAtA[i:])
+		n11, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	return i, nil
 }
@@ -709,6 +933,41 @@ func (m *Configuration) Size() (n int) {
 	}
 	l = m.MinimalFee.Size()
 	n += 1 + l + sovCodec(uint64(l))
+	if m.FeeMarket != nil {
+		l = m.FeeMarket.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovCodec(uint64(m.TargetBlockGas))
+	}
+	if m.AdjustmentDenominator != 0 {
+		n += 1 + sovCodec(uint64(m.AdjustmentDenominator))
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *FeeMarketState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinimalFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
 	return n
 }
 
@@ -1413,6 +1672,271 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeMarket == nil {
+				m.FeeMarket = &FeeMarket{}
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentDenominator", wireType)
+			}
+			m.AdjustmentDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdjustmentDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
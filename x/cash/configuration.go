@@ -1,6 +1,8 @@
 package cash
 
 import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -27,6 +29,22 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(errors.ErrState, "minimal fee cannot be negative")
 		}
 	}
+	if c.FeeMarket != nil {
+		if err := c.FeeMarket.Validate(); err != nil {
+			return errors.Wrap(err, "fee market")
+		}
+		if !c.MinimalFee.IsPositive() {
+			return errors.Wrap(errors.ErrState, "fee market requires a minimal fee")
+		}
+		if max := c.FeeMarket.MaxFee; !max.IsZero() {
+			if !max.SameType(c.MinimalFee) {
+				return errors.Wrap(errors.ErrCurrency, "fee market max fee and minimal fee currency mismatch")
+			}
+			if !max.IsGTE(c.MinimalFee) {
+				return errors.Wrap(errors.ErrState, "fee market max fee lower than minimal fee")
+			}
+		}
+	}
 	return nil
 }
 
@@ -38,3 +56,11 @@ func mustLoadConf(db gconf.Store) Configuration {
 	}
 	return conf
 }
+
+func mustLoadMinimalFee(db weave.ReadOnlyKVStore) coin.Coin {
+	fee, err := CurrentMinimalFee(db)
+	if err != nil {
+		panic(errors.Wrap(err, "load minimal fee"))
+	}
+	return fee
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -64,6 +65,25 @@ func TestConfigurationHandler(t *testing.T) {
 				MinimalFee: coin.NewCoin(0, 40, "ETH"),
 			},
 		},
+		"enable fee market": {
+			init: Configuration{
+				Owner:            ownerAddr,
+				CollectorAddress: otherAddr,
+				MinimalFee:       coin.NewCoin(0, 20, "IOV"),
+			},
+			auth: owner,
+			update: UpdateConfigurationMsg{
+				Patch: &Configuration{
+					FeeMarket: &FeeMarket{TargetBlockGas: 1000},
+				},
+			},
+			expected: Configuration{
+				Owner:            ownerAddr,
+				CollectorAddress: otherAddr,
+				MinimalFee:       coin.NewCoin(0, 20, "IOV"),
+				FeeMarket:        &FeeMarket{TargetBlockGas: 1000},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -95,3 +115,63 @@ func TestConfigurationHandler(t *testing.T) {
 	}
 
 }
+
+func TestConfigurationValidateFeeMarket(t *testing.T) {
+	cases := map[string]struct {
+		conf    Configuration
+		wantErr *errors.Error
+	}{
+		"valid fee market": {
+			conf: Configuration{
+				MinimalFee: coin.NewCoin(1, 0, "IOV"),
+				FeeMarket: &FeeMarket{
+					TargetBlockGas: 1000,
+					MaxFee:         coin.NewCoin(10, 0, "IOV"),
+				},
+			},
+		},
+		"target block gas is required": {
+			conf: Configuration{
+				MinimalFee: coin.NewCoin(1, 0, "IOV"),
+				FeeMarket:  &FeeMarket{},
+			},
+			wantErr: errors.ErrState,
+		},
+		"minimal fee is required": {
+			conf: Configuration{
+				FeeMarket: &FeeMarket{TargetBlockGas: 1000},
+			},
+			wantErr: errors.ErrState,
+		},
+		"max fee lower than minimal fee": {
+			conf: Configuration{
+				MinimalFee: coin.NewCoin(1, 0, "IOV"),
+				FeeMarket: &FeeMarket{
+					TargetBlockGas: 1000,
+					MaxFee:         coin.NewCoin(0, 1, "IOV"),
+				},
+			},
+			wantErr: errors.ErrState,
+		},
+		"max fee in a different currency": {
+			conf: Configuration{
+				MinimalFee: coin.NewCoin(1, 0, "IOV"),
+				FeeMarket: &FeeMarket{
+					TargetBlockGas: 1000,
+					MaxFee:         coin.NewCoin(10, 0, "ETH"),
+				},
+			},
+			wantErr: errors.ErrCurrency,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tc.conf.Metadata = &weave.Metadata{Schema: 1}
+			tc.conf.CollectorAddress = weavetest.NewCondition().Address()
+			if err := tc.conf.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
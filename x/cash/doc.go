@@ -8,5 +8,11 @@ referred to as cash. Simple and safe.
 
 In the future, there should be more implementations that
 support sending and issuing tokens with much more logic inside.
+
+The minimal fee that every transaction must pay is declared by the
+configuration. When the fee market is configured, the minimal fee
+is adjusted at the end of every block depending on the amount of
+gas consumed by that block (see FeeMarketEndBlocker). The current
+minimal fee can be queried using the "/feemarket" path.
 */
 package cash
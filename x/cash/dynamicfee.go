@@ -10,6 +10,13 @@ The business logic is:
 3. If a transaction processing results in an error, revert all transaction
    changes and charge only the min fee.
 
+The min fee is charged before the transaction is processed, so that it is paid
+even if the processing fails because the transaction ran out of gas.
+
+The min fee is the minimal fee declared by the configuration or, if the fee
+market is enabled, the minimal fee adjusted at the end of the last block
+depending on its fullness (see FeeMarketEndBlocker).
+
 TODO: If a transaction succeeded, but requested a RequiredFee higher than paid
 fee, revert all transaction changes and refund all but the min fee, returning
 an error.
@@ -78,11 +85,14 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	rest, err := d.chargeMinimalFee(store, payer, fee)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot charge minimal fee")
+	}
+	if err := d.chargeFee(cache, payer, rest); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	cres, err = next.Check(ctx, cache, tx)
@@ -116,11 +126,14 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	rest, err := d.chargeMinimalFee(store, payer, fee)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot charge minimal fee")
+	}
+	if err := d.chargeFee(cache, payer, rest); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	res, err := next.Deliver(ctx, cache, tx)
@@ -142,16 +155,32 @@ func (d DynamicFeeDecorator) chargeFee(store weave.KVStore, src weave.Address, a
 	return d.ctrl.MoveCoins(store, src, dest, amount)
 }
 
-// chargeMinimalFee deduct an anty span fee from a given account.
-func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Address) error {
-	fee := mustLoadConf(store).MinimalFee
-	if fee.IsZero() {
-		return nil
+// chargeMinimalFee deducts an anti spam fee from a given account and returns
+// the rest of the transaction fee, that must be charged only if the
+// transaction succeeds. The minimal fee is written using a separate cache, so
+// that it is either charged fully or not at all.
+func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Address, fee coin.Coin) (coin.Coin, error) {
+	minFee := mustLoadMinimalFee(store)
+	if minFee.IsZero() {
+		return fee, nil
+	}
+	if minFee.Ticker == "" {
+		return fee, errors.Wrap(errors.ErrHuman, "minimal fee without a ticker")
+	}
+	rest, err := fee.Subtract(minFee)
+	if err != nil {
+		return fee, errors.Wrap(err, "fee less than minimal fee")
+	}
+	cache := store.(weave.CacheableKVStore).CacheWrap()
+	if err := d.chargeFee(cache, src, minFee); err != nil {
+		cache.Discard()
+		return fee, err
 	}
-	if fee.Ticker == "" {
-		return errors.Wrap(errors.ErrHuman, "minimal fee without a ticker")
+	if err := cache.Write(); err != nil {
+		cache.Discard()
+		return fee, err
 	}
-	return d.chargeFee(store, src, fee)
+	return rest, nil
 }
 
 // prepare is all shared setup between Check and Deliver. It computes the fee
@@ -195,7 +224,7 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 
 	txFee := finfo.GetFees()
 	if coin.IsEmpty(txFee) {
-		minFee := mustLoadConf(store).MinimalFee
+		minFee := mustLoadMinimalFee(store)
 		if minFee.IsZero() {
 			return finfo, nil
 		}
@@ -206,7 +235,7 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		return nil, errors.Wrap(err, "invalid fee")
 	}
 
-	minFee := mustLoadConf(store).MinimalFee
+	minFee := mustLoadMinimalFee(store)
 	if minFee.IsZero() {
 		return finfo, nil
 	}
@@ -2,6 +2,7 @@ package cash
 
 import (
 	"context"
+	"strconv"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -59,6 +60,82 @@ func TestCacheWriteFail(t *testing.T) {
 	}
 }
 
+func TestDynamicFeeDecoratorOutOfGas(t *testing.T) {
+	auth := &weavetest.Auth{
+		Signer: weavetest.NewCondition(),
+	}
+	payer := auth.Signer.Address()
+	collector := weavetest.NewCondition().Address()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	config := Configuration{
+		CollectorAddress: collector,
+		MinimalFee:       coin.NewCoin(0, 500000000, "IOV"),
+	}
+	if err := gconf.Save(db, "cash", &config); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	ctrl := NewController(NewBucket())
+	if err := ctrl.CoinMint(db, payer, coin.NewCoin(100, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	// The limit is enough to charge the fee, but not to process the
+	// transaction.
+	meter := weave.NewGasMeter(1000)
+	gasdb := store.NewGasKVStore(db, meter, store.GasConfig{WriteCostFlat: 100})
+	handler := &writeHandler{writes: 100}
+	tx := &txMock{info: &FeeInfo{Fees: coin.NewCoinp(1, 0, "IOV")}}
+
+	decorator := NewDynamicFeeDecorator(auth, ctrl)
+	if _, err := decorator.Deliver(context.TODO(), gasdb, tx, handler); !errors.ErrOutOfGas.Is(err) {
+		t.Fatalf("want out of gas error, got %+v", err)
+	}
+
+	balance := func(addr weave.Address) coin.Coins {
+		t.Helper()
+		coins, err := ctrl.Balance(db, addr)
+		if err != nil && !errors.ErrNotFound.Is(err) {
+			t.Fatalf("cannot get balance: %s", err)
+		}
+		return coins
+	}
+	wantPayer := coin.Coins{coin.NewCoinp(99, 500000000, "IOV")}
+	if got := balance(payer); !got.Equals(wantPayer) {
+		t.Fatalf("want payer balance %v, got %v", wantPayer, got)
+	}
+	wantCollector := coin.Coins{coin.NewCoinp(0, 500000000, "IOV")}
+	if got := balance(collector); !got.Equals(wantCollector) {
+		t.Fatalf("want collector balance %v, got %v", wantCollector, got)
+	}
+	if ok, err := db.Has([]byte("0")); err != nil || ok {
+		t.Fatalf("want handler changes to be reverted: %v", err)
+	}
+}
+
+// writeHandler writes given number of keys.
+type writeHandler struct {
+	writes int
+}
+
+func (h *writeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return &weave.CheckResult{}, h.write(db)
+}
+
+func (h *writeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	return &weave.DeliverResult{}, h.write(db)
+}
+
+func (h *writeHandler) write(db weave.KVStore) error {
+	for i := 0; i < h.writes; i++ {
+		if err := db.Set([]byte(strconv.Itoa(i)), []byte("value")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // cacheableStoreMock is a mock of a store and a cache wrap. Use it to pass
 // through all operation to wrapped CacheableKVStore. Write call returns
 // defined error.
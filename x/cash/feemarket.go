@@ -0,0 +1,267 @@
+package cash
+
+import (
+	"math/big"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// defaultAdjustmentDenominator is used when the fee market configuration
+// does not declare the adjustment denominator.
+const defaultAdjustmentDenominator = 8
+
+// feeMarketKey is the database key under which the fee market state is
+// stored.
+var feeMarketKey = []byte("_fm:cash")
+
+// Validate returns an error if the fee market configuration is not valid.
+func (m *FeeMarket) Validate() error {
+	if m.TargetBlockGas <= 0 {
+		return errors.Wrap(errors.ErrState, "target block gas must be greater than zero")
+	}
+	if !m.MaxFee.IsZero() {
+		if err := m.MaxFee.Validate(); err != nil {
+			return errors.Wrap(err, "max fee")
+		}
+		if !m.MaxFee.IsPositive() {
+			return errors.Wrap(errors.ErrState, "max fee must be greater than zero")
+		}
+	}
+	return nil
+}
+
+// CurrentMinimalFee returns the minimal fee that a transaction must pay in
+// order to be processed.
+//
+// If the fee market is not enabled, this is the minimal fee declared by the
+// configuration. Otherwise it is the minimal fee computed at the end of the
+// last block, never lower than the configured minimal fee and never higher
+// than the fee market max fee.
+func CurrentMinimalFee(db weave.ReadOnlyKVStore) (coin.Coin, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "cash", &conf); err != nil {
+		return coin.Coin{}, errors.Wrap(err, "load configuration")
+	}
+	return currentMinimalFee(db, conf)
+}
+
+func currentMinimalFee(db weave.ReadOnlyKVStore, conf Configuration) (coin.Coin, error) {
+	if conf.FeeMarket == nil {
+		return conf.MinimalFee, nil
+	}
+	state, err := loadFeeMarketState(db)
+	if err != nil {
+		return coin.Coin{}, err
+	}
+	// State that was computed for a different currency is ignored. This
+	// can happen when the minimal fee currency was changed.
+	if state == nil || !state.MinimalFee.SameType(conf.MinimalFee) {
+		return conf.MinimalFee, nil
+	}
+	return clampFee(state.MinimalFee, conf), nil
+}
+
+// ScaleFee returns given fee adjusted proportionally to the change of the
+// minimal fee made by the fee market. For example if the current minimal fee
+// is twice the configured minimal fee, the returned fee is twice the given
+// fee.
+// If the fee market is not enabled or the cash extension is not configured,
+// given fee is returned unchanged.
+func ScaleFee(db weave.ReadOnlyKVStore, fee coin.Coin) (coin.Coin, error) {
+	if fee.IsZero() {
+		return fee, nil
+	}
+	var conf Configuration
+	switch err := gconf.Load(db, "cash", &conf); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return fee, nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "load configuration")
+	}
+	if conf.FeeMarket == nil || conf.MinimalFee.IsZero() {
+		return fee, nil
+	}
+	current, err := currentMinimalFee(db, conf)
+	if err != nil {
+		return coin.Coin{}, err
+	}
+	units := toUnits(fee)
+	units.Mul(units, toUnits(current))
+	units.Quo(units, toUnits(conf.MinimalFee))
+	return fromUnits(units, fee.Ticker)
+}
+
+func loadFeeMarketState(db weave.ReadOnlyKVStore) (*FeeMarketState, error) {
+	raw, err := db.Get(feeMarketKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load fee market state")
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	var state FeeMarketState
+	if err := state.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal fee market state")
+	}
+	return &state, nil
+}
+
+// FeeMarketEndBlocker implements weave.EndBlocker. At the end of every block it
+// adjusts the minimal fee depending on how much gas the block consumed when
+// compared to the target block gas declared by the configuration.
+//
+// Consumed gas is computed using the gas costs stored in the state (see the
+// x/gas extension), so all nodes compute the same minimal fee.
+//
+// A block consuming exactly the target amount of gas does not change the
+// minimal fee. A block consuming twice the target or more increases the
+// minimal fee by 1/adjustment_denominator and an empty block decreases it by
+// the same fraction.
+type FeeMarketEndBlocker struct{}
+
+var _ weave.EndBlocker = FeeMarketEndBlocker{}
+
+// NewFeeMarketEndBlocker returns an end blocker that adjusts the minimal
+// fee according to the fee market configuration.
+func NewFeeMarketEndBlocker() FeeMarketEndBlocker {
+	return FeeMarketEndBlocker{}
+}
+
+// EndBlock computes and stores the minimal fee for the next block.
+func (FeeMarketEndBlocker) EndBlock(ctx weave.Context, db weave.CacheableKVStore, summary weave.BlockSummary) {
+	var conf Configuration
+	switch err := gconf.Load(db, "cash", &conf); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		// Cash extension is not configured.
+		return
+	default:
+		panic(errors.Wrap(err, "load configuration"))
+	}
+	if conf.FeeMarket == nil {
+		if err := db.Delete(feeMarketKey); err != nil {
+			panic(errors.Wrap(err, "cannot delete fee market state"))
+		}
+		return
+	}
+
+	current, err := currentMinimalFee(db, conf)
+	if err != nil {
+		panic(err)
+	}
+	fee, err := adjustFee(current, conf, summary.GasUsed)
+	if err != nil {
+		weave.GetLogger(ctx).Error("cannot adjust minimal fee", "err", err)
+		fee = current
+	}
+	height, _ := weave.GetHeight(ctx)
+	state := FeeMarketState{
+		MinimalFee: fee,
+		Height:     height,
+	}
+	raw, err := state.Marshal()
+	if err != nil {
+		panic(errors.Wrap(err, "cannot marshal fee market state"))
+	}
+	if err := db.Set(feeMarketKey, raw); err != nil {
+		panic(errors.Wrap(err, "cannot save fee market state"))
+	}
+}
+
+// adjustFee returns the minimal fee for the next block, computed from the
+// current minimal fee and the amount of gas consumed by the last block.
+func adjustFee(current coin.Coin, conf Configuration, gasUsed int64) (coin.Coin, error) {
+	target := conf.FeeMarket.TargetBlockGas
+	denominator := int64(conf.FeeMarket.AdjustmentDenominator)
+	if denominator == 0 {
+		denominator = defaultAdjustmentDenominator
+	}
+
+	// Limit the difference so that a single block cannot change the fee
+	// by more than 1/denominator.
+	diff := gasUsed - target
+	if diff > target {
+		diff = target
+	}
+
+	units := toUnits(current)
+	delta := new(big.Int).Mul(units, big.NewInt(diff))
+	delta.Quo(delta, big.NewInt(target*denominator))
+	// Make sure that a full block always increases the fee, even if the
+	// change is too small to be represented.
+	if diff > 0 && delta.Sign() == 0 {
+		delta.SetInt64(1)
+	}
+	units.Add(units, delta)
+
+	fee, err := fromUnits(units, current.Ticker)
+	if err != nil {
+		return coin.Coin{}, err
+	}
+	return clampFee(fee, conf), nil
+}
+
+// clampFee returns given fee limited by the configured minimal fee and the
+// fee market max fee.
+func clampFee(fee coin.Coin, conf Configuration) coin.Coin {
+	if !fee.IsGTE(conf.MinimalFee) {
+		return conf.MinimalFee
+	}
+	if max := conf.FeeMarket.MaxFee; !max.IsZero() && max.SameType(fee) && !max.IsGTE(fee) {
+		return max
+	}
+	return fee
+}
+
+func toUnits(c coin.Coin) *big.Int {
+	units := new(big.Int).Mul(big.NewInt(c.Whole), big.NewInt(coin.FracUnit))
+	return units.Add(units, big.NewInt(c.Fractional))
+}
+
+func fromUnits(units *big.Int, ticker string) (coin.Coin, error) {
+	whole, frac := new(big.Int).QuoRem(units, big.NewInt(coin.FracUnit), new(big.Int))
+	if !whole.IsInt64() {
+		return coin.Coin{}, errors.Wrap(errors.ErrOverflow, "fee")
+	}
+	return coin.NewCoin(whole.Int64(), frac.Int64(), ticker), nil
+}
+
+// FeeMarketQuery allows querying the current minimal fee.
+type FeeMarketQuery struct{}
+
+var _ weave.QueryHandler = FeeMarketQuery{}
+
+// Query returns a single model with an empty key and a serialized
+// FeeMarketState as the value. When the fee market is not enabled, the
+// returned state contains the configured minimal fee.
+func (FeeMarketQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	var conf Configuration
+	if err := gconf.Load(db, "cash", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	var state FeeMarketState
+	if conf.FeeMarket != nil {
+		stored, err := loadFeeMarketState(db)
+		if err != nil {
+			return nil, err
+		}
+		if stored != nil {
+			state.Height = stored.Height
+		}
+	}
+	fee, err := currentMinimalFee(db, conf)
+	if err != nil {
+		return nil, err
+	}
+	state.MinimalFee = fee
+
+	raw, err := state.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal fee market state")
+	}
+	return []weave.Model{weave.Pair([]byte(""), raw)}, nil
+}
@@ -0,0 +1,284 @@
+package cash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestFeeMarketEndBlock(t *testing.T) {
+	cases := map[string]struct {
+		MinimalFee coin.Coin
+		FeeMarket  *FeeMarket
+		// Gas used by each of the consecutive blocks.
+		BlocksGasUsed []int64
+		WantFee       coin.Coin
+	}{
+		"fee market disabled": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			BlocksGasUsed: []int64{1000, 1000},
+			WantFee:       coin.NewCoin(1, 0, "IOV"),
+		},
+		"block consuming the target gas does not change the fee": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100},
+			BlocksGasUsed: []int64{100, 100},
+			WantFee:       coin.NewCoin(1, 0, "IOV"),
+		},
+		"full blocks increase the fee": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+			BlocksGasUsed: []int64{200, 200},
+			WantFee:       coin.NewCoin(2, 250000000, "IOV"),
+		},
+		"default adjustment denominator": {
+			MinimalFee:    coin.NewCoin(8, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100},
+			BlocksGasUsed: []int64{200},
+			WantFee:       coin.NewCoin(9, 0, "IOV"),
+		},
+		"fee change is limited for overfull blocks": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+			BlocksGasUsed: []int64{100000},
+			WantFee:       coin.NewCoin(1, 500000000, "IOV"),
+		},
+		"empty blocks decrease the fee": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+			BlocksGasUsed: []int64{200, 200, 0},
+			WantFee:       coin.NewCoin(1, 125000000, "IOV"),
+		},
+		"fee is never lower than the minimal fee": {
+			MinimalFee:    coin.NewCoin(1, 0, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+			BlocksGasUsed: []int64{200, 0, 0, 0},
+			WantFee:       coin.NewCoin(1, 0, "IOV"),
+		},
+		"fee is never higher than the max fee": {
+			MinimalFee: coin.NewCoin(1, 0, "IOV"),
+			FeeMarket: &FeeMarket{
+				TargetBlockGas:        100,
+				AdjustmentDenominator: 2,
+				MaxFee:                coin.NewCoin(2, 0, "IOV"),
+			},
+			BlocksGasUsed: []int64{200, 200, 200},
+			WantFee:       coin.NewCoin(2, 0, "IOV"),
+		},
+		"fee too small to change proportionally is increased": {
+			MinimalFee:    coin.NewCoin(0, 1, "IOV"),
+			FeeMarket:     &FeeMarket{TargetBlockGas: 100},
+			BlocksGasUsed: []int64{101},
+			WantFee:       coin.NewCoin(0, 2, "IOV"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			conf := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinimalFee:       tc.MinimalFee,
+				FeeMarket:        tc.FeeMarket,
+			}
+			if err := gconf.Save(db, "cash", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			eb := NewFeeMarketEndBlocker()
+			for i, gas := range tc.BlocksGasUsed {
+				ctx := weave.WithHeight(context.Background(), int64(i+1))
+				eb.EndBlock(ctx, db, weave.BlockSummary{GasUsed: gas})
+			}
+
+			fee, err := CurrentMinimalFee(db)
+			if err != nil {
+				t.Fatalf("cannot get current minimal fee: %s", err)
+			}
+			assert.Equal(t, tc.WantFee, fee)
+		})
+	}
+}
+
+func TestFeeMarketDisabledAfterUse(t *testing.T) {
+	db := store.MemStore()
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		MinimalFee:       coin.NewCoin(1, 0, "IOV"),
+		FeeMarket:        &FeeMarket{TargetBlockGas: 100},
+	}
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	ctx := weave.WithHeight(context.Background(), 1)
+	NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{GasUsed: 200})
+
+	conf.FeeMarket = nil
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	fee, err := CurrentMinimalFee(db)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(1, 0, "IOV"), fee)
+
+	// Fee market state must be removed, so that enabling it again
+	// starts from the configured minimal fee.
+	NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{})
+	conf.FeeMarket = &FeeMarket{TargetBlockGas: 100}
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	fee, err = CurrentMinimalFee(db)
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(1, 0, "IOV"), fee)
+}
+
+func TestScaleFee(t *testing.T) {
+	db := store.MemStore()
+
+	// Without the configuration the fee is not changed.
+	fee, err := ScaleFee(db, coin.NewCoin(3, 0, "IOV"))
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(3, 0, "IOV"), fee)
+
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		MinimalFee:       coin.NewCoin(2, 0, "IOV"),
+		FeeMarket:        &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 4},
+	}
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	ctx := weave.WithHeight(context.Background(), 1)
+	NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{GasUsed: 200})
+
+	fee, err = ScaleFee(db, coin.NewCoin(3, 0, "IOV"))
+	assert.Nil(t, err)
+	assert.Equal(t, coin.NewCoin(3, 750000000, "IOV"), fee)
+
+	fee, err = ScaleFee(db, coin.Coin{})
+	assert.Nil(t, err)
+	assert.Equal(t, coin.Coin{}, fee)
+}
+
+func TestFeeMarketQuery(t *testing.T) {
+	db := store.MemStore()
+	conf := Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		MinimalFee:       coin.NewCoin(1, 0, "IOV"),
+		FeeMarket:        &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+	}
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	query := func() FeeMarketState {
+		t.Helper()
+		models, err := FeeMarketQuery{}.Query(db, weave.KeyQueryMod, nil)
+		if err != nil {
+			t.Fatalf("cannot query: %s", err)
+		}
+		if len(models) != 1 {
+			t.Fatalf("want one model, got %d", len(models))
+		}
+		var state FeeMarketState
+		if err := state.Unmarshal(models[0].Value); err != nil {
+			t.Fatalf("cannot unmarshal state: %s", err)
+		}
+		return state
+	}
+
+	assert.Equal(t, FeeMarketState{MinimalFee: coin.NewCoin(1, 0, "IOV")}, query())
+
+	ctx := weave.WithHeight(context.Background(), 7)
+	NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{GasUsed: 200})
+
+	want := FeeMarketState{
+		MinimalFee: coin.NewCoin(1, 500000000, "IOV"),
+		Height:     7,
+	}
+	assert.Equal(t, want, query())
+}
+
+func TestDynamicFeeDecoratorFeeMarket(t *testing.T) {
+	payer := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		txFee        coin.Coin
+		handler      *weavetest.Handler
+		wantCheckErr *errors.Error
+		wantCharged  coin.Coin
+	}{
+		"fee lower than the adjusted minimal fee is rejected": {
+			txFee:        coin.NewCoin(1, 0, "IOV"),
+			handler:      &weavetest.Handler{},
+			wantCheckErr: errors.ErrAmount,
+		},
+		"fee equal to the adjusted minimal fee is accepted": {
+			txFee:       coin.NewCoin(1, 500000000, "IOV"),
+			handler:     &weavetest.Handler{},
+			wantCharged: coin.NewCoin(1, 500000000, "IOV"),
+		},
+		"on a handler failure the adjusted minimal fee is charged": {
+			txFee:        coin.NewCoin(5, 0, "IOV"),
+			handler:      &weavetest.Handler{CheckErr: ErrTestingError},
+			wantCheckErr: ErrTestingError,
+			wantCharged:  coin.NewCoin(1, 500000000, "IOV"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+			conf := Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinimalFee:       coin.NewCoin(1, 0, "IOV"),
+				FeeMarket:        &FeeMarket{TargetBlockGas: 100, AdjustmentDenominator: 2},
+			}
+			if err := gconf.Save(db, "cash", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			wallet, err := WalletWith(payer.Address(), coin.NewCoinp(10, 0, "IOV"))
+			if err != nil {
+				t.Fatalf("cannot create a wallet: %s", err)
+			}
+			ensureWallets(t, db, []orm.Object{wallet})
+
+			ctx := weave.WithHeight(context.Background(), 1)
+			NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{GasUsed: 200})
+
+			ctrl := NewController(NewBucket())
+			auth := &weavetest.Auth{Signer: payer}
+			h := NewDynamicFeeDecorator(auth, ctrl)
+			tx := &txMock{info: &FeeInfo{Payer: payer.Address(), Fees: &tc.txFee}}
+
+			if _, err := h.Check(ctx, db, tx, tc.handler); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			balance, err := ctrl.Balance(db, conf.CollectorAddress)
+			if tc.wantCharged.IsZero() {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want no fee charged, got %v, %v", balance, err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, coin.Coins{&tc.wantCharged}, balance)
+		})
+	}
+}
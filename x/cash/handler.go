@@ -17,9 +17,11 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterQuery will register this bucket as "/wallets" and the fee market
+// query as "/feemarket"
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("wallets", qr)
+	qr.Register("/feemarket", FeeMarketQuery{})
 }
 
 // SendHandler will handle sending coins
@@ -1,9 +1,12 @@
 package cash
 
 import (
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // GenesisAccount is used to parse the json from genesis file
@@ -47,3 +50,31 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+var _ weave.Exporter = Initializer{}
+
+// ExportGenesis will write all account balances and the configuration to
+// opts, in the format expected by FromGenesis.
+func (Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	accts := []GenesisAccount{}
+	err := orm.ForEach(db, NewBucket(), func(obj orm.Object) error {
+		accts = append(accts, GenesisAccount{
+			Address: weave.Address(obj.Key()),
+			Set:     Set{Coins: AsCoins(obj)},
+		})
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "export wallets")
+	}
+	raw, err := json.Marshal(accts)
+	if err != nil {
+		return errors.Wrap(err, "marshal accounts")
+	}
+	opts["cash"] = raw
+
+	if err := gconf.ExportConfig(db, opts, "cash", &Configuration{}); err != nil {
+		return errors.Wrap(err, "export config")
+	}
+	return nil
+}
@@ -124,6 +124,9 @@ func (m *UpdateConfigurationMsg) Validate() error {
 			errs = errors.Append(errs, errors.Field("MinimalFee", errors.ErrState, "cannot be negative"))
 		}
 	}
+	if c.FeeMarket != nil {
+		errs = errors.AppendField(errs, "FeeMarket", c.FeeMarket.Validate())
+	}
 	return errs
 }
 
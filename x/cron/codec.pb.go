@@ -41,6 +41,12 @@ type TaskResult struct {
 	ExecTime github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=exec_time,json=execTime,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"exec_time,omitempty"`
 	// Exec height holds the block height value at the time the task was executed.
 	ExecHeight int64 `protobuf:"varint,5,opt,name=exec_height,json=execHeight,proto3" json:"exec_height,omitempty"`
+	// Series ID references the task series that this execution belongs to. It
+	// is empty for tasks that are not recurring.
+	SeriesID []byte `protobuf:"bytes,6,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Run is the sequence number of this execution within the series, starting
+	// with 1. It is zero for tasks that are not recurring.
+	Run uint32 `protobuf:"varint,7,opt,name=run,proto3" json:"run,omitempty"`
 }
 
 func (m *TaskResult) Reset()         { *m = TaskResult{} }
@@ -111,31 +117,520 @@ func (m *TaskResult) GetExecHeight() int64 {
 	return 0
 }
 
+func (m *TaskResult) GetSeriesID() []byte {
+	if m != nil {
+		return m.SeriesID
+	}
+	return nil
+}
+
+func (m *TaskResult) GetRun() uint32 {
+	if m != nil {
+		return m.Run
+	}
+	return 0
+}
+
+// Recurrence declares how often a recurring task is executed. Exactly one of
+// interval or months must be set.
+type Recurrence struct {
+	// Interval is the fixed amount of time between two consecutive executions.
+	Interval github_com_iov_one_weave.UnixDuration `protobuf:"varint,1,opt,name=interval,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"interval,omitempty"`
+	// Months is the number of calendar months between two consecutive
+	// executions. Each execution happens on the same day of the month and at
+	// the same time as the first one. Days that do not exist in a given month
+	// are replaced with the last day of that month, for example 31 April is
+	// 30 April.
+	Months uint32 `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	// Max runs is the maximum number of executions. Zero means no limit.
+	MaxRuns uint32 `protobuf:"varint,3,opt,name=max_runs,json=maxRuns,proto3" json:"max_runs,omitempty"`
+}
+
+func (m *Recurrence) Reset()         { *m = Recurrence{} }
+func (m *Recurrence) String() string { return proto.CompactTextString(m) }
+func (*Recurrence) ProtoMessage()    {}
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{1}
+}
+func (m *Recurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recurrence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recurrence.Merge(m, src)
+}
+func (m *Recurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recurrence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recurrence proto.InternalMessageInfo
+
+func (m *Recurrence) GetInterval() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *Recurrence) GetMonths() uint32 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+func (m *Recurrence) GetMaxRuns() uint32 {
+	if m != nil {
+		return m.MaxRuns
+	}
+	return 0
+}
+
+// TaskSeries represents a recurring task. After each execution, the task is
+// scheduled again for the next occurrence, until the maximum number of runs
+// is reached or the series is cancelled.
+type TaskSeries struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Recurrence Recurrence      `protobuf:"bytes,2,opt,name=recurrence,proto3" json:"recurrence"`
+	// Task ID is the ID of the queued task that is the next execution of this
+	// series.
+	TaskID []byte `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// First run at is the time of the first execution. All occurrences are
+	// computed relative to it.
+	FirstRunAt github_com_iov_one_weave.UnixTime `protobuf:"varint,4,opt,name=first_run_at,json=firstRunAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"first_run_at,omitempty"`
+	// Occurrence is the index of the occurrence that the queued task is
+	// scheduled for, starting with 0 for the first run. Occurrences that were
+	// missed are skipped, so this value can be greater than runs.
+	Occurrence uint32 `protobuf:"varint,5,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+	// Runs is the number of executions done so far.
+	Runs uint32 `protobuf:"varint,6,opt,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (m *TaskSeries) Reset()         { *m = TaskSeries{} }
+func (m *TaskSeries) String() string { return proto.CompactTextString(m) }
+func (*TaskSeries) ProtoMessage()    {}
+func (*TaskSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{2}
+}
+func (m *TaskSeries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSeries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSeries.Merge(m, src)
+}
+func (m *TaskSeries) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSeries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskSeries proto.InternalMessageInfo
+
+func (m *TaskSeries) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *TaskSeries) GetRecurrence() Recurrence {
+	if m != nil {
+		return m.Recurrence
+	}
+	return Recurrence{}
+}
+
+func (m *TaskSeries) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+func (m *TaskSeries) GetFirstRunAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.FirstRunAt
+	}
+	return 0
+}
+
+func (m *TaskSeries) GetOccurrence() uint32 {
+	if m != nil {
+		return m.Occurrence
+	}
+	return 0
+}
+
+func (m *TaskSeries) GetRuns() uint32 {
+	if m != nil {
+		return m.Runs
+	}
+	return 0
+}
+
+// PendingTask is a publicly available information about a task that is queued
+// for execution. It is not stored in the database and is computed from the
+// queued task each time it is queried.
+type PendingTask struct {
+	// Run at is the time after which the task is executed.
+	RunAt github_com_iov_one_weave.UnixTime `protobuf:"varint,1,opt,name=run_at,json=runAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"run_at,omitempty"`
+	// Msg path is the path of the message that is executed by the task.
+	MsgPath string `protobuf:"bytes,2,opt,name=msg_path,json=msgPath,proto3" json:"msg_path,omitempty"`
+	// Auth contains the conditions that authenticate the task execution.
+	Auth []github_com_iov_one_weave.Condition `protobuf:"bytes,3,rep,name=auth,proto3,casttype=github.com/iov-one/weave.Condition" json:"auth,omitempty"`
+	// Series ID references the task series that this task belongs to. It is
+	// empty for tasks that are not recurring.
+	SeriesID []byte `protobuf:"bytes,4,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	// Decode error is set if the task cannot be decoded. Msg path and auth
+	// are empty in that case.
+	DecodeError string `protobuf:"bytes,5,opt,name=decode_error,json=decodeError,proto3" json:"decode_error,omitempty"`
+}
+
+func (m *PendingTask) Reset()         { *m = PendingTask{} }
+func (m *PendingTask) String() string { return proto.CompactTextString(m) }
+func (*PendingTask) ProtoMessage()    {}
+func (*PendingTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{3}
+}
+func (m *PendingTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTask.Merge(m, src)
+}
+func (m *PendingTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTask proto.InternalMessageInfo
+
+func (m *PendingTask) GetRunAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.RunAt
+	}
+	return 0
+}
+
+func (m *PendingTask) GetMsgPath() string {
+	if m != nil {
+		return m.MsgPath
+	}
+	return ""
+}
+
+func (m *PendingTask) GetAuth() []github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Auth
+	}
+	return nil
+}
+
+func (m *PendingTask) GetSeriesID() []byte {
+	if m != nil {
+		return m.SeriesID
+	}
+	return nil
+}
+
+func (m *PendingTask) GetDecodeError() string {
+	if m != nil {
+		return m.DecodeError
+	}
+	return ""
+}
+
+// CancelTaskMsg removes a queued task, so that it is never executed. If the
+// task belongs to a series, the whole series is cancelled.
+//
+// A task can be cancelled by the configuration owner or by the signers of all
+// conditions that authenticate the task execution.
+type CancelTaskMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	TaskID   []byte          `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *CancelTaskMsg) Reset()         { *m = CancelTaskMsg{} }
+func (m *CancelTaskMsg) String() string { return proto.CompactTextString(m) }
+func (*CancelTaskMsg) ProtoMessage()    {}
+func (*CancelTaskMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{4}
+}
+func (m *CancelTaskMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelTaskMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelTaskMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelTaskMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTaskMsg.Merge(m, src)
+}
+func (m *CancelTaskMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelTaskMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTaskMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelTaskMsg proto.InternalMessageInfo
+
+func (m *CancelTaskMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CancelTaskMsg) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+type Configuration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	// This defines the Address that is allowed to cancel any queued task. To
+	// allow cancelling tasks via on-chain governance, use the address of an
+	// election rule.
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Max tasks per block is the maximum number of tasks executed in a single
+	// block. Tasks that are due but were not executed because of this limit
+	// are executed in the following blocks, in order of their execution time.
+	// Zero means the default limit of 50 tasks.
+	MaxTasksPerBlock uint32 `protobuf:"varint,3,opt,name=max_tasks_per_block,json=maxTasksPerBlock,proto3" json:"max_tasks_per_block,omitempty"`
+	// Max gas per block is the amount of gas that tasks executed in a single
+	// block can consume. Once it is used up, no more tasks are executed in
+	// that block. A task that is already running is never interrupted, so the
+	// last executed task can exceed this value. Zero means no limit.
+	MaxGasPerBlock int64 `protobuf:"varint,4,opt,name=max_gas_per_block,json=maxGasPerBlock,proto3" json:"max_gas_per_block,omitempty"`
+}
+
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{5}
+}
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Configuration) GetMaxTasksPerBlock() uint32 {
+	if m != nil {
+		return m.MaxTasksPerBlock
+	}
+	return 0
+}
+
+func (m *Configuration) GetMaxGasPerBlock() int64 {
+	if m != nil {
+		return m.MaxGasPerBlock
+	}
+	return 0
+}
+
+// UpdateConfigurationMsg is used by the gconf extension to update the
+// configuration. Only the fields that are set in the patch are changed.
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed99bc993a5d5798, []int{6}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TaskResult)(nil), "cron.TaskResult")
+	proto.RegisterType((*Recurrence)(nil), "cron.Recurrence")
+	proto.RegisterType((*TaskSeries)(nil), "cron.TaskSeries")
+	proto.RegisterType((*PendingTask)(nil), "cron.PendingTask")
+	proto.RegisterType((*CancelTaskMsg)(nil), "cron.CancelTaskMsg")
+	proto.RegisterType((*Configuration)(nil), "cron.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "cron.UpdateConfigurationMsg")
 }
 
 func init() { proto.RegisterFile("x/cron/codec.proto", fileDescriptor_ed99bc993a5d5798) }
 
 var fileDescriptor_ed99bc993a5d5798 = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xcf, 0x41, 0x4b, 0xfb, 0x30,
-	0x18, 0xc7, 0xf1, 0xe6, 0xbf, 0xfe, 0xa5, 0x7b, 0x7a, 0x10, 0x82, 0x87, 0xb0, 0x43, 0x56, 0x05,
-	0xa1, 0x20, 0xb6, 0xa0, 0xef, 0xa0, 0x27, 0x2f, 0x5e, 0xc2, 0x3c, 0x4b, 0x97, 0x3d, 0x6b, 0x83,
-	0x6b, 0x1f, 0x59, 0xd3, 0xd9, 0x97, 0xe1, 0xcb, 0xf2, 0x38, 0x3c, 0x79, 0x12, 0x69, 0xdf, 0x85,
-	0x27, 0x49, 0x27, 0xb2, 0xdb, 0x97, 0x0f, 0xc9, 0x2f, 0x04, 0x78, 0x97, 0xea, 0x2d, 0xd5, 0xa9,
-	0xa6, 0x15, 0xea, 0xe4, 0x79, 0x4b, 0x96, 0xb8, 0xef, 0x64, 0x16, 0x1e, 0xd1, 0xec, 0xac, 0xa0,
-	0x82, 0xc6, 0x4c, 0x5d, 0x1d, 0xf4, 0xe2, 0x9d, 0x01, 0x2c, 0xf2, 0xe6, 0x49, 0x61, 0xd3, 0x6e,
-	0x2c, 0xbf, 0x82, 0xa0, 0x42, 0x9b, 0xaf, 0x72, 0x9b, 0x0b, 0x16, 0xb1, 0x38, 0xbc, 0x39, 0x4d,
-	0x5e, 0x30, 0xdf, 0x61, 0x72, 0xff, 0xcb, 0xea, 0xef, 0x00, 0x97, 0x00, 0x4d, 0xab, 0x35, 0x36,
-	0xcd, 0xba, 0xdd, 0x88, 0x7f, 0x11, 0x8b, 0x03, 0x75, 0x24, 0x9c, 0x83, 0x6f, 0xea, 0x35, 0x89,
-	0x49, 0xc4, 0xe2, 0xa9, 0x1a, 0x9b, 0x67, 0x30, 0xc5, 0x0e, 0xf5, 0xa3, 0x35, 0x15, 0x0a, 0x3f,
-	0x62, 0xf1, 0x24, 0xbb, 0xfc, 0xfe, 0x9c, 0x9f, 0x17, 0xc6, 0x96, 0xed, 0x32, 0xd1, 0x54, 0xa5,
-	0x86, 0x76, 0xd7, 0x54, 0x63, 0x7a, 0x78, 0xf7, 0xa1, 0x36, 0xdd, 0xc2, 0x54, 0xa8, 0x02, 0x77,
-	0xcf, 0x15, 0x9f, 0x43, 0x38, 0x6e, 0x94, 0x68, 0x8a, 0xd2, 0x8a, 0xff, 0x6e, 0x45, 0x81, 0xa3,
-	0xbb, 0x51, 0x32, 0xf1, 0xd6, 0x4b, 0xb6, 0xef, 0x25, 0xfb, 0xea, 0x25, 0x7b, 0x1d, 0xa4, 0xb7,
-	0x1f, 0xa4, 0xf7, 0x31, 0x48, 0x6f, 0x79, 0x32, 0xfe, 0xfa, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0x03, 0x46, 0xbc, 0x34, 0x01, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0xfb, 0x46,
+	0x10, 0x8d, 0xc9, 0xff, 0x49, 0xd2, 0xd2, 0xfd, 0x55, 0x3f, 0xb9, 0x1c, 0x62, 0xe3, 0x96, 0x2a,
+	0x51, 0x45, 0x22, 0x51, 0xa9, 0x07, 0xd4, 0x0b, 0x06, 0x44, 0x73, 0x40, 0x42, 0x5b, 0x38, 0x5b,
+	0x8b, 0xbd, 0xb1, 0x2d, 0xe2, 0xdd, 0x68, 0x77, 0x0d, 0xf9, 0x04, 0xbd, 0xb6, 0x52, 0xbf, 0x14,
+	0x47, 0xd4, 0x53, 0x4f, 0x51, 0x15, 0xbe, 0x40, 0xcf, 0x39, 0x55, 0xbb, 0xb6, 0xd2, 0x70, 0x00,
+	0x29, 0xb7, 0xf1, 0xdb, 0x99, 0xdd, 0x37, 0x6f, 0xde, 0x18, 0xd0, 0x62, 0x1c, 0x0a, 0xce, 0xc6,
+	0x21, 0x8f, 0x68, 0x38, 0x9a, 0x0b, 0xae, 0x38, 0xaa, 0x69, 0xe4, 0xa0, 0xb3, 0x05, 0x1d, 0x7c,
+	0x1d, 0xf3, 0x98, 0x9b, 0x70, 0xac, 0xa3, 0x02, 0xf5, 0x7e, 0xdf, 0x03, 0xb8, 0x25, 0xf2, 0x01,
+	0x53, 0x99, 0xcf, 0x14, 0xfa, 0x01, 0x5a, 0x19, 0x55, 0x24, 0x22, 0x8a, 0xd8, 0x96, 0x6b, 0x0d,
+	0x3a, 0x27, 0x5f, 0x8e, 0x9e, 0x28, 0x79, 0xa4, 0xa3, 0xeb, 0x12, 0xc6, 0x9b, 0x04, 0xd4, 0x07,
+	0x90, 0x79, 0x18, 0x52, 0x29, 0xa7, 0xf9, 0xcc, 0xde, 0x73, 0xad, 0x41, 0x0b, 0x6f, 0x21, 0x08,
+	0x41, 0x2d, 0x65, 0x53, 0x6e, 0x57, 0x5d, 0x6b, 0xd0, 0xc6, 0x26, 0x46, 0x3e, 0xb4, 0xe9, 0x82,
+	0x86, 0x81, 0x4a, 0x33, 0x6a, 0xd7, 0x5c, 0x6b, 0x50, 0xf5, 0x8f, 0xd6, 0x4b, 0xe7, 0x30, 0x4e,
+	0x55, 0x92, 0xdf, 0x8f, 0x42, 0x9e, 0x8d, 0x53, 0xfe, 0x78, 0xcc, 0x19, 0x1d, 0x17, 0xef, 0xde,
+	0xb1, 0x74, 0x71, 0x9b, 0x66, 0x14, 0xb7, 0x74, 0x9d, 0x8e, 0x90, 0x03, 0x1d, 0x73, 0x47, 0x42,
+	0xd3, 0x38, 0x51, 0x76, 0x5d, 0xdf, 0x82, 0x41, 0x43, 0xbf, 0x18, 0x04, 0x0d, 0xa1, 0x2d, 0xa9,
+	0x48, 0xa9, 0x0c, 0xd2, 0xc8, 0x6e, 0xb8, 0xd6, 0xa0, 0xeb, 0x77, 0x57, 0x4b, 0xa7, 0xf5, 0xab,
+	0x01, 0x27, 0x17, 0xb8, 0x55, 0x1c, 0x4f, 0x22, 0xb4, 0x0f, 0x55, 0x91, 0x33, 0xbb, 0xe9, 0x5a,
+	0x83, 0x1e, 0xd6, 0xa1, 0xf7, 0x9b, 0x05, 0x80, 0x69, 0x98, 0x0b, 0x41, 0x59, 0x48, 0xd1, 0x25,
+	0xb4, 0x52, 0xa6, 0xa8, 0x78, 0x24, 0x33, 0xa3, 0x48, 0xdd, 0x1f, 0xae, 0x97, 0xce, 0xd1, 0x87,
+	0x7c, 0x2f, 0x72, 0x41, 0x54, 0xca, 0x19, 0xde, 0x94, 0xa2, 0xcf, 0xd0, 0xc8, 0x38, 0x53, 0x89,
+	0x34, 0x3a, 0xf5, 0x70, 0xf9, 0x85, 0xbe, 0x81, 0x56, 0x46, 0x16, 0x81, 0xc8, 0x99, 0x34, 0x3a,
+	0xf5, 0x70, 0x33, 0x23, 0x0b, 0x9c, 0x33, 0xe9, 0xfd, 0x59, 0x8e, 0xa6, 0x60, 0xbd, 0xdb, 0x68,
+	0x7e, 0x02, 0x10, 0x9b, 0x1e, 0xcc, 0x93, 0x9d, 0x93, 0xfd, 0x91, 0x36, 0xc5, 0xe8, 0xff, 0xde,
+	0xfc, 0xda, 0xf3, 0xd2, 0xa9, 0xe0, 0xad, 0x4c, 0xf4, 0x2d, 0x34, 0x15, 0x91, 0x0f, 0x5a, 0xb7,
+	0xaa, 0xd1, 0x0d, 0x56, 0x4b, 0xa7, 0xa1, 0x59, 0x4c, 0x2e, 0x70, 0x43, 0x1f, 0x4d, 0x22, 0x74,
+	0x05, 0xdd, 0x69, 0x2a, 0xa4, 0xd2, 0xac, 0x03, 0xa2, 0x76, 0x1b, 0x23, 0x98, 0x52, 0x9c, 0xb3,
+	0x33, 0xa5, 0x0d, 0xc4, 0xc3, 0x0d, 0xcb, 0xba, 0x69, 0x7f, 0x0b, 0xd1, 0x06, 0x32, 0xc2, 0x34,
+	0xcc, 0x89, 0x89, 0xbd, 0x7f, 0x2d, 0xe8, 0xdc, 0x50, 0x16, 0xa5, 0x2c, 0xd6, 0xb4, 0xd0, 0xcf,
+	0xd0, 0x28, 0x69, 0x58, 0xbb, 0xd0, 0xa8, 0x0b, 0xc3, 0x40, 0xcb, 0x2f, 0xe3, 0x60, 0x4e, 0x54,
+	0x62, 0x54, 0x6a, 0xe3, 0x66, 0x26, 0xe3, 0x1b, 0xa2, 0x12, 0x74, 0x0a, 0x35, 0x92, 0xab, 0xc4,
+	0xae, 0xba, 0xd5, 0x41, 0xd7, 0xff, 0x7e, 0xbd, 0x74, 0xbc, 0x77, 0xaf, 0x3d, 0xe7, 0x2c, 0x4a,
+	0xcd, 0xc4, 0x4d, 0xcd, 0x5b, 0x03, 0xd6, 0x3e, 0x34, 0xe0, 0x21, 0x74, 0x23, 0xaa, 0xf7, 0x34,
+	0xa0, 0x42, 0x70, 0x61, 0x54, 0x68, 0xe3, 0x4e, 0x81, 0x5d, 0x6a, 0xc8, 0x23, 0xd0, 0x3b, 0x27,
+	0x2c, 0xa4, 0x33, 0xdd, 0xf0, 0xb5, 0x8c, 0x77, 0xb3, 0xc2, 0xd6, 0x48, 0xf7, 0xde, 0x1b, 0xa9,
+	0xf7, 0x97, 0x05, 0xbd, 0x73, 0xce, 0xa6, 0x69, 0x5c, 0x5a, 0x77, 0xb7, 0x37, 0x4e, 0xa1, 0xce,
+	0x9f, 0x18, 0x15, 0xe5, 0x0b, 0xdf, 0xad, 0x97, 0x8e, 0xfb, 0xae, 0x58, 0x67, 0x51, 0x24, 0xa8,
+	0x94, 0xb8, 0x28, 0x41, 0xc7, 0xf0, 0x49, 0x6f, 0x80, 0x26, 0x22, 0x83, 0x39, 0x15, 0xc1, 0xfd,
+	0x8c, 0x87, 0x0f, 0xe5, 0x32, 0xec, 0x67, 0x64, 0xa1, 0xa9, 0xca, 0x1b, 0x2a, 0x7c, 0x8d, 0xa3,
+	0x21, 0x7c, 0xa5, 0xd3, 0x63, 0xb2, 0x9d, 0x6c, 0x1c, 0x88, 0xbf, 0xc8, 0xc8, 0xe2, 0x8a, 0x6c,
+	0x52, 0xbd, 0x39, 0x7c, 0xbe, 0x9b, 0x47, 0x44, 0xd1, 0x37, 0x9d, 0xed, 0x2c, 0xe0, 0x10, 0xea,
+	0x73, 0xa2, 0xc2, 0xa4, 0x5c, 0xa3, 0x4f, 0xc5, 0x1a, 0xbd, 0xb9, 0x13, 0x17, 0x19, 0xbe, 0xfd,
+	0xbc, 0xea, 0x5b, 0x2f, 0xab, 0xbe, 0xf5, 0xcf, 0xaa, 0x6f, 0xfd, 0xf1, 0xda, 0xaf, 0xbc, 0xbc,
+	0xf6, 0x2b, 0x7f, 0xbf, 0xf6, 0x2b, 0xf7, 0x0d, 0xf3, 0xbb, 0xfd, 0xf1, 0xbf, 0x01, 0x00, 0x94,
+	0xb9, 0x12, 0x78, 0xad, 0x05, 0x00, 0x00,
 }
 
 func (m *TaskResult) Marshal() (dAtA []byte, err error) {
@@ -189,9 +684,275 @@ func (m *TaskResult) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ExecHeight))
 	}
-	return i, nil
-}
-
+	if len(m.SeriesID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesID)))
+		i += copy(dAtA[i:], m.SeriesID)
+	}
+	if m.Run != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Run))
+	}
+	return i, nil
+}
+
+func (m *Recurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recurrence) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Interval))
+	}
+	if m.Months != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Months))
+	}
+	if m.MaxRuns != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxRuns))
+	}
+	return i, nil
+}
+
+func (m *TaskSeries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSeries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Recurrence.Size()))
+	n3, err := m.Recurrence.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	if m.FirstRunAt != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FirstRunAt))
+	}
+	if m.Occurrence != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Occurrence))
+	}
+	if m.Runs != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Runs))
+	}
+	return i, nil
+}
+
+func (m *PendingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RunAt != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RunAt))
+	}
+	if len(m.MsgPath) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MsgPath)))
+		i += copy(dAtA[i:], m.MsgPath)
+	}
+	if len(m.Auth) > 0 {
+		for _, b := range m.Auth {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.SeriesID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesID)))
+		i += copy(dAtA[i:], m.SeriesID)
+	}
+	if len(m.DecodeError) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DecodeError)))
+		i += copy(dAtA[i:], m.DecodeError)
+	}
+	return i, nil
+}
+
+func (m *CancelTaskMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
+	}
+	return i, nil
+}
+
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.MaxTasksPerBlock != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxTasksPerBlock))
+	}
+	if m.MaxGasPerBlock != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxGasPerBlock))
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n7, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -224,23 +985,920 @@ func (m *TaskResult) Size() (n int) {
 	if m.ExecHeight != 0 {
 		n += 1 + sovCodec(uint64(m.ExecHeight))
 	}
+	l = len(m.SeriesID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Run != 0 {
+		n += 1 + sovCodec(uint64(m.Run))
+	}
 	return n
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Recurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Interval != 0 {
+		n += 1 + sovCodec(uint64(m.Interval))
+	}
+	if m.Months != 0 {
+		n += 1 + sovCodec(uint64(m.Months))
+	}
+	if m.MaxRuns != 0 {
+		n += 1 + sovCodec(uint64(m.MaxRuns))
+	}
+	return n
+}
+
+func (m *TaskSeries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Recurrence.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.FirstRunAt != 0 {
+		n += 1 + sovCodec(uint64(m.FirstRunAt))
+	}
+	if m.Occurrence != 0 {
+		n += 1 + sovCodec(uint64(m.Occurrence))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovCodec(uint64(m.Runs))
+	}
+	return n
+}
+
+func (m *PendingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunAt != 0 {
+		n += 1 + sovCodec(uint64(m.RunAt))
+	}
+	l = len(m.MsgPath)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Auth) > 0 {
+		for _, b := range m.Auth {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.SeriesID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DecodeError)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MaxTasksPerBlock != 0 {
+		n += 1 + sovCodec(uint64(m.MaxTasksPerBlock))
+	}
+	if m.MaxGasPerBlock != 0 {
+		n += 1 + sovCodec(uint64(m.MaxGasPerBlock))
+	}
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Successful = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
+			}
+			m.ExecTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecHeight", wireType)
+			}
+			m.ExecHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesID = append(m.SeriesID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesID == nil {
+				m.SeriesID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Run", wireType)
+			}
+			m.Run = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Run |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Months", wireType)
+			}
+			m.Months = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Months |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuns", wireType)
+			}
+			m.MaxRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuns |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstRunAt", wireType)
+			}
+			m.FirstRunAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstRunAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrence", wireType)
+			}
+			m.Occurrence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Occurrence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAt", wireType)
+			}
+			m.RunAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auth", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auth = append(m.Auth, make([]byte, postIndex-iNdEx))
+			copy(m.Auth[len(m.Auth)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesID = append(m.SeriesID[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesID == nil {
+				m.SeriesID = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodeError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecodeError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TaskResult) Unmarshal(dAtA []byte) error {
+func (m *CancelTaskMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -263,10 +1921,10 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelTaskMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelTaskMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -306,10 +1964,10 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -319,17 +1977,84 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Successful = bool(v != 0)
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -339,29 +2064,67 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTasksPerBlock", wireType)
 			}
-			m.ExecTime = 0
+			m.MaxTasksPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -371,16 +2134,16 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.MaxTasksPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerBlock", wireType)
 			}
-			m.ExecHeight = 0
+			m.MaxGasPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -390,11 +2153,136 @@ func (m *TaskResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecHeight |= int64(b&0x7F) << shift
+				m.MaxGasPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
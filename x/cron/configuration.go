@@ -0,0 +1,55 @@
+package cron
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+var _ gconf.OwnedConfig = (*Configuration)(nil)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	// Owner is optional. Without an owner, a task can be cancelled only by
+	// the signers of its conditions.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if c.MaxGasPerBlock < 0 {
+		errs = errors.Append(errs, errors.Field("MaxGasPerBlock", errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+// defaultMaxTasksPerBlock is used when the configuration does not declare
+// the maximum number of tasks executed in a single block.
+const defaultMaxTasksPerBlock = 50
+
+// loadConfiguration returns the configuration of this package. Because the
+// configuration is optional, if it does not exist an empty one is returned.
+func loadConfiguration(db weave.ReadOnlyKVStore) (*Configuration, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "cron", &conf); {
+	case err == nil:
+		return &conf, nil
+	case errors.ErrNotFound.Is(err):
+		return &Configuration{}, nil
+	default:
+		return nil, errors.Wrap(err, "load configuration")
+	}
+}
+
+// maxTasksPerBlock returns the maximum number of tasks that can be executed
+// in a single block.
+func (c *Configuration) maxTasksPerBlock() int {
+	if c.MaxTasksPerBlock == 0 {
+		return defaultMaxTasksPerBlock
+	}
+	return int(c.MaxTasksPerBlock)
+}
@@ -0,0 +1,61 @@
+package cron
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestConfigurationValidation(t *testing.T) {
+	cases := map[string]struct {
+		Conf Configuration
+		// Field name to error mapping. Use `nil` if no error is expected.
+		WantErrs map[string]*errors.Error
+	}{
+		"valid configuration": {
+			Conf: Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Owner:            weavetest.NewCondition().Address(),
+				MaxTasksPerBlock: 10,
+				MaxGasPerBlock:   1000,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":       nil,
+				"Owner":          nil,
+				"MaxGasPerBlock": nil,
+			},
+		},
+		"all limits are optional": {
+			Conf: Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":       nil,
+				"Owner":          nil,
+				"MaxGasPerBlock": nil,
+			},
+		},
+		"negative gas limit": {
+			Conf: Configuration{
+				Metadata:       &weave.Metadata{Schema: 1},
+				MaxGasPerBlock: -1,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":       nil,
+				"MaxGasPerBlock": errors.ErrInput,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Conf.Validate()
+			for field, wantErr := range tc.WantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/iov-one/weave"
@@ -31,12 +32,16 @@ type TaskMarshaler interface {
 //
 // Always use the same marshaler for ticker and scheduler.
 func NewScheduler(enc TaskMarshaler) *Scheduler {
-	return &Scheduler{enc: enc}
+	return &Scheduler{
+		enc:    enc,
+		series: NewTaskSeriesBucket(),
+	}
 }
 
 // Scheduler is the weave.Scheduler implementation.
 type Scheduler struct {
-	enc TaskMarshaler
+	enc    TaskMarshaler
+	series orm.ModelBucket
 }
 
 var _ weave.Scheduler = (*Scheduler)(nil)
@@ -50,13 +55,64 @@ var _ weave.Scheduler = (*Scheduler)(nil)
 //
 // Time granularity is second.
 func (s *Scheduler) Schedule(db weave.KVStore, runAt time.Time, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
-	const granularity = time.Second
-	runAt = roundT(runAt, granularity)
-
 	raw, err := s.enc.MarshalTask(auth, msg)
 	if err != nil {
 		return nil, errors.Wrap(err, "marshal task")
 	}
+	return enqueue(db, runAt, raw)
+}
+
+// ScheduleRecurring queues given message in the database to be executed for
+// the first time at given time, and then repeatedly as declared by the
+// recurrence. Each execution is a separate task with its own result.
+// When successful, returns the ID of the created task series, that can be
+// used to cancel all future executions.
+//
+// Occurrences that could not be executed on time, for example because of a
+// long break between blocks, are skipped.
+func (s *Scheduler) ScheduleRecurring(db weave.KVStore, runAt time.Time, rec Recurrence, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if err := rec.Validate(); err != nil {
+		return nil, errors.Wrap(err, "recurrence")
+	}
+	taskID, err := s.Schedule(db, runAt, auth, msg)
+	if err != nil {
+		return nil, err
+	}
+	series := TaskSeries{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Recurrence: rec,
+		TaskID:     taskID,
+		FirstRunAt: weave.AsUnixTime(runAt),
+	}
+	seriesID, err := s.series.Put(db, nil, &series)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot store task series")
+	}
+	return seriesID, nil
+}
+
+// CancelSeries removes the task series together with its queued task, so that
+// no more executions happen. It returns ErrNotFound if series with given ID
+// does not exist.
+func (s *Scheduler) CancelSeries(db weave.KVStore, seriesID []byte) error {
+	var series TaskSeries
+	if err := s.series.One(db, seriesID, &series); err != nil {
+		return errors.Wrap(err, "cannot load task series")
+	}
+	if err := db.Delete(series.TaskID); err != nil {
+		return errors.Wrap(err, "cannot delete task")
+	}
+	if err := s.series.Delete(db, seriesID); err != nil {
+		return errors.Wrap(err, "cannot delete task series")
+	}
+	return nil
+}
+
+// enqueue stores given serialized task in the queue, to be executed not
+// earlier than at given time. It returns the ID of the queued task.
+func enqueue(db weave.KVStore, runAt time.Time, raw []byte) ([]byte, error) {
+	const granularity = time.Second
+	runAt = roundT(runAt, granularity)
 
 	// We use execution time as the queue value and to keep it unique, we
 	// increment the execution time if a taks is already scheduled at given
@@ -100,6 +156,9 @@ func roundT(t time.Time, granularity time.Duration) time.Time {
 	return rounded
 }
 
+// queuePrefix is the prefix of all task queue keys.
+var queuePrefix = []byte("_crontask:runat:")
+
 func queueKey(t time.Time) []byte {
 	rawTime := make([]byte, 8)
 	// Zero time does not need to put any data as the bytes are already set
@@ -107,10 +166,23 @@ func queueKey(t time.Time) []byte {
 	if !t.IsZero() {
 		binary.BigEndian.PutUint64(rawTime, uint64(t.UnixNano()))
 	}
-	return append([]byte("_crontask:runat:"), rawTime...)
+	key := make([]byte, 0, len(queuePrefix)+len(rawTime))
+	key = append(key, queuePrefix...)
+	return append(key, rawTime...)
+}
+
+// queueKeyTime returns the execution time encoded in given task queue key.
+func queueKeyTime(key []byte) (time.Time, error) {
+	if !isQueueKey(key) {
+		return time.Time{}, errors.Wrap(errors.ErrInput, "not a task queue key")
+	}
+	nano := binary.BigEndian.Uint64(key[len(queuePrefix):])
+	return time.Unix(0, int64(nano)).UTC(), nil
 }
 
 // Delete implements weave.Scheduler interface.
+//
+// If the task belongs to a series, the whole series is cancelled.
 func (s *Scheduler) Delete(db weave.KVStore, taskID []byte) error {
 	if ok, err := db.Has(taskID); err != nil {
 		return errors.Wrap(err, "failed to check existence of key")
@@ -120,9 +192,39 @@ func (s *Scheduler) Delete(db weave.KVStore, taskID []byte) error {
 	if err := db.Delete(taskID); err != nil {
 		return errors.Wrap(err, "cannot delete")
 	}
+	var series []*TaskSeries
+	keys, err := s.series.ByIndex(db, "task", taskID, &series)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "cannot find task series")
+	}
+	for _, key := range keys {
+		if err := s.series.Delete(db, key); err != nil {
+			return errors.Wrap(err, "cannot delete task series")
+		}
+	}
 	return nil
 }
 
+// DeleteTask removes the task with given ID using provided scheduler. Unlike
+// Scheduler.Delete, it does not fail if the task was already executed or
+// removed, or if the ID is empty. This is useful for extensions that store
+// the ID of a task that might be already gone, for example an automatic
+// return that is no longer needed.
+func DeleteTask(db weave.KVStore, scheduler weave.Scheduler, taskID []byte) error {
+	if len(taskID) == 0 {
+		return nil
+	}
+	switch err := scheduler.Delete(db, taskID); {
+	case err == nil:
+		return nil
+	case errors.ErrNotFound.Is(err):
+		// We want the task to not exist and this is true.
+		return nil
+	default:
+		return errors.Wrap(err, "cannot delete task")
+	}
+}
+
 // NewTicker returns a cron runner instance that is using given handler to
 // process all queued messages that execution time is due. All serialization is
 // done using provided marshaler.
@@ -133,6 +235,8 @@ func NewTicker(h weave.Handler, enc TaskMarshaler) *Ticker {
 		hn:      h,
 		enc:     enc,
 		results: NewTaskResultBucket(),
+		series:  NewTaskSeriesBucket(),
+		gas:     store.DefaultGasRules,
 	}
 }
 
@@ -142,10 +246,24 @@ type Ticker struct {
 	hn      weave.Handler
 	enc     TaskMarshaler
 	results orm.ModelBucket
+	series  orm.ModelBucket
+	gas     store.GasRulesLoader
 }
 
 var _ weave.Ticker = (*Ticker)(nil)
 
+// WithGasRules sets the loader of the gas rules. Only the costs of store
+// operations are used, to count the gas consumed by the executed tasks. Gas
+// is counted only if the configuration declares the maximum amount of gas
+// that can be used in a single block.
+//
+// Gas rules must be loaded from the state, so that all nodes execute the
+// same number of tasks in a block.
+func (t *Ticker) WithGasRules(load store.GasRulesLoader) *Ticker {
+	t.gas = load
+	return t
+}
+
 // Tick implementes weave.Ticker interface.
 //
 // Tick can process any number of messages suitable for execution. All changes
@@ -207,11 +325,45 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 		return tags, vDiff, errors.Wrap(errors.ErrState, "cannot get current block height")
 	}
 
+	conf, err := loadConfiguration(db)
+	if err != nil {
+		return tags, vDiff, err
+	}
+	var gasCosts store.GasConfig
+	if conf.MaxGasPerBlock > 0 {
+		rules, err := t.gas(db)
+		if err != nil {
+			return tags, vDiff, errors.Wrap(err, "cannot load gas rules")
+		}
+		gasCosts = rules.Costs
+	}
+
 	// A safety measure to not execute too many tasks in one go. Maintain
 	// an upper limit as we can process the rest of the queue in another
-	// run.
-	const maxExecuted = 50
-	for proc := 0; proc < maxExecuted; proc++ {
+	// run. Both the number of tasks and the amount of gas they consume
+	// can be limited by the configuration.
+	var gasUsed int64
+	for proc := 0; ; proc++ {
+		if proc == conf.maxTasksPerBlock() || (conf.MaxGasPerBlock > 0 && gasUsed >= conf.MaxGasPerBlock) {
+			backlog, err := countDue(db, now, maxBacklogCount)
+			if err != nil {
+				return tags, vDiff, errors.Wrap(err, "cannot count due tasks")
+			}
+			if backlog > 0 {
+				value := strconv.Itoa(backlog)
+				if backlog == maxBacklogCount {
+					value = ">=" + value
+				}
+				weave.GetLogger(ctx).Info("cron task execution limit reached",
+					"executed", proc, "gasUsed", gasUsed, "backlog", value)
+				tags = append(tags, common.KVPair{
+					Key:   []byte("cron.backlog"),
+					Value: []byte(value),
+				})
+			}
+			return tags, vDiff, nil
+		}
+
 		switch key, raw, err := peek(db, now); {
 		case err == nil:
 			// Each task is processed using its own cache instance
@@ -220,8 +372,9 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 			cache := db.CacheWrap()
 
 			var (
-				taskTags []common.KVPair
-				taskDiff []weave.ValidatorUpdate
+				taskTags  []common.KVPair
+				taskDiff  []weave.ValidatorUpdate
+				taskMeter weave.GasMeter
 			)
 			res := TaskResult{
 				Metadata:   &weave.Metadata{Schema: 1},
@@ -236,12 +389,21 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				res.Info = fmt.Sprintf("cannot unmarshal task: %+v", err)
 			} else {
 				taskCtx := withAuth(ctx, auth)
+				var taskDB weave.KVStore = cache
+				if conf.MaxGasPerBlock > 0 {
+					// Gas is only counted. A task is never
+					// interrupted because of the block limit.
+					meter := weave.NewGasMeter(0)
+					taskCtx = weave.WithGasMeter(taskCtx, meter)
+					taskDB = store.NewGasKVStore(cache, meter, gasCosts)
+					taskMeter = meter
+				}
 				tx := &taskTx{msg: msg}
 				// Execute deliver using the savepoint wrapper,
 				// so that changes are applied to the cache
 				// only when the Deliver call is successful.
 				sp := utils.NewSavepoint().OnDeliver()
-				if r, err := sp.Deliver(taskCtx, cache, tx, t.hn); err != nil {
+				if r, err := sp.Deliver(taskCtx, taskDB, tx, t.hn); err != nil {
 					res.Successful = false
 					res.Info = err.Error()
 				} else {
@@ -250,6 +412,20 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 					res.Info = r.Log
 				}
 			}
+			if taskMeter != nil {
+				gasUsed += taskMeter.GasConsumed()
+			}
+
+			seriesID, series, err := t.taskSeries(cache, key)
+			if err != nil {
+				cache.Discard()
+				return tags, vDiff, errors.Wrap(err, "cannot get task series")
+			}
+			if series != nil {
+				series.Runs++
+				res.SeriesID = seriesID
+				res.Run = series.Runs
+			}
 
 			if _, err := t.results.Put(cache, key, &res); err != nil {
 				cache.Discard()
@@ -261,6 +437,13 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 				cache.Discard()
 				return tags, vDiff, errors.Wrap(err, "cannot delete task")
 			}
+
+			if series != nil {
+				if err := t.reschedule(cache, now, seriesID, series, raw); err != nil {
+					cache.Discard()
+					return tags, vDiff, errors.Wrap(err, "cannot reschedule task")
+				}
+			}
 			if err := cache.Write(); err != nil {
 				cache.Discard()
 				return tags, vDiff, errors.Wrap(err, "cannot write cache")
@@ -283,8 +466,62 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 			return tags, vDiff, errors.Wrap(err, "cannot pop queue")
 		}
 	}
+}
 
-	return tags, vDiff, nil
+// taskSeries returns the series that the task with given ID belongs to. It
+// returns nil if the task is not recurring.
+func (t *Ticker) taskSeries(db weave.KVStore, taskID []byte) ([]byte, *TaskSeries, error) {
+	var series []*TaskSeries
+	keys, err := t.series.ByIndex(db, "task", taskID, &series)
+	switch {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil, nil, nil
+	default:
+		return nil, nil, err
+	}
+	if len(series) == 0 {
+		return nil, nil, nil
+	}
+	return keys[0], series[0], nil
+}
+
+// reschedule queues the next execution of the series. If the series reached
+// its maximum number of runs or its next execution time cannot be computed,
+// it is removed instead.
+func (t *Ticker) reschedule(db weave.KVStore, now time.Time, seriesID []byte, series *TaskSeries, raw []byte) error {
+	rec := series.Recurrence
+	if rec.MaxRuns != 0 && series.Runs >= rec.MaxRuns {
+		if err := t.series.Delete(db, seriesID); err != nil {
+			return errors.Wrap(err, "cannot delete task series")
+		}
+		return nil
+	}
+
+	first := series.FirstRunAt.Time()
+	next, runAt, err := rec.nextRun(first, series.Occurrence, now)
+	switch {
+	case err == nil:
+	case errors.ErrOverflow.Is(err):
+		// The next execution is too far in the future to be
+		// computed, so the series cannot continue.
+		if err := t.series.Delete(db, seriesID); err != nil {
+			return errors.Wrap(err, "cannot delete task series")
+		}
+		return nil
+	default:
+		return errors.Wrap(err, "cannot compute next run")
+	}
+	taskID, err := enqueue(db, runAt, raw)
+	if err != nil {
+		return errors.Wrap(err, "cannot queue task")
+	}
+	series.TaskID = taskID
+	series.Occurrence = next
+	if _, err := t.series.Put(db, seriesID, series); err != nil {
+		return errors.Wrap(err, "cannot store task series")
+	}
+	return nil
 }
 
 // peek reads from the queue a single task that reached its execution time and
@@ -310,6 +547,35 @@ func peek(db weave.KVStore, now time.Time) (id, raw []byte, err error) {
 	}
 }
 
+// countDue returns the number of tasks that reached their execution time.
+// Counting stops when the limit is reached, so that a long queue does not
+// have to be iterated over in every block.
+func countDue(db weave.KVStore, now time.Time, limit int) (int, error) {
+	it, err := db.Iterator(queueKey(time.Time{}), queueKey(now))
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	var n int
+	for n < limit {
+		switch _, _, err := it.Next(); {
+		case err == nil:
+			n++
+		case errors.ErrIteratorDone.Is(err):
+			return n, nil
+		default:
+			return 0, errors.Wrap(err, "cannot get next item")
+		}
+	}
+	return n, nil
+}
+
+// maxBacklogCount is the maximum number of due tasks that are counted when
+// reporting the backlog. A bigger backlog is reported as ">=" followed by
+// this number.
+const maxBacklogCount = 1000
+
 // taskTx is a weave.Tx implementation created for running
 // asynchronous tasks. It is a thin wrapper over the message.
 type taskTx struct {
@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -51,6 +53,33 @@ func TestSchedulerDelete(t *testing.T) {
 	ticker.Tick(ctx, db)
 }
 
+func TestDeleteTask(t *testing.T) {
+	db := store.MemStore()
+
+	s := NewScheduler(NewTestTaskMarshaler(&weavetest.Msg{}))
+
+	if err := DeleteTask(db, s, nil); err != nil {
+		t.Fatalf("deletion of a task without an ID failed: %s", err)
+	}
+	if err := DeleteTask(db, s, []byte("task-with-this-id-does-not-exist")); err != nil {
+		t.Fatalf("deletion of a non existing task failed: %s", err)
+	}
+
+	tid, err := s.Schedule(db, time.Now(), nil, &weavetest.Msg{})
+	if err != nil {
+		t.Fatalf("cannot schedule a task: %s", err)
+	}
+	if err := DeleteTask(db, s, tid); err != nil {
+		t.Fatalf("cannot delete a scheduled task: %s", err)
+	}
+	if ok, err := db.Has(tid); err != nil || ok {
+		t.Fatalf("task not deleted: %v, %v", ok, err)
+	}
+	if err := DeleteTask(db, s, tid); err != nil {
+		t.Fatalf("double deletion failed: %s", err)
+	}
+}
+
 func TestRoundT(t *testing.T) {
 	cases := map[string]struct {
 		input       time.Time
@@ -369,6 +398,145 @@ func TestTicker(t *testing.T) {
 	}
 }
 
+func TestRecurringTask(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+	ticker := NewTicker(&cronHandler{}, enc)
+
+	start := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	rec := Recurrence{Interval: 60, MaxRuns: 3}
+	seriesID, err := scheduler.ScheduleRecurring(db, start, rec, nil, &weavetest.Msg{RoutePath: "test/recurring"})
+	if err != nil {
+		t.Fatalf("cannot schedule a recurring task: %s", err)
+	}
+
+	tick := func(at time.Time) [][]byte {
+		t.Helper()
+		ctx := weave.WithBlockTime(context.Background(), at)
+		ctx = weave.WithHeight(ctx, 1)
+		tags, _, err := ticker.tick(ctx, db)
+		if err != nil {
+			t.Fatalf("cannot tick: %+v", err)
+		}
+		var executed [][]byte
+		for _, tag := range tags {
+			if string(tag.Key) == "cron" {
+				executed = append(executed, tag.Value)
+			}
+		}
+		return executed
+	}
+
+	steps := []struct {
+		At       time.Time
+		WantRun  uint32
+		WantNext bool
+	}{
+		{At: start.Add(time.Second), WantRun: 1, WantNext: true},
+		// Next occurrence is not due yet.
+		{At: start.Add(30 * time.Second), WantRun: 0, WantNext: true},
+		{At: start.Add(61 * time.Second), WantRun: 2, WantNext: true},
+		// Missed occurrences are skipped, but the run still counts.
+		{At: start.Add(10 * time.Minute), WantRun: 3, WantNext: false},
+		// Series reached its maximum number of runs.
+		{At: start.Add(time.Hour), WantRun: 0, WantNext: false},
+	}
+
+	results := NewTaskResultBucket()
+	for i, step := range steps {
+		executed := tick(step.At)
+		if step.WantRun == 0 {
+			if len(executed) != 0 {
+				t.Fatalf("step %d: want no task executed, got %d", i, len(executed))
+			}
+		} else {
+			if len(executed) != 1 {
+				t.Fatalf("step %d: want one task executed, got %d", i, len(executed))
+			}
+			var res TaskResult
+			if err := results.One(db, executed[0], &res); err != nil {
+				t.Fatalf("step %d: cannot get task result: %s", i, err)
+			}
+			if !bytes.Equal(res.SeriesID, seriesID) {
+				t.Fatalf("step %d: want %q series ID, got %q", i, seriesID, res.SeriesID)
+			}
+			if res.Run != step.WantRun {
+				t.Fatalf("step %d: want run %d, got %d", i, step.WantRun, res.Run)
+			}
+		}
+
+		var series TaskSeries
+		switch err := NewTaskSeriesBucket().One(db, seriesID, &series); {
+		case err == nil:
+			if !step.WantNext {
+				t.Fatalf("step %d: want series to be removed", i)
+			}
+			if ok, err := db.Has(series.TaskID); err != nil || !ok {
+				t.Fatalf("step %d: want next task to be queued: %v", i, err)
+			}
+		case errors.ErrNotFound.Is(err):
+			if step.WantNext {
+				t.Fatalf("step %d: want series to exist", i)
+			}
+		default:
+			t.Fatalf("step %d: cannot get series: %s", i, err)
+		}
+	}
+}
+
+func TestCancelSeries(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+
+	now := time.Now()
+	rec := Recurrence{Interval: 60}
+
+	if err := scheduler.CancelSeries(db, weavetest.SequenceID(1)); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cancelling a non existing series failed with an unexpected error: %s", err)
+	}
+
+	seriesID, err := scheduler.ScheduleRecurring(db, now, rec, nil, &weavetest.Msg{})
+	if err != nil {
+		t.Fatalf("cannot schedule a recurring task: %s", err)
+	}
+	if err := scheduler.CancelSeries(db, seriesID); err != nil {
+		t.Fatalf("cannot cancel series: %s", err)
+	}
+	if err := scheduler.CancelSeries(db, seriesID); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("double cancellation failed with an unexpected error: %s", err)
+	}
+
+	// Deleting the currently queued task cancels the whole series.
+	seriesID, err = scheduler.ScheduleRecurring(db, now, rec, nil, &weavetest.Msg{})
+	if err != nil {
+		t.Fatalf("cannot schedule a recurring task: %s", err)
+	}
+	var series TaskSeries
+	if err := NewTaskSeriesBucket().One(db, seriesID, &series); err != nil {
+		t.Fatalf("cannot get series: %s", err)
+	}
+	if err := scheduler.Delete(db, series.TaskID); err != nil {
+		t.Fatalf("cannot delete task: %s", err)
+	}
+	if err := NewTaskSeriesBucket().One(db, seriesID, &series); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want series to be deleted, got %v", err)
+	}
+
+	if _, err := scheduler.ScheduleRecurring(db, now, Recurrence{}, nil, &weavetest.Msg{}); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("want invalid recurrence to be rejected, got %v", err)
+	}
+
+	// Use nil as handler so that it panics if used. No task must be
+	// processed.
+	ctx := weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	ctx = weave.WithHeight(ctx, 123)
+	NewTicker(nil, enc).Tick(ctx, db)
+}
+
 func containsPairValue(pairs []common.KVPair, item []byte) bool {
 	for _, p := range pairs {
 		if bytes.Equal(p.Value, item) {
@@ -457,3 +625,150 @@ type serializedTask struct {
 	Auth   []weave.Condition
 	RawMsg []byte
 }
+
+func TestTickerBlockLimits(t *testing.T) {
+	cases := map[string]struct {
+		Conf  *Configuration
+		Rules store.GasRulesLoader
+		Tasks int
+		// Number of tasks executed by each consecutive block.
+		WantExecuted []int
+		// Backlog tag value reported by each consecutive block. Empty
+		// string means that no tag is expected.
+		WantBacklog []string
+	}{
+		"default task limit": {
+			Tasks:        60,
+			WantExecuted: []int{50, 10},
+			WantBacklog:  []string{"10", ""},
+		},
+		"configured task limit": {
+			Conf:         &Configuration{MaxTasksPerBlock: 2},
+			Tasks:        5,
+			WantExecuted: []int{2, 2, 1, 0},
+			WantBacklog:  []string{"3", "1", "", ""},
+		},
+		"gas limit reached by a single task": {
+			Conf:         &Configuration{MaxGasPerBlock: 1},
+			Tasks:        3,
+			WantExecuted: []int{1, 1, 1},
+			WantBacklog:  []string{"2", "1", ""},
+		},
+		"big backlog is reported as a lower bound": {
+			Conf:         &Configuration{MaxTasksPerBlock: 1},
+			Tasks:        maxBacklogCount + 1,
+			WantExecuted: []int{1, 1},
+			WantBacklog:  []string{">=1000", "999"},
+		},
+		"gas limit not reached": {
+			Conf:         &Configuration{MaxGasPerBlock: 1000000},
+			Tasks:        3,
+			WantExecuted: []int{3},
+			WantBacklog:  []string{""},
+		},
+		"gas costs are loaded from the state": {
+			Conf: &Configuration{MaxGasPerBlock: 1000000},
+			Rules: func(db store.ReadOnlyKVStore) (store.GasRules, error) {
+				raw, err := db.Get([]byte("writecost"))
+				if err != nil {
+					return store.GasRules{}, err
+				}
+				cost, err := strconv.ParseInt(string(raw), 10, 64)
+				if err != nil {
+					return store.GasRules{}, err
+				}
+				return store.GasRules{Costs: store.GasConfig{WriteCostFlat: cost}}, nil
+			},
+			Tasks:        3,
+			WantExecuted: []int{1, 1, 1},
+			WantBacklog:  []string{"2", "1", ""},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+			if tc.Conf != nil {
+				tc.Conf.Metadata = &weave.Metadata{Schema: 1}
+				if err := gconf.Save(db, "cron", tc.Conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+
+			enc := NewTestTaskMarshaler(&weavetest.Msg{})
+			scheduler := NewScheduler(enc)
+			ticker := NewTicker(writingHandler{}, enc)
+			if tc.Rules != nil {
+				ticker = ticker.WithGasRules(tc.Rules)
+				if err := db.Set([]byte("writecost"), []byte("1000000")); err != nil {
+					t.Fatalf("cannot set write cost: %s", err)
+				}
+			}
+
+			now := time.Now()
+			var taskIDs [][]byte
+			for i := 0; i < tc.Tasks; i++ {
+				// Schedule in reverse order to ensure that
+				// tasks are executed in order of their
+				// execution time.
+				runAt := now.Add(-time.Duration(tc.Tasks-i) * time.Minute)
+				id, err := scheduler.Schedule(db, runAt, nil, &weavetest.Msg{RoutePath: "test/write"})
+				if err != nil {
+					t.Fatalf("cannot schedule #%d task: %s", i, err)
+				}
+				taskIDs = append(taskIDs, id)
+			}
+
+			ctx := weave.WithBlockTime(context.Background(), now)
+			ctx = weave.WithHeight(ctx, 1)
+
+			var next int
+			for block, want := range tc.WantExecuted {
+				tags, _, err := ticker.tick(ctx, db)
+				if err != nil {
+					t.Fatalf("block %d: cannot tick: %+v", block, err)
+				}
+				var (
+					executed [][]byte
+					backlog  string
+				)
+				for _, tag := range tags {
+					switch string(tag.Key) {
+					case "cron":
+						executed = append(executed, tag.Value)
+					case "cron.backlog":
+						backlog = string(tag.Value)
+					}
+				}
+				if len(executed) != want {
+					t.Fatalf("block %d: want %d tasks executed, got %d", block, want, len(executed))
+				}
+				for _, id := range executed {
+					if !bytes.Equal(id, taskIDs[next]) {
+						t.Fatalf("block %d: task #%d executed out of order", block, next)
+					}
+					next++
+				}
+				if backlog != tc.WantBacklog[block] {
+					t.Fatalf("block %d: want %q backlog, got %q", block, tc.WantBacklog[block], backlog)
+				}
+			}
+		})
+	}
+}
+
+// writingHandler is a handler that writes to the database, so that it
+// consumes gas when executed.
+type writingHandler struct{}
+
+func (writingHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	panic("cron must not call check")
+}
+
+func (writingHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := db.Set([]byte("written"), []byte("by a task")); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
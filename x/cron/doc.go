@@ -4,5 +4,26 @@ Package cron implements delayed message execution.
 This package provides a queue implementation for scheduling message for
 execution in the future and weave.Ticker compatible task runner.
 
+A message can be scheduled to run repeatedly, either in fixed intervals or
+every given number of calendar months, optionally limited to a maximum number
+of runs. A monthly run that falls on a day missing in a given month is
+executed on the last day of that month. Each run is a separate task with its
+own result. All future runs can be cancelled by cancelling the task series.
+
+Queued tasks can be listed using the "/crontasks" query and cancelled using
+the CancelTaskMsg. A task can be cancelled either by the configuration owner
+or by the signers of all conditions that authenticate the task execution.
+
+The number of tasks executed in a single block is limited. By default at most
+50 tasks are executed, which can be changed with the max_tasks_per_block
+configuration value. Optionally max_gas_per_block limits the total amount of
+gas consumed by tasks in a single block. A task is never interrupted, so the
+gas limit can be exceeded by the last task executed. Tasks that were not
+executed because of those limits are left in the queue and executed in the
+following blocks, in order of their execution time. The number of due tasks
+left in the queue is reported using the "cron.backlog" tag. Only the first
+1000 due tasks are counted, a bigger backlog is reported as ">=1000". The
+configuration can be changed by its owner using the UpdateConfigurationMsg.
+
 */
 package cron
@@ -0,0 +1,98 @@
+package cron
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x"
+)
+
+// RegisterRoutes registers handlers for managing queued tasks and the
+// configuration. Given marshaler must be the same as the one used by the
+// scheduler and the ticker.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, enc TaskMarshaler) {
+	r = migration.SchemaMigratingRegistry("cron", r)
+
+	r.Handle(&CancelTaskMsg{}, &cancelTaskHandler{
+		auth:      auth,
+		enc:       enc,
+		scheduler: NewScheduler(enc),
+	})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// NewConfigHandler returns a handler that allows the owner to update the
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("cron", &conf, auth)
+}
+
+type cancelTaskHandler struct {
+	auth      x.Authenticator
+	enc       TaskMarshaler
+	scheduler *Scheduler
+}
+
+var _ weave.Handler = (*cancelTaskHandler)(nil)
+
+func (h *cancelTaskHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *cancelTaskHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.scheduler.Delete(db, msg.TaskID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete task")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *cancelTaskHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CancelTaskMsg, error) {
+	var msg CancelTaskMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	raw, err := db.Get(msg.TaskID)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load task")
+	}
+	if raw == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "no task")
+	}
+	if ok, err := h.isOwner(ctx, db); err != nil {
+		return nil, err
+	} else if ok {
+		return &msg, nil
+	}
+	auth, _, err := h.enc.UnmarshalTask(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal task")
+	}
+	// A task without conditions can be cancelled only by the owner.
+	if len(auth) == 0 {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "owner signature required")
+	}
+	for _, c := range auth {
+		if !h.auth.HasAddress(ctx, c.Address()) {
+			return nil, errors.Wrapf(errors.ErrUnauthorized, "%s condition signature required", c)
+		}
+	}
+	return &msg, nil
+}
+
+// isOwner returns true if the configuration owner signed the transaction.
+func (h *cancelTaskHandler) isOwner(ctx weave.Context, db weave.KVStore) (bool, error) {
+	conf, err := loadConfiguration(db)
+	if err != nil {
+		return false, err
+	}
+	return conf.Owner != nil && h.auth.HasAddress(ctx, conf.Owner), nil
+}
@@ -0,0 +1,219 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestCancelTaskHandler(t *testing.T) {
+	var (
+		owner      = weavetest.NewCondition()
+		conditionA = weavetest.NewCondition()
+		conditionB = weavetest.NewCondition()
+		stranger   = weavetest.NewCondition()
+	)
+
+	cases := map[string]struct {
+		// Configuration is saved only if owner is provided.
+		Owner     weave.Address
+		TaskAuth  []weave.Condition
+		Recurring bool
+		Signers   []weave.Condition
+		// When set, this task ID is used instead of the scheduled task
+		// ID.
+		TaskID         []byte
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+	}{
+		"owner can cancel any task": {
+			Owner:    owner.Address(),
+			TaskAuth: []weave.Condition{conditionA},
+			Signers:  []weave.Condition{owner},
+		},
+		"owner can cancel a task without conditions": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+		},
+		"signers of all task conditions can cancel the task": {
+			TaskAuth: []weave.Condition{conditionA, conditionB},
+			Signers:  []weave.Condition{conditionB, conditionA},
+		},
+		"cancelling a recurring task cancels the series": {
+			TaskAuth:  []weave.Condition{conditionA},
+			Recurring: true,
+			Signers:   []weave.Condition{conditionA},
+		},
+		"signers of some of the task conditions cannot cancel the task": {
+			Owner:          owner.Address(),
+			TaskAuth:       []weave.Condition{conditionA, conditionB},
+			Signers:        []weave.Condition{conditionA},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"stranger cannot cancel a task": {
+			Owner:          owner.Address(),
+			TaskAuth:       []weave.Condition{conditionA},
+			Signers:        []weave.Condition{stranger},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"task without conditions cannot be cancelled without an owner": {
+			Signers:        []weave.Condition{stranger},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"task must exist": {
+			Owner:          owner.Address(),
+			Signers:        []weave.Condition{owner},
+			TaskID:         queueKey(time.Now().Add(48 * time.Hour)),
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+		"only task can be cancelled": {
+			Owner:          owner.Address(),
+			Signers:        []weave.Condition{owner},
+			TaskID:         []byte("_c:cron"),
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+
+			if tc.Owner != nil {
+				conf := Configuration{
+					Metadata: &weave.Metadata{Schema: 1},
+					Owner:    tc.Owner,
+				}
+				if err := gconf.Save(db, "cron", &conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+
+			enc := NewTestTaskMarshaler(&weavetest.Msg{})
+			scheduler := NewScheduler(enc)
+			runAt := time.Now().Add(time.Hour)
+			var (
+				taskID   []byte
+				seriesID []byte
+				err      error
+			)
+			if tc.Recurring {
+				seriesID, err = scheduler.ScheduleRecurring(db, runAt, Recurrence{Interval: 60}, tc.TaskAuth, &weavetest.Msg{})
+				if err == nil {
+					var series TaskSeries
+					err = NewTaskSeriesBucket().One(db, seriesID, &series)
+					taskID = series.TaskID
+				}
+			} else {
+				taskID, err = scheduler.Schedule(db, runAt, tc.TaskAuth, &weavetest.Msg{})
+			}
+			if err != nil {
+				t.Fatalf("cannot schedule task: %s", err)
+			}
+			if tc.TaskID != nil {
+				taskID = tc.TaskID
+			}
+
+			auth := &weavetest.Auth{Signers: tc.Signers}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, enc)
+			tx := &weavetest.Tx{Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TaskID:   taskID,
+			}}
+
+			cache := db.CacheWrap()
+			if _, err := rt.Check(context.TODO(), cache, tx); !tc.WantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			cache.Discard()
+			if _, err := rt.Deliver(context.TODO(), db, tx); !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.WantDeliverErr != nil {
+				return
+			}
+
+			if ok, err := db.Has(taskID); err != nil || ok {
+				t.Fatalf("want task to be deleted: %v", err)
+			}
+			if seriesID != nil {
+				var series TaskSeries
+				if err := NewTaskSeriesBucket().One(db, seriesID, &series); !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want series to be deleted, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationHandler(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		Signer       weave.Condition
+		WantErr      *errors.Error
+		WantMaxTasks uint32
+	}{
+		"owner can update the configuration": {
+			Signer:       owner,
+			WantMaxTasks: 10,
+		},
+		"only the owner can update the configuration": {
+			Signer:       stranger,
+			WantErr:      errors.ErrUnauthorized,
+			WantMaxTasks: 0,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cron")
+			conf := Configuration{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Owner:          owner.Address(),
+				MaxGasPerBlock: 1000,
+			}
+			if err := gconf.Save(db, "cron", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, &weavetest.Auth{Signer: tc.Signer}, NewTestTaskMarshaler(&weavetest.Msg{}))
+			tx := &weavetest.Tx{Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{MaxTasksPerBlock: 10},
+			}}
+			if _, err := rt.Deliver(context.TODO(), db, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			got, err := loadConfiguration(db)
+			if err != nil {
+				t.Fatalf("cannot load configuration: %s", err)
+			}
+			if got.MaxTasksPerBlock != tc.WantMaxTasks {
+				t.Fatalf("want %d max tasks per block, got %d", tc.WantMaxTasks, got.MaxTasksPerBlock)
+			}
+			// Fields that are not set in the patch are not changed.
+			if got.MaxGasPerBlock != 1000 {
+				t.Fatalf("want max gas per block unchanged, got %d", got.MaxGasPerBlock)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package cron
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisCron is the genesis file representation of the task queue.
+type genesisCron struct {
+	Tasks  []genesisTask   `json:"tasks"`
+	Series []genesisSeries `json:"series"`
+}
+
+type genesisTask struct {
+	RunAt time.Time `json:"run_at"`
+	// Task is the task serialized using the TaskMarshaler.
+	Task []byte `json:"task"`
+}
+
+type genesisSeries struct {
+	ID         uint64         `json:"id"`
+	Recurrence Recurrence     `json:"recurrence"`
+	TaskRunAt  time.Time      `json:"task_run_at"`
+	FirstRunAt weave.UnixTime `json:"first_run_at"`
+	Occurrence uint32         `json:"occurrence,omitempty"`
+	Runs       uint32         `json:"runs,omitempty"`
+}
+
+// FromGenesis will load the configuration and the queued tasks from the
+// genesis file and save them to the database.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	// Configuration is optional. Without it, a task can be cancelled only
+	// by the signers of its conditions.
+	switch err := gconf.InitConfig(kv, opts, "cron", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "init config")
+	}
+
+	var genesis genesisCron
+	if err := opts.ReadOptions("cron", &genesis); err != nil {
+		return err
+	}
+	for i, t := range genesis.Tasks {
+		if len(t.Task) == 0 {
+			return errors.Wrapf(errors.ErrEmpty, "task #%d", i)
+		}
+		key := queueKey(t.RunAt)
+		if ok, err := kv.Has(key); err != nil {
+			return errors.Wrap(err, "cannot check key existence")
+		} else if ok {
+			return errors.Wrapf(errors.ErrDuplicate, "task #%d run at %s", i, t.RunAt)
+		}
+		if err := kv.Set(key, t.Task); err != nil {
+			return errors.Wrapf(err, "cannot store task #%d", i)
+		}
+	}
+
+	bucket := NewTaskSeriesBucket()
+	for _, s := range genesis.Series {
+		taskID := queueKey(s.TaskRunAt)
+		if ok, err := kv.Has(taskID); err != nil {
+			return errors.Wrap(err, "cannot check key existence")
+		} else if !ok {
+			return errors.Wrapf(errors.ErrNotFound, "task of series %d", s.ID)
+		}
+		key, err := taskSeriesSeq.ReserveVal(kv, int64(s.ID))
+		if err != nil {
+			return errors.Wrap(err, "cannot reserve series ID")
+		}
+		series := TaskSeries{
+			Metadata:   &weave.Metadata{Schema: 1},
+			Recurrence: s.Recurrence,
+			TaskID:     taskID,
+			FirstRunAt: s.FirstRunAt,
+			Occurrence: s.Occurrence,
+			Runs:       s.Runs,
+		}
+		if _, err := bucket.Put(kv, key, &series); err != nil {
+			return errors.Wrapf(err, "series %d", s.ID)
+		}
+	}
+	return nil
+}
+
+// ExportGenesis will write the configuration, all queued tasks and task
+// series to opts, in the format expected by FromGenesis. Task results are not
+// exported.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	switch err := gconf.ExportConfig(db, opts, "cron", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "export config")
+	}
+
+	genesis := genesisCron{
+		Tasks:  []genesisTask{},
+		Series: []genesisSeries{},
+	}
+
+	it, err := db.Iterator(queuePrefix, prefixEnd(queuePrefix))
+	if err != nil {
+		return errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+	for {
+		key, value, err := it.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot get next task")
+		}
+		runAt, err := queueKeyTime(key)
+		if err != nil {
+			return err
+		}
+		genesis.Tasks = append(genesis.Tasks, genesisTask{RunAt: runAt, Task: value})
+	}
+
+	sit, err := NewTaskSeriesBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate task series")
+	}
+	defer sit.Release()
+	for {
+		var s TaskSeries
+		key, err := sit.LoadNext(&s)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load task series")
+		}
+		runAt, err := queueKeyTime(s.TaskID)
+		if err != nil {
+			return errors.Wrapf(err, "task of series %x", key)
+		}
+		genesis.Series = append(genesis.Series, genesisSeries{
+			ID:         binary.BigEndian.Uint64(key),
+			Recurrence: s.Recurrence,
+			TaskRunAt:  runAt,
+			FirstRunAt: s.FirstRunAt,
+			Occurrence: s.Occurrence,
+			Runs:       s.Runs,
+		})
+	}
+
+	raw, err := json.Marshal(genesis)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal cron")
+	}
+	opts["cron"] = raw
+	return nil
+}
@@ -0,0 +1,130 @@
+package cron
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestGenesisConfiguration(t *testing.T) {
+	const genesis = `
+		{
+			"conf": {
+				"cron": {
+					"metadata": {"schema": 1},
+					"owner": "seq:gov/rule/1"
+				}
+			}
+		}
+	`
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+
+	var conf Configuration
+	if err := gconf.Load(db, "cron", &conf); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if len(conf.Owner) == 0 {
+		t.Fatal("owner not loaded")
+	}
+
+	exported := make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis: %s", err)
+	}
+	if _, ok := exported["conf"]; !ok {
+		t.Fatal("configuration not exported")
+	}
+}
+
+func TestGenesisWithoutConfiguration(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	var ini Initializer
+	if err := ini.FromGenesis(weave.Options{}, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+	var conf Configuration
+	if err := gconf.Load(db, "cron", &conf); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want no configuration, got %+v", err)
+	}
+}
+
+func TestGenesisExportTasks(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+
+	runAt := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	taskID, err := scheduler.Schedule(db, runAt, nil, &weavetest.Msg{RoutePath: "test/once"})
+	if err != nil {
+		t.Fatalf("cannot schedule task: %s", err)
+	}
+	rec := Recurrence{Interval: 60, MaxRuns: 3}
+	seriesID, err := scheduler.ScheduleRecurring(db, runAt.Add(time.Hour), rec, nil, &weavetest.Msg{RoutePath: "test/recurring"})
+	if err != nil {
+		t.Fatalf("cannot schedule recurring task: %s", err)
+	}
+
+	var ini Initializer
+	exported := make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis: %s", err)
+	}
+
+	db = store.MemStore()
+	migration.MustInitPkg(db, "cron")
+	if err := ini.FromGenesis(exported, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load exported genesis: %s", err)
+	}
+
+	raw, err := db.Get(taskID)
+	if err != nil {
+		t.Fatalf("cannot get task: %s", err)
+	}
+	_, msg, err := enc.UnmarshalTask(raw)
+	if err != nil {
+		t.Fatalf("cannot unmarshal task: %s", err)
+	}
+	if want, got := "test/once", msg.Path(); want != got {
+		t.Fatalf("want %q task, got %q", want, got)
+	}
+
+	var series TaskSeries
+	if err := NewTaskSeriesBucket().One(db, seriesID, &series); err != nil {
+		t.Fatalf("cannot get task series: %s", err)
+	}
+	if series.Recurrence != rec {
+		t.Fatalf("want %v recurrence, got %v", rec, series.Recurrence)
+	}
+	if ok, err := db.Has(series.TaskID); err != nil || !ok {
+		t.Fatalf("want series task to be queued: %v", err)
+	}
+
+	// The series sequence continues after the imported IDs.
+	nextID, err := scheduler.ScheduleRecurring(db, runAt.Add(2*time.Hour), rec, nil, &weavetest.Msg{RoutePath: "test/recurring"})
+	if err != nil {
+		t.Fatalf("cannot schedule recurring task: %s", err)
+	}
+	if bytes.Equal(nextID, seriesID) {
+		t.Fatal("series ID reused")
+	}
+}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"math"
+	"time"
+
 	weave "github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
@@ -9,6 +12,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &TaskResult{}, migration.NoModification)
+	migration.MustRegister(1, &TaskSeries{}, migration.NoModification)
 }
 
 var _ orm.CloneableData = (*TaskResult)(nil)
@@ -32,6 +36,8 @@ func (t *TaskResult) Copy() orm.CloneableData {
 		Info:       t.Info,
 		ExecTime:   t.ExecTime,
 		ExecHeight: t.ExecHeight,
+		SeriesID:   copyBytes(t.SeriesID),
+		Run:        t.Run,
 	}
 }
 
@@ -43,4 +49,164 @@ func NewTaskResultBucket() orm.ModelBucket {
 
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTaskResultBucket().Register("crontaskresults", qr)
+	NewTaskSeriesBucket().Register("crontaskseries", qr)
+}
+
+// Validate returns an error if the recurrence is not valid.
+func (r *Recurrence) Validate() error {
+	var errs error
+	switch {
+	case r.Interval != 0 && r.Months != 0:
+		errs = errors.Append(errs, errors.Field("Interval", errors.ErrInput, "cannot be used together with months"))
+	case r.Interval == 0 && r.Months == 0:
+		errs = errors.Append(errs, errors.Field("Interval", errors.ErrEmpty, "interval or months is required"))
+	case r.Interval < 0:
+		errs = errors.Append(errs, errors.Field("Interval", errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+// occurrence returns the time of the n-th occurrence, counting from zero,
+// of a series that was first executed at given time. When the day of the
+// month of the first execution does not exist in the target month, the last
+// day of that month is used instead. ErrOverflow is returned if the
+// occurrence is too far in the future to be computed.
+func (r *Recurrence) occurrence(first time.Time, n uint32) (time.Time, error) {
+	if r.Months != 0 {
+		months := uint64(r.Months) * uint64(n)
+		if months > maxOccurrenceMonths {
+			return time.Time{}, errors.Wrapf(errors.ErrOverflow, "occurrence %d", n)
+		}
+		m := int(first.Month()) - 1 + int(months)
+		year, month := first.Year()+m/12, time.Month(m%12+1)
+		day := first.Day()
+		if last := daysIn(year, month); day > last {
+			day = last
+		}
+		return time.Date(year, month, day, first.Hour(), first.Minute(), first.Second(), first.Nanosecond(), first.Location()), nil
+	}
+	interval := r.Interval.Duration()
+	if interval <= 0 || int64(n) > math.MaxInt64/int64(interval) {
+		return time.Time{}, errors.Wrapf(errors.ErrOverflow, "occurrence %d", n)
+	}
+	return first.Add(time.Duration(n) * interval), nil
+}
+
+// maxOccurrenceMonths is the biggest number of months that an occurrence can
+// be distant from the first execution. It keeps the calendar computation
+// within the int range on every platform.
+const maxOccurrenceMonths = math.MaxInt32 - 12
+
+// daysIn returns the number of days in the month of the given year.
+func daysIn(year int, month time.Month) int {
+	// Day zero of the next month is normalized to the last day of the
+	// given month.
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// nextOccurrence returns the index of the first occurrence after the given
+// one that is still in the future. Any occurrence that was missed is
+// skipped. ErrOverflow is returned if the index cannot be represented.
+func (r *Recurrence) nextOccurrence(first time.Time, prev uint32, now time.Time) (uint32, error) {
+	if prev == math.MaxUint32 {
+		return 0, errors.Wrap(errors.ErrOverflow, "occurrence index")
+	}
+	next := prev + 1
+	if r.Months == 0 {
+		if elapsed := now.Sub(first); elapsed >= 0 {
+			n := uint64(elapsed/r.Interval.Duration()) + 1
+			if n > math.MaxUint32 {
+				return 0, errors.Wrap(errors.ErrOverflow, "occurrence index")
+			}
+			if uint32(n) > next {
+				next = uint32(n)
+			}
+		}
+		return next, nil
+	}
+	for {
+		at, err := r.occurrence(first, next)
+		if err != nil {
+			return 0, err
+		}
+		if at.After(now) {
+			return next, nil
+		}
+		if next == math.MaxUint32 {
+			return 0, errors.Wrap(errors.ErrOverflow, "occurrence index")
+		}
+		next++
+	}
+}
+
+// nextRun returns the index and the time of the first occurrence after the
+// given one that is still in the future.
+func (r *Recurrence) nextRun(first time.Time, prev uint32, now time.Time) (uint32, time.Time, error) {
+	next, err := r.nextOccurrence(first, prev, now)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	at, err := r.occurrence(first, next)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return next, at, nil
+}
+
+var _ orm.CloneableData = (*TaskSeries)(nil)
+
+func (s *TaskSeries) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
+	errs = errors.AppendField(errs, "Recurrence", s.Recurrence.Validate())
+	if len(s.TaskID) == 0 {
+		errs = errors.Append(errs, errors.Field("TaskID", errors.ErrEmpty, "required"))
+	}
+	errs = errors.AppendField(errs, "FirstRunAt", s.FirstRunAt.Validate())
+	if s.Recurrence.MaxRuns != 0 && s.Runs >= s.Recurrence.MaxRuns {
+		errs = errors.Append(errs, errors.Field("Runs", errors.ErrState, "maximum number of runs reached"))
+	}
+	return errs
+}
+
+func (s *TaskSeries) Copy() orm.CloneableData {
+	return &TaskSeries{
+		Metadata:   s.Metadata.Copy(),
+		Recurrence: s.Recurrence,
+		TaskID:     copyBytes(s.TaskID),
+		FirstRunAt: s.FirstRunAt,
+		Occurrence: s.Occurrence,
+		Runs:       s.Runs,
+	}
+}
+
+// NewTaskSeriesBucket returns a bucket for storing recurring task series.
+func NewTaskSeriesBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("tss", &TaskSeries{},
+		orm.WithIDSequence(taskSeriesSeq),
+		orm.WithIndex("task", taskSeriesTaskIndexer, true),
+	)
+	return migration.NewModelBucket("cron", b)
+}
+
+var taskSeriesSeq = orm.NewSequence("tss", "id")
+
+func taskSeriesTaskIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	s, ok := obj.Value().(*TaskSeries)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+	}
+	return s.TaskID, nil
+}
+
+func copyBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	cpy := make([]byte, len(b))
+	copy(cpy, b)
+	return cpy
 }
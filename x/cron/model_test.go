@@ -1,8 +1,10 @@
 package cron
 
 import (
+	"math"
 	"strings"
 	"testing"
+	"time"
 
 	weave "github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -66,3 +68,208 @@ func TestTaskResultValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskSeriesValidation(t *testing.T) {
+	cases := map[string]struct {
+		Series *TaskSeries
+		// Field name to error mapping. Use `nil` if no error is expected.
+		WantErrs map[string]*errors.Error
+	}{
+		"valid interval series": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Recurrence: Recurrence{Interval: 60, MaxRuns: 3},
+				TaskID:     []byte("task"),
+				FirstRunAt: weave.UnixTime(1000000),
+				Runs:       2,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": nil,
+				"TaskID":     nil,
+				"FirstRunAt": nil,
+				"Runs":       nil,
+			},
+		},
+		"valid calendar series": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Recurrence: Recurrence{Months: 1},
+				TaskID:     []byte("task"),
+				FirstRunAt: weave.UnixTime(1000000),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": nil,
+				"TaskID":     nil,
+				"FirstRunAt": nil,
+				"Runs":       nil,
+			},
+		},
+		"recurrence is required": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				TaskID:     []byte("task"),
+				FirstRunAt: weave.UnixTime(1000000),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": errors.ErrEmpty,
+				"TaskID":     nil,
+				"FirstRunAt": nil,
+				"Runs":       nil,
+			},
+		},
+		"interval and months cannot be used together": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Recurrence: Recurrence{Interval: 60, Months: 1},
+				TaskID:     []byte("task"),
+				FirstRunAt: weave.UnixTime(1000000),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": errors.ErrInput,
+				"TaskID":     nil,
+				"FirstRunAt": nil,
+				"Runs":       nil,
+			},
+		},
+		"negative interval": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Recurrence: Recurrence{Interval: -1},
+				TaskID:     []byte("task"),
+				FirstRunAt: weave.UnixTime(1000000),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": errors.ErrInput,
+				"TaskID":     nil,
+				"FirstRunAt": nil,
+				"Runs":       nil,
+			},
+		},
+		"missing task ID and too many runs": {
+			Series: &TaskSeries{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Recurrence: Recurrence{Interval: 60, MaxRuns: 2},
+				FirstRunAt: weave.UnixTime(1000000),
+				Runs:       2,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Recurrence": nil,
+				"TaskID":     errors.ErrEmpty,
+				"FirstRunAt": nil,
+				"Runs":       errors.ErrState,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Series.Validate()
+			for field, wantErr := range tc.WantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNextRun(t *testing.T) {
+	first := time.Date(2019, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		Recurrence Recurrence
+		Prev       uint32
+		Now        time.Time
+		WantNext   uint32
+		WantTime   time.Time
+		WantErr    *errors.Error
+	}{
+		"next interval occurrence": {
+			Recurrence: Recurrence{Interval: 60},
+			Prev:       0,
+			Now:        first.Add(time.Second),
+			WantNext:   1,
+			WantTime:   first.Add(time.Minute),
+		},
+		"missed interval occurrences are skipped": {
+			Recurrence: Recurrence{Interval: 60},
+			Prev:       0,
+			Now:        first.Add(150 * time.Second),
+			WantNext:   3,
+			WantTime:   first.Add(3 * time.Minute),
+		},
+		"occurrence at exactly the current time is skipped": {
+			Recurrence: Recurrence{Interval: 60},
+			Prev:       1,
+			Now:        first.Add(2 * time.Minute),
+			WantNext:   3,
+			WantTime:   first.Add(3 * time.Minute),
+		},
+		"calendar months are computed from the first run": {
+			Recurrence: Recurrence{Months: 1},
+			Prev:       1,
+			Now:        time.Date(2019, time.March, 8, 0, 0, 0, 0, time.UTC),
+			WantNext:   2,
+			WantTime:   time.Date(2019, time.March, 31, 12, 0, 0, 0, time.UTC),
+		},
+		"missed calendar occurrences are skipped": {
+			Recurrence: Recurrence{Months: 3},
+			Prev:       0,
+			Now:        time.Date(2019, time.August, 1, 0, 0, 0, 0, time.UTC),
+			WantNext:   3,
+			WantTime:   time.Date(2019, time.October, 31, 12, 0, 0, 0, time.UTC),
+		},
+		"day is clamped to the last day of a shorter month": {
+			Recurrence: Recurrence{Months: 1},
+			Prev:       0,
+			Now:        first.Add(time.Second),
+			WantNext:   1,
+			WantTime:   time.Date(2019, time.February, 28, 12, 0, 0, 0, time.UTC),
+		},
+		"day is clamped to the last day of a leap year february": {
+			Recurrence: Recurrence{Months: 13},
+			Prev:       0,
+			Now:        first.Add(time.Second),
+			WantNext:   1,
+			WantTime:   time.Date(2020, time.February, 29, 12, 0, 0, 0, time.UTC),
+		},
+		"occurrence index overflow": {
+			Recurrence: Recurrence{Interval: 60},
+			Prev:       math.MaxUint32,
+			Now:        first.Add(time.Second),
+			WantErr:    errors.ErrOverflow,
+		},
+		"interval occurrence time overflow": {
+			Recurrence: Recurrence{Interval: math.MaxInt32},
+			Prev:       math.MaxUint32 - 1,
+			Now:        first.Add(time.Second),
+			WantErr:    errors.ErrOverflow,
+		},
+		"calendar occurrence time overflow": {
+			Recurrence: Recurrence{Months: math.MaxUint32},
+			Prev:       0,
+			Now:        first.Add(time.Second),
+			WantErr:    errors.ErrOverflow,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			next, at, err := tc.Recurrence.nextRun(first, tc.Prev, tc.Now)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			assert.Equal(t, tc.WantNext, next)
+			if !at.Equal(tc.WantTime) {
+				t.Fatalf("want %s occurrence time, got %s", tc.WantTime, at)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package cron
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &CancelTaskMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*CancelTaskMsg)(nil)
+
+func (CancelTaskMsg) Path() string {
+	return "cron/cancel_task"
+}
+
+func (msg *CancelTaskMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if len(msg.TaskID) == 0 {
+		errs = errors.Append(errs, errors.Field("TaskID", errors.ErrEmpty, "required"))
+	} else if !isQueueKey(msg.TaskID) {
+		errs = errors.Append(errs, errors.Field("TaskID", errors.ErrInput, "not a task ID"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (UpdateConfigurationMsg) Path() string {
+	return "cron/update_configuration"
+}
+
+// Validate will skip any zero fields and validate the set ones.
+func (msg *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.Patch == nil {
+		return errors.Append(errs, errors.Field("Patch", errors.ErrEmpty, "required"))
+	}
+	if len(msg.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", msg.Patch.Owner.Validate())
+	}
+	if msg.Patch.MaxGasPerBlock < 0 {
+		errs = errors.Append(errs, errors.Field("Patch.MaxGasPerBlock", errors.ErrInput, "must not be negative"))
+	}
+	return errs
+}
+
+// isQueueKey returns true if given key is in the format of a task queue key,
+// as created by the queueKey function.
+func isQueueKey(key []byte) bool {
+	return len(key) == len(queuePrefix)+8 && bytes.HasPrefix(key, queuePrefix)
+}
@@ -0,0 +1,105 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCancelTaskMsgValidation(t *testing.T) {
+	cases := map[string]struct {
+		Msg weave.Msg
+		// Field name to error mapping. Use `nil` if no error is expected.
+		WantErrs map[string]*errors.Error
+	}{
+		"valid message": {
+			Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TaskID:   queueKey(time.Now()),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata": nil,
+				"TaskID":   nil,
+			},
+		},
+		"missing metadata and task ID": {
+			Msg: &CancelTaskMsg{},
+			WantErrs: map[string]*errors.Error{
+				"Metadata": errors.ErrMetadata,
+				"TaskID":   errors.ErrEmpty,
+			},
+		},
+		"task ID is not a queue key": {
+			Msg: &CancelTaskMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				TaskID:   []byte("_crontask:runat:1"),
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata": nil,
+				"TaskID":   errors.ErrInput,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Msg.Validate()
+			for field, wantErr := range tc.WantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationMsgValidation(t *testing.T) {
+	cases := map[string]struct {
+		Msg weave.Msg
+		// Field name to error mapping. Use `nil` if no error is expected.
+		WantErrs map[string]*errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{MaxTasksPerBlock: 10, MaxGasPerBlock: 1000},
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":             nil,
+				"Patch":                nil,
+				"Patch.MaxGasPerBlock": nil,
+			},
+		},
+		"missing metadata and patch": {
+			Msg: &UpdateConfigurationMsg{},
+			WantErrs: map[string]*errors.Error{
+				"Metadata": errors.ErrMetadata,
+				"Patch":    errors.ErrEmpty,
+			},
+		},
+		"invalid patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Owner:          weave.Address("invalid"),
+					MaxGasPerBlock: -1,
+				},
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":             nil,
+				"Patch.Owner":          errors.ErrInput,
+				"Patch.MaxGasPerBlock": errors.ErrInput,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Msg.Validate()
+			for field, wantErr := range tc.WantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
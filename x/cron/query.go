@@ -0,0 +1,172 @@
+package cron
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// TaskQuery allows querying tasks that are queued for execution. Each task
+// is returned as a serialized PendingTask, under its task ID.
+type TaskQuery struct {
+	enc    TaskMarshaler
+	series orm.ModelBucket
+}
+
+var _ weave.CursorQueryHandler = (*TaskQuery)(nil)
+
+// NewTaskQuery returns a query handler that is using given marshaler to
+// decode queued tasks. Always use the same marshaler as the scheduler.
+func NewTaskQuery(enc TaskMarshaler) *TaskQuery {
+	return &TaskQuery{
+		enc:    enc,
+		series: NewTaskSeriesBucket(),
+	}
+}
+
+// RegisterQuery registers the pending task query under the "/crontasks"
+// path.
+func (q *TaskQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/crontasks", q)
+}
+
+// Query implements weave.QueryHandler interface.
+func (q *TaskQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, _, err := q.QueryWithCursor(db, mod, data)
+	return models, err
+}
+
+// QueryWithCursor implements weave.CursorQueryHandler interface.
+//
+// Key query returns the task with given ID. Prefix query returns all tasks
+// which ID starts with given data, in order of their execution time. An
+// empty prefix matches all queued tasks.
+func (q *TaskQuery) QueryWithCursor(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, []byte, error) {
+	mod, opts, err := weave.SplitQueryMod(mod)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tasks []weave.Model
+	var cursor []byte
+	switch mod {
+	case weave.KeyQueryMod:
+		if !isQueueKey(data) {
+			return nil, nil, nil
+		}
+		raw, err := db.Get(data)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot load task")
+		}
+		if raw == nil {
+			return nil, nil, nil
+		}
+		tasks = []weave.Model{{Key: data, Value: raw}}
+	case weave.PrefixQueryMod:
+		if tasks, cursor, err = queuedTasks(db, data, opts); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+
+	// A task that cannot be decoded is returned with the decode error
+	// set, so that a single broken task does not hide all other tasks.
+	for i, t := range tasks {
+		raw, err := q.pendingTask(db, t.Key, t.Value)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "task %x", t.Key)
+		}
+		tasks[i].Value = raw
+	}
+	return tasks, cursor, nil
+}
+
+// queuedTasks returns raw tasks which ID starts with given prefix, limited
+// according to the options. If the result is truncated, the last returned
+// task ID is returned as the cursor.
+func queuedTasks(db weave.ReadOnlyKVStore, prefix []byte, opts weave.QueryOptions) ([]weave.Model, []byte, error) {
+	switch {
+	case bytes.HasPrefix(prefix, queuePrefix):
+	case bytes.HasPrefix(queuePrefix, prefix):
+		prefix = queuePrefix
+	default:
+		// Prefix does not match any task ID.
+		return nil, nil, nil
+	}
+	start := prefix
+	if len(opts.After) != 0 {
+		// Continue right after the last returned task.
+		if after := append(append([]byte{}, opts.After...), 0); bytes.Compare(after, start) > 0 {
+			start = after
+		}
+	}
+	it, err := db.Iterator(start, prefixEnd(prefix))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	limit := orm.MaxQueryResults
+	if opts.Limit != 0 && opts.Limit < limit {
+		limit = opts.Limit
+	}
+	var tasks []weave.Model
+	for {
+		key, value, err := it.Next()
+		switch {
+		case errors.ErrIteratorDone.Is(err):
+			return tasks, nil, nil
+		case err != nil:
+			return nil, nil, errors.Wrap(err, "cannot get next task")
+		case uint32(len(tasks)) == limit:
+			return tasks, tasks[len(tasks)-1].Key, nil
+		}
+		tasks = append(tasks, weave.Model{Key: key, Value: value})
+	}
+}
+
+// prefixEnd returns the smallest key that is greater than all keys starting
+// with given prefix. It returns nil if there is no such key.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// pendingTask returns a serialized PendingTask created from given raw task.
+// If the raw task cannot be decoded, the decode error of the returned task is
+// set.
+func (q *TaskQuery) pendingTask(db weave.ReadOnlyKVStore, taskID, raw []byte) ([]byte, error) {
+	runAt, err := queueKeyTime(taskID)
+	if err != nil {
+		return nil, err
+	}
+	task := PendingTask{
+		RunAt: weave.AsUnixTime(runAt),
+	}
+	if auth, msg, err := q.enc.UnmarshalTask(raw); err != nil {
+		task.DecodeError = errors.Wrap(err, "cannot unmarshal task").Error()
+	} else {
+		task.MsgPath = msg.Path()
+		task.Auth = auth
+	}
+	var series []*TaskSeries
+	switch keys, err := q.series.ByIndex(db, "task", taskID, &series); {
+	case err == nil:
+		if len(keys) != 0 {
+			task.SeriesID = keys[0]
+		}
+	case errors.ErrNotFound.Is(err):
+	default:
+		return nil, errors.Wrap(err, "cannot find task series")
+	}
+	return task.Marshal()
+}
@@ -0,0 +1,147 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestTaskQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+
+	cond := weavetest.NewCondition()
+	runAt := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	// Tasks are scheduled in a different order than execution.
+	secondID, err := scheduler.ScheduleRecurring(db, runAt.Add(time.Hour), Recurrence{Interval: 60}, nil, &weavetest.Msg{RoutePath: "test/second"})
+	assert.Nil(t, err)
+	var series TaskSeries
+	assert.Nil(t, NewTaskSeriesBucket().One(db, secondID, &series))
+	secondTaskID := series.TaskID
+	firstTaskID, err := scheduler.Schedule(db, runAt, []weave.Condition{cond}, &weavetest.Msg{RoutePath: "test/first"})
+	assert.Nil(t, err)
+	thirdTaskID, err := scheduler.Schedule(db, runAt.Add(2*time.Hour), nil, &weavetest.Msg{RoutePath: "test/third"})
+	assert.Nil(t, err)
+
+	wantFirst := PendingTask{
+		RunAt:   weave.AsUnixTime(runAt),
+		MsgPath: "test/first",
+		Auth:    []weave.Condition{cond},
+	}
+	wantSecond := PendingTask{
+		RunAt:    weave.AsUnixTime(runAt.Add(time.Hour)),
+		MsgPath:  "test/second",
+		SeriesID: secondID,
+	}
+	wantThird := PendingTask{
+		RunAt:   weave.AsUnixTime(runAt.Add(2 * time.Hour)),
+		MsgPath: "test/third",
+	}
+
+	cases := map[string]struct {
+		Mod        string
+		Data       []byte
+		WantIDs    [][]byte
+		WantTasks  []PendingTask
+		WantCursor []byte
+	}{
+		"single task": {
+			Mod:       weave.KeyQueryMod,
+			Data:      firstTaskID,
+			WantIDs:   [][]byte{firstTaskID},
+			WantTasks: []PendingTask{wantFirst},
+		},
+		"task that does not exist": {
+			Mod:  weave.KeyQueryMod,
+			Data: queueKey(runAt.Add(time.Minute)),
+		},
+		"key that is not a task ID": {
+			Mod:  weave.KeyQueryMod,
+			Data: []byte("_c:cron"),
+		},
+		"all tasks in execution order": {
+			Mod:       weave.PrefixQueryMod,
+			WantIDs:   [][]byte{firstTaskID, secondTaskID, thirdTaskID},
+			WantTasks: []PendingTask{wantFirst, wantSecond, wantThird},
+		},
+		"limited result": {
+			Mod:        weave.QueryMod(weave.PrefixQueryMod, weave.QueryOptions{Limit: 2}),
+			WantIDs:    [][]byte{firstTaskID, secondTaskID},
+			WantTasks:  []PendingTask{wantFirst, wantSecond},
+			WantCursor: secondTaskID,
+		},
+		"result after cursor": {
+			Mod:       weave.QueryMod(weave.PrefixQueryMod, weave.QueryOptions{After: secondTaskID}),
+			WantIDs:   [][]byte{thirdTaskID},
+			WantTasks: []PendingTask{wantThird},
+		},
+		"prefix not matching any task": {
+			Mod:  weave.PrefixQueryMod,
+			Data: []byte("_c:"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, cursor, err := NewTaskQuery(enc).QueryWithCursor(db, tc.Mod, tc.Data)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.WantCursor, cursor)
+			if len(models) != len(tc.WantTasks) {
+				t.Fatalf("want %d tasks, got %d", len(tc.WantTasks), len(models))
+			}
+			for i, m := range models {
+				assert.Equal(t, tc.WantIDs[i], m.Key)
+				var task PendingTask
+				if err := task.Unmarshal(m.Value); err != nil {
+					t.Fatalf("cannot unmarshal #%d task: %s", i, err)
+				}
+				assert.Equal(t, tc.WantTasks[i], task)
+			}
+		})
+	}
+}
+
+func TestTaskQueryUndecodableTask(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+
+	runAt := time.Date(2019, time.June, 1, 12, 0, 0, 0, time.UTC)
+	brokenID, err := scheduler.Schedule(db, runAt, nil, &weavetest.Msg{RoutePath: "test/broken"})
+	assert.Nil(t, err)
+	okID, err := scheduler.Schedule(db, runAt.Add(time.Hour), nil, &weavetest.Msg{RoutePath: "test/ok"})
+	assert.Nil(t, err)
+	assert.Nil(t, db.Set(brokenID, []byte("not a task")))
+
+	models, _, err := NewTaskQuery(enc).QueryWithCursor(db, weave.PrefixQueryMod, nil)
+	assert.Nil(t, err)
+	if len(models) != 2 {
+		t.Fatalf("want 2 tasks, got %d", len(models))
+	}
+
+	assert.Equal(t, brokenID, models[0].Key)
+	var broken PendingTask
+	assert.Nil(t, broken.Unmarshal(models[0].Value))
+	if broken.DecodeError == "" {
+		t.Fatal("want decode error set")
+	}
+	assert.Equal(t, "", broken.MsgPath)
+	assert.Equal(t, weave.AsUnixTime(runAt), broken.RunAt)
+
+	assert.Equal(t, okID, models[1].Key)
+	var ok PendingTask
+	assert.Nil(t, ok.Unmarshal(models[1].Value))
+	assert.Equal(t, "", ok.DecodeError)
+	assert.Equal(t, "test/ok", ok.MsgPath)
+}
@@ -1,7 +1,11 @@
 package currency
 
 import (
+	"encoding/json"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -9,14 +13,18 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisToken is the genesis file representation of a token.
+type genesisToken struct {
+	Ticker string `json:"ticker"`
+	Name   string `json:"name"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var tokens []struct {
-		Ticker string `json:"ticker"`
-		Name   string `json:"name"`
-	}
+	var tokens []genesisToken
 	if err := opts.ReadOptions("currencies", &tokens); err != nil {
 		return err
 	}
@@ -30,3 +38,26 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ExportGenesis will write all token information to opts, in the format
+// expected by FromGenesis.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	tokens := []genesisToken{}
+	err := orm.ForEach(db, NewTokenInfoBucket().Bucket, func(obj orm.Object) error {
+		t, ok := obj.Value().(*TokenInfo)
+		if !ok {
+			return errors.WithType(errors.ErrModel, obj.Value())
+		}
+		tokens = append(tokens, genesisToken{Ticker: string(obj.Key()), Name: t.Name})
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot export tokens")
+	}
+	raw, err := json.Marshal(tokens)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal tokens")
+	}
+	opts["currencies"] = raw
+	return nil
+}
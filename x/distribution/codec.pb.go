@@ -353,40 +353,255 @@ func (m *ResetMsg) GetDestinations() []*Destination {
 	return nil
 }
 
+// Configuration enables distributing the fees collected by the cash extension
+// among the validators at the end of every block.
+type Configuration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Community pool is the address that receives the community pool share of
+	// the collected fees. It also receives the share of validators that did not
+	// declare a reward address and any leftover of the split.
+	CommunityPool github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=community_pool,json=communityPool,proto3,casttype=github.com/iov-one/weave.Address" json:"community_pool,omitempty"`
+	// Community pool share is the percentage (0 to 100) of the collected fees
+	// that is sent to the community pool. The rest is split among the
+	// validators that signed the last block, in proportion to their voting
+	// power.
+	CommunityPoolShare uint32 `protobuf:"varint,3,opt,name=community_pool_share,json=communityPoolShare,proto3" json:"community_pool_share,omitempty"`
+	// Validator rewards declares addresses that validators are paid to.
+	// When updating the configuration, a non empty list replaces the whole
+	// list of validator rewards.
+	ValidatorRewards []ValidatorReward `protobuf:"bytes,4,rep,name=validator_rewards,json=validatorRewards,proto3" json:"validator_rewards"`
+	// Owner is present to implement gconf.OwnedConfig interface.
+	// It defines the address that is allowed to update the configuration.
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+}
+
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_186299c22854933b, []int{5}
+}
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Configuration) GetCommunityPool() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *Configuration) GetCommunityPoolShare() uint32 {
+	if m != nil {
+		return m.CommunityPoolShare
+	}
+	return 0
+}
+
+func (m *Configuration) GetValidatorRewards() []ValidatorReward {
+	if m != nil {
+		return m.ValidatorRewards
+	}
+	return nil
+}
+
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+// UpdateConfigurationMsg is used by the gconf extension to update the
+// configuration. Only the fields that are set in the patch are changed.
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_186299c22854933b, []int{6}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
+	}
+	return nil
+}
+
+type ValidatorReward struct {
+	// Validator is the Tendermint address of the validator, as present in the
+	// last commit votes.
+	Validator github_com_iov_one_weave.Address `protobuf:"bytes,1,opt,name=validator,proto3,casttype=github.com/iov-one/weave.Address" json:"validator,omitempty"`
+	// Address is the account that the validator share of the fees is sent to.
+	// This should not be the validator address, as the keys used to sign
+	// blocks should never be in a wallet.
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *ValidatorReward) Reset()         { *m = ValidatorReward{} }
+func (m *ValidatorReward) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReward) ProtoMessage()    {}
+func (*ValidatorReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_186299c22854933b, []int{7}
+}
+func (m *ValidatorReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReward.Merge(m, src)
+}
+func (m *ValidatorReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReward proto.InternalMessageInfo
+
+func (m *ValidatorReward) GetValidator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *ValidatorReward) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Revenue)(nil), "distribution.Revenue")
 	proto.RegisterType((*Destination)(nil), "distribution.Destination")
 	proto.RegisterType((*CreateMsg)(nil), "distribution.CreateMsg")
 	proto.RegisterType((*DistributeMsg)(nil), "distribution.DistributeMsg")
 	proto.RegisterType((*ResetMsg)(nil), "distribution.ResetMsg")
+	proto.RegisterType((*Configuration)(nil), "distribution.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "distribution.UpdateConfigurationMsg")
+	proto.RegisterType((*ValidatorReward)(nil), "distribution.ValidatorReward")
 }
 
 func init() { proto.RegisterFile("x/distribution/codec.proto", fileDescriptor_186299c22854933b) }
 
 var fileDescriptor_186299c22854933b = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0xcd, 0x4a, 0xc3, 0x40,
-	0x10, 0xee, 0x5a, 0xfb, 0x37, 0x69, 0x11, 0x82, 0x48, 0xec, 0x21, 0x0d, 0xc1, 0x43, 0x41, 0x4d,
-	0xa0, 0xde, 0x04, 0x05, 0x6b, 0x2f, 0x3d, 0xf4, 0xb2, 0x2f, 0x20, 0xdb, 0xee, 0x90, 0xae, 0xd0,
-	0xac, 0x64, 0xb7, 0xad, 0x8f, 0xe1, 0x43, 0xf8, 0x06, 0xbe, 0x84, 0xc7, 0x1e, 0x3d, 0x15, 0x49,
-	0x9f, 0xc0, 0xab, 0x27, 0x69, 0x92, 0x6a, 0x3c, 0x56, 0xf1, 0xe0, 0x6d, 0x76, 0xe6, 0xfb, 0xbe,
-	0xf9, 0xf8, 0x98, 0x85, 0xe6, 0xbd, 0xcf, 0x85, 0xd2, 0x91, 0x18, 0x4e, 0xb5, 0x90, 0xa1, 0x3f,
-	0x92, 0x1c, 0x47, 0xde, 0x5d, 0x24, 0xb5, 0x34, 0xeb, 0xf9, 0x49, 0xd3, 0xc8, 0x8d, 0x9a, 0xfb,
-	0x81, 0x0c, 0x64, 0x52, 0xfa, 0xeb, 0x2a, 0xed, 0xba, 0x6f, 0x04, 0x2a, 0x14, 0x67, 0x18, 0x4e,
-	0xd1, 0x3c, 0x86, 0xea, 0x04, 0x35, 0xe3, 0x4c, 0x33, 0x8b, 0x38, 0xa4, 0x6d, 0x74, 0xf6, 0xbc,
-	0x39, 0xb2, 0x19, 0x7a, 0x83, 0xac, 0x4d, 0x3f, 0x01, 0xe6, 0x39, 0x94, 0x18, 0x9f, 0x88, 0xd0,
-	0xda, 0x71, 0x48, 0xbb, 0xde, 0x3d, 0x7a, 0x5f, 0xb6, 0x9c, 0x40, 0xe8, 0xf1, 0x74, 0xe8, 0x8d,
-	0xe4, 0xc4, 0x17, 0x72, 0x76, 0x2a, 0x43, 0xf4, 0x53, 0xfe, 0x15, 0xe7, 0x11, 0x2a, 0x45, 0x53,
-	0x8a, 0x79, 0x01, 0x75, 0x8e, 0x4a, 0x8b, 0x90, 0xad, 0x6d, 0x2a, 0xab, 0xe8, 0x14, 0xdb, 0x46,
-	0xe7, 0xd0, 0xcb, 0x9b, 0xf7, 0x7a, 0x5f, 0x08, 0xfa, 0x0d, 0x6e, 0x5e, 0x42, 0x85, 0xa5, 0x82,
-	0xd6, 0xee, 0x16, 0xcb, 0x37, 0x24, 0x17, 0xc1, 0xc8, 0x89, 0xe7, 0xe5, 0xc8, 0x0f, 0xe4, 0xcc,
-	0x03, 0x28, 0xcf, 0x51, 0x04, 0x63, 0x9d, 0x44, 0x51, 0xa2, 0xd9, 0xcb, 0x7d, 0x22, 0x50, 0xbb,
-	0x8e, 0x90, 0x69, 0x1c, 0xa8, 0xe0, 0xbf, 0x84, 0xeb, 0xde, 0x42, 0xa3, 0xb7, 0x41, 0x6e, 0x6f,
-	0xfc, 0x04, 0x20, 0x4a, 0xaf, 0xe9, 0x46, 0xf0, 0xcc, 0x7d, 0x23, 0x5e, 0xb6, 0x6a, 0xd9, 0x8d,
-	0xf5, 0x7b, 0xb4, 0x96, 0x01, 0xfa, 0xdc, 0x7d, 0x24, 0x50, 0xa5, 0xa8, 0x50, 0xff, 0xed, 0x9e,
-	0x5f, 0x46, 0xd2, 0xb5, 0x9e, 0x63, 0x9b, 0x2c, 0x62, 0x9b, 0xbc, 0xc6, 0x36, 0x79, 0x58, 0xd9,
-	0x85, 0xc5, 0xca, 0x2e, 0xbc, 0xac, 0xec, 0xc2, 0xb0, 0x9c, 0x7c, 0xa2, 0xb3, 0x8f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xad, 0xdc, 0xe2, 0x23, 0x93, 0x03, 0x00, 0x00,
+	// 516 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xcd, 0x8e, 0x12, 0x41,
+	0x10, 0xa6, 0x97, 0x65, 0x77, 0x29, 0xc0, 0xd5, 0xce, 0x66, 0x33, 0x62, 0x1c, 0xc8, 0xc4, 0x03,
+	0x89, 0x3a, 0x28, 0xde, 0x4c, 0x34, 0x91, 0xe5, 0xb2, 0x31, 0x9b, 0x6c, 0xda, 0xe8, 0x95, 0x34,
+	0x74, 0x39, 0xb4, 0x81, 0x69, 0xd2, 0xd3, 0xc0, 0xfa, 0x16, 0x5e, 0x7c, 0x03, 0xdf, 0xc0, 0x97,
+	0x58, 0x6f, 0x7b, 0xf4, 0x44, 0x0c, 0x3c, 0x81, 0x57, 0x4f, 0x66, 0x7e, 0x60, 0x67, 0xbc, 0xcd,
+	0x1a, 0x0f, 0xde, 0x66, 0xaa, 0xbe, 0xef, 0xeb, 0xaa, 0xaf, 0xab, 0x0b, 0xea, 0x17, 0x6d, 0x21,
+	0x03, 0xa3, 0xe5, 0x60, 0x66, 0xa4, 0xf2, 0xdb, 0x43, 0x25, 0x70, 0xe8, 0x4e, 0xb5, 0x32, 0x8a,
+	0x56, 0xd3, 0x99, 0x7a, 0x25, 0x95, 0xaa, 0x1f, 0x79, 0xca, 0x53, 0xd1, 0x67, 0x3b, 0xfc, 0x8a,
+	0xa3, 0xce, 0x4f, 0x02, 0xfb, 0x0c, 0xe7, 0xe8, 0xcf, 0x90, 0x3e, 0x84, 0x83, 0x09, 0x1a, 0x2e,
+	0xb8, 0xe1, 0x16, 0x69, 0x92, 0x56, 0xa5, 0x73, 0xe8, 0x2e, 0x90, 0xcf, 0xd1, 0x3d, 0x4b, 0xc2,
+	0x6c, 0x0b, 0xa0, 0xcf, 0xa1, 0xc4, 0xc5, 0x44, 0xfa, 0xd6, 0x4e, 0x93, 0xb4, 0xaa, 0xdd, 0x07,
+	0xbf, 0x96, 0x8d, 0xa6, 0x27, 0xcd, 0x68, 0x36, 0x70, 0x87, 0x6a, 0xd2, 0x96, 0x6a, 0xfe, 0x58,
+	0xf9, 0xd8, 0x8e, 0xf9, 0xaf, 0x84, 0xd0, 0x18, 0x04, 0x2c, 0xa6, 0xd0, 0x17, 0x50, 0x15, 0x18,
+	0x18, 0xe9, 0xf3, 0xb0, 0xcc, 0xc0, 0x2a, 0x36, 0x8b, 0xad, 0x4a, 0xe7, 0xae, 0x9b, 0x2e, 0xde,
+	0xed, 0x5d, 0x23, 0x58, 0x06, 0x4e, 0x5f, 0xc2, 0x3e, 0x8f, 0x05, 0xad, 0xdd, 0x1c, 0x87, 0x6f,
+	0x48, 0x0e, 0x42, 0x25, 0x25, 0x9e, 0x96, 0x23, 0x37, 0x90, 0xa3, 0xc7, 0xb0, 0xb7, 0x40, 0xe9,
+	0x8d, 0x4c, 0x64, 0x45, 0x89, 0x25, 0x7f, 0xce, 0x57, 0x02, 0xe5, 0x13, 0x8d, 0xdc, 0xe0, 0x59,
+	0xe0, 0xfd, 0x2f, 0xe6, 0x3a, 0x1f, 0xa0, 0xd6, 0xdb, 0x20, 0xf3, 0x17, 0xfe, 0x08, 0x40, 0xc7,
+	0xd3, 0xd4, 0x97, 0x22, 0xa9, 0xbe, 0xb6, 0x5a, 0x36, 0xca, 0xc9, 0x8c, 0x9d, 0xf6, 0x58, 0x39,
+	0x01, 0x9c, 0x0a, 0xe7, 0x0b, 0x81, 0x03, 0x86, 0x01, 0x9a, 0x7f, 0x7b, 0xce, 0xdf, 0x5a, 0xf2,
+	0x6d, 0x07, 0x6a, 0x27, 0xca, 0x7f, 0x2f, 0xbd, 0x99, 0x8e, 0x47, 0x26, 0x57, 0xad, 0xaf, 0xe1,
+	0xd6, 0x50, 0x4d, 0x26, 0x33, 0x5f, 0x9a, 0x8f, 0xfd, 0xa9, 0x52, 0xe3, 0x5c, 0xb7, 0x5a, 0xdb,
+	0x72, 0xcf, 0x95, 0x1a, 0xd3, 0x27, 0x70, 0x94, 0x15, 0xeb, 0x07, 0x23, 0xae, 0xd1, 0x2a, 0x36,
+	0x49, 0xab, 0xc6, 0x68, 0x06, 0xfc, 0x26, 0xcc, 0xd0, 0x73, 0xb8, 0x33, 0xe7, 0x63, 0x29, 0xb8,
+	0x51, 0xba, 0xaf, 0x71, 0xc1, 0xb5, 0x08, 0xdf, 0x4d, 0xe8, 0xc0, 0xfd, 0xac, 0x03, 0xef, 0x36,
+	0x30, 0x16, 0xa1, 0xba, 0xbb, 0x97, 0xcb, 0x46, 0x81, 0xdd, 0x9e, 0x67, 0xc3, 0x41, 0x38, 0x9d,
+	0x6a, 0xe1, 0xa3, 0xb6, 0x4a, 0x79, 0xa6, 0x33, 0xa2, 0x38, 0x17, 0x70, 0xfc, 0x76, 0x2a, 0xb8,
+	0xc1, 0x8c, 0xa1, 0xb9, 0xef, 0xff, 0x29, 0x94, 0xa6, 0xdc, 0x0c, 0x47, 0x91, 0x95, 0x95, 0xce,
+	0xbd, 0x6c, 0x23, 0x19, 0x6d, 0x16, 0x23, 0x9d, 0xcf, 0x04, 0x0e, 0xff, 0xe8, 0x90, 0x76, 0xa1,
+	0xbc, 0xed, 0x2e, 0xd7, 0xe3, 0xbf, 0xa6, 0xa5, 0xd7, 0xc7, 0xce, 0x0d, 0xd6, 0x47, 0xd7, 0xba,
+	0x5c, 0xd9, 0xe4, 0x6a, 0x65, 0x93, 0x1f, 0x2b, 0x9b, 0x7c, 0x5a, 0xdb, 0x85, 0xab, 0xb5, 0x5d,
+	0xf8, 0xbe, 0xb6, 0x0b, 0x83, 0xbd, 0x68, 0x45, 0x3f, 0xfb, 0x3d, 0x00, 0xcf, 0x2d, 0x38, 0x20,
+	0xf1, 0x05, 0x00, 0x00,
 }
 
 func (m *Revenue) Marshal() (dAtA []byte, err error) {
@@ -596,6 +811,131 @@ func (m *ResetMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.CommunityPool) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CommunityPool)))
+		i += copy(dAtA[i:], m.CommunityPool)
+	}
+	if m.CommunityPoolShare != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CommunityPoolShare))
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, msg := range m.ValidatorRewards {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n7, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	return i, nil
+}
+
+func (m *ValidatorReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReward) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Validator)))
+		i += copy(dAtA[i:], m.Validator)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -711,28 +1051,92 @@ func (m *ResetMsg) Size() (n int) {
 	return n
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CommunityPool)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CommunityPoolShare != 0 {
+		n += 1 + sovCodec(uint64(m.CommunityPoolShare))
+	}
+	if len(m.ValidatorRewards) > 0 {
+		for _, e := range m.ValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
 }
-func (m *Revenue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+
+func (m *ValidatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Revenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -815,17 +1219,401 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, &Destination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Destination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Destination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Destination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, &Destination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributeMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -852,14 +1640,16 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destinations = append(m.Destinations, &Destination{})
-			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -886,9 +1676,9 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevenueID == nil {
+				m.RevenueID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -915,7 +1705,7 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Destination) Unmarshal(dAtA []byte) error {
+func (m *ResetMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -938,15 +1728,51 @@ func (m *Destination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Destination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Destination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -973,16 +1799,16 @@ func (m *Destination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
+			if m.RevenueID == nil {
+				m.RevenueID = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -992,11 +1818,26 @@ func (m *Destination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, &Destination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1021,7 +1862,7 @@ func (m *Destination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+func (m *Configuration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,10 +1885,10 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1088,7 +1929,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1115,14 +1956,33 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.CommunityPool = append(m.CommunityPool[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommunityPool == nil {
+				m.CommunityPool = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolShare", wireType)
+			}
+			m.CommunityPoolShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommunityPoolShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1149,11 +2009,45 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destinations = append(m.Destinations, &Destination{})
-			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorRewards = append(m.ValidatorRewards, ValidatorReward{})
+			if err := m.ValidatorRewards[len(m.ValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1178,7 +2072,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,10 +2095,10 @@ func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DistributeMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DistributeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1245,9 +2139,9 @@ func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1257,24 +2151,26 @@ func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
-			if m.RevenueID == nil {
-				m.RevenueID = []byte{}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1301,7 +2197,7 @@ func (m *DistributeMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetMsg) Unmarshal(dAtA []byte) error {
+func (m *ValidatorReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,17 +2220,17 @@ func (m *ResetMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1344,31 +2240,29 @@ func (m *ResetMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Validator = append(m.Validator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Validator == nil {
+				m.Validator = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevenueID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1395,43 +2289,9 @@ func (m *ResetMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RevenueID = append(m.RevenueID[:0], dAtA[iNdEx:postIndex]...)
-			if m.RevenueID == nil {
-				m.RevenueID = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destinations = append(m.Destinations, &Destination{})
-			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -15,5 +15,14 @@ multisig contract as an admin address value.
 This functionality can be used to pay validators for their work. It is a
 transparent and trustful way to split income.
 
+Fees collected by the cash extension can also be paid out to the validators
+automatically. When the package configuration is present,
+FeeDistributionEndBlocker splits all collected fees at the end of every block
+among the validators that signed the last block, in proportion to their
+voting power. A configured share of the fees is sent to the community pool.
+Validators declare the address that they are paid to in the configuration.
+The owner of the configuration can change it, for example to add a reward
+address of a new validator, using UpdateConfigurationMsg.
+
 */
 package distribution
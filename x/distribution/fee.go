@@ -0,0 +1,195 @@
+package distribution
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+var _ gconf.OwnedConfig = (*Configuration)(nil)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	errs = errors.AppendField(errs, "CommunityPool", c.CommunityPool.Validate())
+	if c.CommunityPoolShare > 100 {
+		errs = errors.Append(errs,
+			errors.Field("CommunityPoolShare", errors.ErrInput, "must not be greater than 100"))
+	}
+	errs = errors.AppendField(errs, "ValidatorRewards", validateValidatorRewards(c.ValidatorRewards))
+	// Owner is optional. Without an owner, the configuration can be
+	// changed only via genesis.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	return errs
+}
+
+func validateValidatorRewards(rewards []ValidatorReward) error {
+	var errs error
+	validators := make(map[string]struct{})
+	for i, r := range rewards {
+		if len(r.Validator) == 0 {
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrEmpty, "validator %d", i))
+		} else if _, ok := validators[string(r.Validator)]; ok {
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrDuplicate, "validator %s", r.Validator))
+		} else {
+			validators[string(r.Validator)] = struct{}{}
+		}
+		if err := r.Address.Validate(); err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "address %d", i))
+		}
+	}
+	return errs
+}
+
+// FeeDistributionEndBlocker implements weave.EndBlocker. At the end of every
+// block it splits all fees collected by the cash extension among the
+// validators that signed the last block, in proportion to their voting power.
+//
+// Fee distribution is enabled only when the distribution package
+// configuration is present. A configured share of the fees is sent to the
+// community pool. The community pool also receives the share of each
+// validator that has no reward address declared and any leftover that is too
+// small to be split.
+type FeeDistributionEndBlocker struct {
+	ctrl CashController
+}
+
+var _ weave.EndBlocker = (*FeeDistributionEndBlocker)(nil)
+
+// NewFeeDistributionEndBlocker returns an end blocker that is using given
+// controller to pay the collected fees out.
+func NewFeeDistributionEndBlocker(ctrl CashController) *FeeDistributionEndBlocker {
+	return &FeeDistributionEndBlocker{ctrl: ctrl}
+}
+
+// EndBlock distributes all collected fees. Any failure is logged and the
+// fees are left on the collector account, so that they can be distributed
+// at the end of the next block.
+func (eb *FeeDistributionEndBlocker) EndBlock(ctx weave.Context, db weave.CacheableKVStore, summary weave.BlockSummary) {
+	var conf Configuration
+	switch err := gconf.Load(db, "distribution", &conf); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		// Fee distribution is not enabled.
+		return
+	default:
+		panic(errors.Wrap(err, "load configuration"))
+	}
+
+	cache := db.CacheWrap()
+	if err := eb.distribute(ctx, cache, conf); err != nil {
+		cache.Discard()
+		weave.GetLogger(ctx).Error("cannot distribute fees", "err", err)
+		return
+	}
+	if err := cache.Write(); err != nil {
+		panic(errors.Wrap(err, "cannot write fee distribution"))
+	}
+}
+
+func (eb *FeeDistributionEndBlocker) distribute(ctx weave.Context, db weave.KVStore, conf Configuration) error {
+	info, ok := weave.GetCommitInfo(ctx)
+	if !ok {
+		return errors.Wrap(errors.ErrState, "commit info not present in the context")
+	}
+	var totalPower int64
+	for _, v := range info.Votes {
+		if v.SignedLastBlock && v.Validator.Power > 0 {
+			totalPower += v.Validator.Power
+		}
+	}
+	// Without any signatures, there is no one to pay. Collected fees are
+	// distributed at the end of the next block.
+	if totalPower == 0 {
+		return nil
+	}
+
+	var cashConf cash.Configuration
+	if err := gconf.Load(db, "cash", &cashConf); err != nil {
+		return errors.Wrap(err, "load cash configuration")
+	}
+	collector := cashConf.CollectorAddress
+
+	balance, err := eb.ctrl.Balance(db, collector)
+	switch {
+	case err == nil:
+		balance, err = coin.NormalizeCoins(balance)
+		if err != nil {
+			return errors.Wrap(err, "cannot normalize balance")
+		}
+	case errors.ErrNotFound.Is(err):
+		// No fees were collected.
+		return nil
+	default:
+		return errors.Wrap(err, "cannot acquire collector account balance")
+	}
+
+	rewards := make(map[string]weave.Address, len(conf.ValidatorRewards))
+	for _, r := range conf.ValidatorRewards {
+		rewards[string(r.Validator)] = r.Address
+	}
+
+	for _, c := range balance {
+		if !c.IsPositive() {
+			continue
+		}
+		one, _, err := c.Divide(100)
+		if err != nil {
+			return errors.Wrap(err, "cannot split fees")
+		}
+		communityShare, err := one.Multiply(int64(conf.CommunityPoolShare))
+		if err != nil {
+			return errors.Wrap(err, "cannot compute community pool share")
+		}
+		validatorsShare, err := c.Subtract(communityShare)
+		if err != nil {
+			return errors.Wrap(err, "cannot compute validators share")
+		}
+		perPower, _, err := validatorsShare.Divide(totalPower)
+		if err != nil {
+			return errors.Wrap(err, "cannot split validators share")
+		}
+
+		// Whatever is not paid to the validators goes to the
+		// community pool.
+		leftover := *c
+		for _, v := range info.Votes {
+			if !v.SignedLastBlock || v.Validator.Power <= 0 {
+				continue
+			}
+			dest, ok := rewards[string(v.Validator.Address)]
+			if !ok {
+				continue
+			}
+			amount, err := perPower.Multiply(v.Validator.Power)
+			if err != nil {
+				return errors.Wrap(err, "cannot compute validator share")
+			}
+			if amount.IsZero() {
+				continue
+			}
+			if err := eb.ctrl.MoveCoins(db, collector, dest, amount); err != nil {
+				return errors.Wrapf(err, "cannot pay validator %X", v.Validator.Address)
+			}
+			if leftover, err = leftover.Subtract(amount); err != nil {
+				return errors.Wrap(err, "cannot compute leftover")
+			}
+		}
+		if leftover.IsZero() {
+			continue
+		}
+		if err := eb.ctrl.MoveCoins(db, collector, conf.CommunityPool, leftover); err != nil {
+			return errors.Wrap(err, "cannot pay community pool")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,431 @@
+package distribution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestFeeDistributionEndBlocker(t *testing.T) {
+	collector := weavetest.NewCondition().Address()
+	pool := weavetest.NewCondition().Address()
+	validator1 := weavetest.NewCondition().Address()
+	validator2 := weavetest.NewCondition().Address()
+	validator3 := weavetest.NewCondition().Address()
+	reward1 := weavetest.NewCondition().Address()
+	reward2 := weavetest.NewCondition().Address()
+
+	vote := func(validator weave.Address, power int64, signed bool) abci.VoteInfo {
+		return abci.VoteInfo{
+			Validator:       abci.Validator{Address: validator, Power: power},
+			SignedLastBlock: signed,
+		}
+	}
+
+	cases := map[string]struct {
+		Conf        *Configuration
+		Collected   []*coin.Coin
+		Votes       []abci.VoteInfo
+		WantBalance map[string]coin.Coins
+	}{
+		"fee distribution not configured": {
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV")},
+			Votes:     []abci.VoteInfo{vote(validator1, 1, true)},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): {coin.NewCoinp(4, 0, "IOV")},
+			},
+		},
+		"fees are split in proportion to voting power": {
+			Conf: &Configuration{
+				CommunityPool: pool,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+					{Validator: validator2, Address: reward2},
+				},
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV")},
+			Votes: []abci.VoteInfo{
+				vote(validator1, 1, true),
+				vote(validator2, 3, true),
+			},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): nil,
+				reward1.String():   {coin.NewCoinp(1, 0, "IOV")},
+				reward2.String():   {coin.NewCoinp(3, 0, "IOV")},
+				pool.String():      nil,
+			},
+		},
+		"community pool share": {
+			Conf: &Configuration{
+				CommunityPool:      pool,
+				CommunityPoolShare: 25,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+					{Validator: validator2, Address: reward2},
+				},
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV")},
+			Votes: []abci.VoteInfo{
+				vote(validator1, 1, true),
+				vote(validator2, 3, true),
+			},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): nil,
+				reward1.String():   {coin.NewCoinp(0, 750000000, "IOV")},
+				reward2.String():   {coin.NewCoinp(2, 250000000, "IOV")},
+				pool.String():      {coin.NewCoinp(1, 0, "IOV")},
+			},
+		},
+		"validators that did not sign are not paid": {
+			Conf: &Configuration{
+				CommunityPool: pool,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+					{Validator: validator2, Address: reward2},
+				},
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV")},
+			Votes: []abci.VoteInfo{
+				vote(validator1, 1, true),
+				vote(validator2, 3, false),
+			},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): nil,
+				reward1.String():   {coin.NewCoinp(4, 0, "IOV")},
+				reward2.String():   nil,
+			},
+		},
+		"share of a validator without a reward address goes to the community pool": {
+			Conf: &Configuration{
+				CommunityPool: pool,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+				},
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV"), coin.NewCoinp(8, 0, "ETH")},
+			Votes: []abci.VoteInfo{
+				vote(validator1, 1, true),
+				vote(validator3, 1, true),
+			},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): nil,
+				reward1.String():   {coin.NewCoinp(4, 0, "ETH"), coin.NewCoinp(2, 0, "IOV")},
+				pool.String():      {coin.NewCoinp(4, 0, "ETH"), coin.NewCoinp(2, 0, "IOV")},
+			},
+		},
+		"leftover too small to split goes to the community pool": {
+			Conf: &Configuration{
+				CommunityPool: pool,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+					{Validator: validator2, Address: reward2},
+				},
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(0, 3, "IOV")},
+			Votes: []abci.VoteInfo{
+				vote(validator1, 1, true),
+				vote(validator2, 1, true),
+			},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): nil,
+				reward1.String():   {coin.NewCoinp(0, 1, "IOV")},
+				reward2.String():   {coin.NewCoinp(0, 1, "IOV")},
+				pool.String():      {coin.NewCoinp(0, 1, "IOV")},
+			},
+		},
+		"without signatures nothing is distributed": {
+			Conf: &Configuration{
+				CommunityPool:      pool,
+				CommunityPoolShare: 50,
+			},
+			Collected: []*coin.Coin{coin.NewCoinp(4, 0, "IOV")},
+			Votes:     []abci.VoteInfo{vote(validator1, 1, false)},
+			WantBalance: map[string]coin.Coins{
+				collector.String(): {coin.NewCoinp(4, 0, "IOV")},
+				pool.String():      nil,
+			},
+		},
+		"no fees collected": {
+			Conf: &Configuration{
+				CommunityPool: pool,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator1, Address: reward1},
+				},
+			},
+			Votes: []abci.VoteInfo{vote(validator1, 1, true)},
+			WantBalance: map[string]coin.Coins{
+				reward1.String(): nil,
+				pool.String():    nil,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+
+			cashConf := cash.Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: collector,
+			}
+			if err := gconf.Save(db, "cash", &cashConf); err != nil {
+				t.Fatalf("cannot save cash configuration: %s", err)
+			}
+			if tc.Conf != nil {
+				tc.Conf.Metadata = &weave.Metadata{Schema: 1}
+				if err := gconf.Save(db, "distribution", tc.Conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+			if len(tc.Collected) != 0 {
+				wallet, err := cash.WalletWith(collector, tc.Collected...)
+				if err != nil {
+					t.Fatalf("cannot create collector wallet: %s", err)
+				}
+				if err := cash.NewBucket().Save(db, wallet); err != nil {
+					t.Fatalf("cannot save collector wallet: %s", err)
+				}
+			}
+
+			ctrl := cash.NewController(cash.NewBucket())
+			ctx := weave.WithCommitInfo(context.Background(), weave.CommitInfo{Votes: tc.Votes})
+			NewFeeDistributionEndBlocker(ctrl).EndBlock(ctx, db, weave.BlockSummary{})
+
+			for addr, want := range tc.WantBalance {
+				a, err := weave.ParseAddress(addr)
+				if err != nil {
+					t.Fatalf("cannot parse address: %s", err)
+				}
+				got, err := ctrl.Balance(db, a)
+				if err != nil && !errors.ErrNotFound.Is(err) {
+					t.Fatalf("cannot get %s balance: %s", addr, err)
+				}
+				if len(want) == 0 {
+					if !got.IsEmpty() {
+						t.Errorf("want %s balance to be zero, got %v", addr, got)
+					}
+					continue
+				}
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	validator := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Conf    Configuration
+		WantErr *errors.Error
+	}{
+		"valid configuration": {
+			Conf: Configuration{
+				Metadata:           &weave.Metadata{Schema: 1},
+				CommunityPool:      weavetest.NewCondition().Address(),
+				CommunityPoolShare: 100,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator, Address: weavetest.NewCondition().Address()},
+				},
+			},
+		},
+		"community pool is required": {
+			Conf: Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"community pool share above 100": {
+			Conf: Configuration{
+				Metadata:           &weave.Metadata{Schema: 1},
+				CommunityPool:      weavetest.NewCondition().Address(),
+				CommunityPoolShare: 101,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"duplicated validator": {
+			Conf: Configuration{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommunityPool: weavetest.NewCondition().Address(),
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator, Address: weavetest.NewCondition().Address()},
+					{Validator: validator, Address: weavetest.NewCondition().Address()},
+				},
+			},
+			WantErr: errors.ErrDuplicate,
+		},
+		"reward address is required": {
+			Conf: Configuration{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommunityPool: weavetest.NewCondition().Address(),
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator},
+				},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"invalid owner": {
+			Conf: Configuration{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommunityPool: weavetest.NewCondition().Address(),
+				Owner:         weave.Address("invalid"),
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Conf.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateConfigurationMsg(t *testing.T) {
+	validator := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ValidatorRewards: []ValidatorReward{
+						{Validator: validator, Address: weavetest.NewCondition().Address()},
+					},
+				},
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &UpdateConfigurationMsg{
+				Patch: &Configuration{CommunityPoolShare: 10},
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"community pool share above 100": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{CommunityPoolShare: 101},
+			},
+			WantErr: errors.ErrInput,
+		},
+		"duplicated validator": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ValidatorRewards: []ValidatorReward{
+						{Validator: validator, Address: weavetest.NewCondition().Address()},
+						{Validator: validator, Address: weavetest.NewCondition().Address()},
+					},
+				},
+			},
+			WantErr: errors.ErrDuplicate,
+		},
+		"invalid owner": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{Owner: weave.Address("invalid")},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationHandler(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+	validator := weavetest.NewCondition().Address()
+	oldReward := weavetest.NewCondition().Address()
+	newReward := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Signer      weave.Condition
+		WantErr     *errors.Error
+		WantRewards []ValidatorReward
+	}{
+		"owner can update validator rewards": {
+			Signer: owner,
+			WantRewards: []ValidatorReward{
+				{Validator: validator, Address: newReward},
+			},
+		},
+		"only the owner can update the configuration": {
+			Signer:  stranger,
+			WantErr: errors.ErrUnauthorized,
+			WantRewards: []ValidatorReward{
+				{Validator: validator, Address: oldReward},
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "distribution")
+			conf := Configuration{
+				Metadata:           &weave.Metadata{Schema: 1},
+				CommunityPool:      weavetest.NewCondition().Address(),
+				CommunityPoolShare: 10,
+				ValidatorRewards: []ValidatorReward{
+					{Validator: validator, Address: oldReward},
+				},
+				Owner: owner.Address(),
+			}
+			if err := gconf.Save(db, "distribution", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, &weavetest.Auth{Signer: tc.Signer}, cash.NewController(cash.NewBucket()))
+			tx := &weavetest.Tx{Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ValidatorRewards: []ValidatorReward{
+						{Validator: validator, Address: newReward},
+					},
+				},
+			}}
+			if _, err := rt.Deliver(context.TODO(), db, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			var got Configuration
+			if err := gconf.Load(db, "distribution", &got); err != nil {
+				t.Fatalf("cannot load configuration: %s", err)
+			}
+			assert.Equal(t, tc.WantRewards, got.ValidatorRewards)
+			assert.Equal(t, uint32(10), got.CommunityPoolShare)
+		})
+	}
+}
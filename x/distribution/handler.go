@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
@@ -47,6 +48,14 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, ctrl CashController)
 		bucket: bucket,
 		ctrl:   ctrl,
 	})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// NewConfigHandler returns a handler that allows the owner to update the fee
+// distribution configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("distribution", &conf, auth)
 }
 
 type createRevenueHandler struct {
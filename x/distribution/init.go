@@ -1,8 +1,12 @@
 package distribution
 
 import (
+	"encoding/binary"
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -10,18 +14,25 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisRevenue is the genesis file representation of a revenue.
+type genesisRevenue struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID           uint64               `json:"id,omitempty"`
+	Admin        weave.Address        `json:"admin"`
+	Destinations []genesisDestination `json:"destinations"`
+}
+
+type genesisDestination struct {
+	Address weave.Address `json:"address"`
+	Weight  int32         `json:"weight"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type destination struct {
-		Address weave.Address `json:"address"`
-		Weight  int32         `json:"weight"`
-	}
-	var revenues []struct {
-		Admin        weave.Address `json:"admin"`
-		Destinations []destination `json:"destinations"`
-	}
+	var revenues []genesisRevenue
 	if err := opts.ReadOptions("distribution", &revenues); err != nil {
 		return errors.Wrap(err, "cannot load distribution")
 	}
@@ -35,10 +46,21 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 				Weight:  rc.Weight,
 			})
 		}
-		key, err := revenueSeq.NextVal(kv)
+		var (
+			key []byte
+			err error
+		)
+		if r.ID != 0 {
+			key, err = revenueSeq.ReserveVal(kv, int64(r.ID))
+		} else {
+			key, err = revenueSeq.NextVal(kv)
+		}
 		if err != nil {
 			return errors.Wrap(err, "cannot acquire ID")
 		}
+		if err := bucket.Has(kv, key); err == nil {
+			return errors.Wrapf(errors.ErrDuplicate, "revenue with id %d", r.ID)
+		}
 		revenue := Revenue{
 			Metadata:     &weave.Metadata{Schema: 1},
 			Admin:        r.Admin,
@@ -49,5 +71,61 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrapf(err, "cannot store #%d revenue", i)
 		}
 	}
+
+	// Configuration is optional. Without it, collected fees are not
+	// distributed among the validators.
+	switch err := gconf.InitConfig(kv, opts, "distribution", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
+
+// ExportGenesis will write all revenues to opts, in the format expected by
+// FromGenesis. Revenue IDs are preserved so that the revenue account
+// addresses do not change.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	it, err := NewRevenueBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate revenues")
+	}
+	defer it.Release()
+
+	revenues := []genesisRevenue{}
+	for {
+		var r Revenue
+		key, err := it.LoadNext(&r)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load revenue")
+		}
+		gr := genesisRevenue{
+			ID:    binary.BigEndian.Uint64(key),
+			Admin: r.Admin,
+		}
+		for _, d := range r.Destinations {
+			gr.Destinations = append(gr.Destinations, genesisDestination{
+				Address: d.Address,
+				Weight:  d.Weight,
+			})
+		}
+		revenues = append(revenues, gr)
+	}
+	raw, err := json.Marshal(revenues)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal revenues")
+	}
+	opts["distribution"] = raw
+
+	switch err := gconf.ExportConfig(db, opts, "distribution", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "export config")
+	}
 	return nil
 }
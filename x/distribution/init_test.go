@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -66,3 +67,54 @@ func TestGenesisKey(t *testing.T) {
 		t.Fatalf("unexected address: %q", r.Address)
 	}
 }
+
+func TestGenesisConfiguration(t *testing.T) {
+	const genesis = `
+		{
+			"conf": {
+				"distribution": {
+					"metadata": {"schema": 1},
+					"community_pool": "seq:dist/revenue/1",
+					"community_pool_share": 10,
+					"validator_rewards": [
+						{
+							"validator": "E94323317C46BDA2268FA3698BAF4F95B893E8C7",
+							"address": "FE5526DE08337DFEF5CF45EF3ED8C577B854DE34"
+						}
+					]
+				}
+			},
+			"distribution": []
+		}
+	`
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "distribution")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+
+	var conf Configuration
+	if err := gconf.Load(db, "distribution", &conf); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if conf.CommunityPoolShare != 10 {
+		t.Fatalf("unexpected community pool share: %d", conf.CommunityPoolShare)
+	}
+	if n := len(conf.ValidatorRewards); n != 1 {
+		t.Fatalf("want one validator reward, got %d", n)
+	}
+
+	exported := make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis: %s", err)
+	}
+	if _, ok := exported["conf"]; !ok {
+		t.Fatal("configuration not exported")
+	}
+}
@@ -10,6 +10,7 @@ func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DistributeMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ResetMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -59,3 +60,30 @@ func (msg *ResetMsg) Validate() error {
 func (ResetMsg) Path() string {
 	return "distribution/reset"
 }
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (UpdateConfigurationMsg) Path() string {
+	return "distribution/update_configuration"
+}
+
+// Validate will skip any zero fields and validate the set ones.
+func (msg *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.Patch == nil {
+		return errors.Append(errs, errors.Field("Patch", errors.ErrEmpty, "required"))
+	}
+	if len(msg.Patch.CommunityPool) != 0 {
+		errs = errors.AppendField(errs, "Patch.CommunityPool", msg.Patch.CommunityPool.Validate())
+	}
+	if msg.Patch.CommunityPoolShare > 100 {
+		errs = errors.Append(errs,
+			errors.Field("Patch.CommunityPoolShare", errors.ErrInput, "must not be greater than 100"))
+	}
+	errs = errors.AppendField(errs, "Patch.ValidatorRewards", validateValidatorRewards(msg.Patch.ValidatorRewards))
+	if len(msg.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", msg.Patch.Owner.Validate())
+	}
+	return errs
+}
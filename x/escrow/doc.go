@@ -13,6 +13,12 @@ The arbiter or source (sender) can release them to the recipient.
 The recipient (destination) can return them to the sender (source).
 Upon timeout, they will be returned to the sender (source).
 
+When an escrow is created, a return message is scheduled for execution at the
+timeout. Expired escrows are therefore returned to the source automatically,
+without anyone sending the return message. The task is cancelled when the
+escrow is fully released or returned manually. Escrows loaded from the
+genesis file without a return task ID get the return scheduled as well.
+
 
 */
 package escrow
@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 )
 
 const (
@@ -19,14 +20,15 @@ const (
 )
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+// all handlers in this package. Given scheduler is used to automatically
+// return the escrow content to the source once the escrow expires.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("escrow", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl, scheduler})
 	r.Handle(&UpdatePartiesMsg{}, UpdateEscrowHandler{auth, bucket})
 }
 
@@ -37,9 +39,10 @@ func RegisterQuery(qr weave.QueryRouter) {
 
 // CreateEscrowHandler will set a name for objects in this bucket
 type CreateEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = CreateEscrowHandler{}
@@ -87,6 +90,11 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 		Memo:        msg.Memo,
 		Address:     Condition(key).Address(),
 	}
+	taskID, err := scheduleReturn(db, h.scheduler, key, escrow.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	escrow.ReturnTaskID = taskID
 	if _, err := h.bucket.Put(db, key, escrow); err != nil {
 		return nil, errors.Wrap(err, "cannot store escrow")
 	}
@@ -121,9 +129,10 @@ func (h CreateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 // ReleaseEscrowHandler will set a name for objects in this bucket.
 type ReleaseEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReleaseEscrowHandler{}
@@ -169,10 +178,13 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 	if remainingCoins.IsPositive() {
 		return &weave.DeliverResult{Data: msg.EscrowId}, nil
 	}
-	// Delete escrow when empty.
+	// Delete escrow when empty. There is nothing left to return.
 	if err := h.bucket.Delete(db, msg.EscrowId); err != nil {
 		return nil, err
 	}
+	if err := cron.DeleteTask(db, h.scheduler, escrow.ReturnTaskID); err != nil {
+		return nil, err
+	}
 	return &weave.DeliverResult{}, nil
 }
 
@@ -202,9 +214,10 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 
 // ReturnEscrowHandler will set a name for objects in this bucket
 type ReturnEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
 }
 
 var _ weave.Handler = ReturnEscrowHandler{}
@@ -241,6 +254,11 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 	if err := h.bucket.Delete(db, key); err != nil {
 		return nil, err
 	}
+	// When returned manually, the scheduled return task is no longer
+	// needed.
+	if err := cron.DeleteTask(db, h.scheduler, escrow.ReturnTaskID); err != nil {
+		return nil, err
+	}
 	return &weave.DeliverResult{}, nil
 }
 
@@ -344,3 +362,19 @@ func (h UpdateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 	return &msg, &escrow, nil
 }
+
+// scheduleReturn queues a message that returns the content of the escrow
+// with given ID to its source as soon as the escrow expires. Returned is the
+// ID of the scheduled task.
+func scheduleReturn(db weave.KVStore, scheduler weave.Scheduler, escrowID []byte, timeout weave.UnixTime) ([]byte, error) {
+	msg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: escrowID,
+	}
+	// Return message requires no authentication.
+	taskID, err := scheduler.Schedule(db, timeout.Time(), nil, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+	return taskID, nil
+}
@@ -501,7 +501,7 @@ func TestHandler(t *testing.T) {
 	auth := authenticator()
 	// create handler objects and query objects
 	router := app.NewRouter()
-	RegisterRoutes(router, auth, ctrl)
+	RegisterRoutes(router, auth, ctrl, &weavetest.Cron{})
 	cash.RegisterRoutes(router, auth, ctrl)
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -615,6 +615,10 @@ func (q query) check(t testing.TB, db weave.ReadOnlyKVStore, qr weave.QueryRoute
 		// parse out value
 		got, err := q.bucket.Parse(nil, mods[i].Value)
 		assert.Nil(t, err)
+		if e, ok := got.Value().(*Escrow); ok {
+			// ReturnTaskID is a random value that we do not care about.
+			e.ReturnTaskID = nil
+		}
 		assert.Equal(t, ex.Value(), got.Value())
 	}
 }
@@ -627,3 +631,196 @@ func mo(obj orm.Object, err error) orm.Object {
 	}
 	return obj
 }
+
+func TestAutomaticReturn(t *testing.T) {
+	source := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+	destination := weavetest.NewCondition()
+	newSource := weavetest.NewCondition()
+
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+	some := mustCombineCoins(coin.NewCoin(30, 0, "FOO"))
+	remain := mustCombineCoins(coin.NewCoin(70, 0, "FOO"))
+
+	escrowID := weavetest.SequenceID(1)
+
+	cases := map[string]struct {
+		// When set, the escrow is created without a return task, as
+		// if it was created before the automatic return was
+		// introduced.
+		Legacy bool
+		// Actions executed after the escrow is created and before it
+		// expires.
+		Actions []action
+		// When true, the return task is expected to be due once the
+		// escrow expires.
+		WantTask       bool
+		WantBalances   map[string]coin.Coins
+		WantEscrowGone bool
+	}{
+		"expired escrow is returned to the source": {
+			WantTask: true,
+			WantBalances: map[string]coin.Coins{
+				"source":      all,
+				"destination": nil,
+			},
+			WantEscrowGone: true,
+		},
+		"released escrow is not returned": {
+			Actions: []action{
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+			},
+			WantTask: false,
+			WantBalances: map[string]coin.Coins{
+				"source":      nil,
+				"destination": all,
+			},
+			WantEscrowGone: true,
+		},
+		"remaining content of a partially released escrow is returned": {
+			Actions: []action{
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+						Amount:   some,
+					},
+				},
+			},
+			WantTask: true,
+			WantBalances: map[string]coin.Coins{
+				"source":      remain,
+				"destination": some,
+			},
+			WantEscrowGone: true,
+		},
+		"manually returned escrow cancels the return task": {
+			Actions: []action{
+				{
+					msg: &ReturnMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+					blockTime: Timeout.Time(),
+				},
+			},
+			WantTask: false,
+			WantBalances: map[string]coin.Coins{
+				"source":      all,
+				"destination": nil,
+			},
+			WantEscrowGone: true,
+		},
+		"return is made to the updated source": {
+			Actions: []action{
+				{
+					perms: []weave.Condition{source},
+					msg: &UpdatePartiesMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+						Source:   newSource.Address(),
+					},
+				},
+			},
+			WantTask: true,
+			WantBalances: map[string]coin.Coins{
+				"source":    nil,
+				"newSource": all,
+			},
+			WantEscrowGone: true,
+		},
+		"legacy escrow is not returned": {
+			Legacy:   true,
+			WantTask: false,
+			WantBalances: map[string]coin.Coins{
+				"source": nil,
+			},
+			WantEscrowGone: false,
+		},
+	}
+
+	addresses := map[string]weave.Address{
+		"source":      source.Address(),
+		"destination": destination.Address(),
+		"newSource":   newSource.Address(),
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			scheduler := &weavetest.Cron{}
+			auth := authenticator()
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, ctrl, scheduler)
+
+			create := createAction(source, destination, arbiter, all, "")
+			if tc.Legacy {
+				// Schedule the return using a different
+				// scheduler so that it is never executed.
+				legacy := CreateEscrowHandler{auth, NewBucket(), ctrl, &weavetest.Cron{}}
+				if _, err := legacy.Deliver(create.ctx(), db, create.tx()); err != nil {
+					t.Fatalf("cannot create escrow: %s", err)
+				}
+				var escrow Escrow
+				assert.Nil(t, NewBucket().One(db, escrowID, &escrow))
+				escrow.ReturnTaskID = nil
+				_, err := NewBucket().Put(db, escrowID, &escrow)
+				assert.Nil(t, err)
+			} else if _, err := rt.Deliver(create.ctx(), db, create.tx()); err != nil {
+				t.Fatalf("cannot create escrow: %s", err)
+			}
+
+			for i, a := range tc.Actions {
+				if _, err := rt.Deliver(a.ctx(), db, a.tx()); err != nil {
+					t.Fatalf("cannot deliver #%d action: %s", i, err)
+				}
+			}
+
+			// Cron executes tasks scheduled before the block time.
+			tickCtx := weave.WithBlockTime(context.Background(), Timeout.Time().Add(time.Second))
+			executed := scheduler.Tick(tickCtx, db).Tags
+			assert.Equal(t, tc.WantTask, len(executed) == 1)
+			if tc.WantTask {
+				// Execute the task the way cron does, without any
+				// signature.
+				ret := action{
+					msg: &ReturnMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+					blockTime: Timeout.Time().Add(time.Second),
+				}
+				if _, err := rt.Deliver(ret.ctx(), db, ret.tx()); err != nil {
+					t.Fatalf("cannot execute return task: %s", err)
+				}
+			}
+
+			for name, want := range tc.WantBalances {
+				got, err := ctrl.Balance(db, addresses[name])
+				if err != nil && !errors.ErrNotFound.Is(err) {
+					t.Fatalf("cannot get %s balance: %s", name, err)
+				}
+				if !got.Equals(want) {
+					t.Errorf("want %s balance of %v, got %v", name, want, got)
+				}
+			}
+			err = NewBucket().Has(db, escrowID)
+			assert.Equal(t, tc.WantEscrowGone, errors.ErrNotFound.Is(err))
+		})
+	}
+}
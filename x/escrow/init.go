@@ -1,45 +1,86 @@
 package escrow
 
 import (
+	"encoding/binary"
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/cash"
-	"github.com/pkg/errors"
 )
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
 
 // Initializer fulfils the Initializer interface to load data from the genesis file
 type Initializer struct {
 	Minter cash.CoinMinter
+	// Scheduler is used to schedule the automatic return of escrows that
+	// are loaded without a return task.
+	Scheduler weave.Scheduler
+}
+
+// genesisEscrow is the genesis file representation of an escrow.
+type genesisEscrow struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID          uint64         `json:"id,omitempty"`
+	Source      weave.Address  `json:"source"`
+	Arbiter     weave.Address  `json:"arbiter"`
+	Destination weave.Address  `json:"destination"`
+	Timeout     weave.UnixTime `json:"timeout"`
+	Memo        string         `json:"memo,omitempty"`
+	Amount      []*coin.Coin   `json:"amount,omitempty"`
+	// ReturnTaskID is optional. If not set, a new return task is
+	// scheduled. Otherwise the task must be already present in the cron
+	// queue.
+	ReturnTaskID []byte `json:"return_task_id,omitempty"`
 }
 
 // FromGenesis will parse initial escrow  info from genesis and save it in the database.
+// The return of each escrow that has no return task declared is scheduled.
 func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var escrows []struct {
-		Source      weave.Address  `json:"source"`
-		Arbiter     weave.Address  `json:"arbiter"`
-		Destination weave.Address  `json:"destination"`
-		Timeout     weave.UnixTime `json:"timeout"`
-		Amount      []*coin.Coin   `json:"amount"`
-	}
-
+	var escrows []genesisEscrow
 	if err := opts.ReadOptions("escrow", &escrows); err != nil {
 		return err
 	}
 	bucket := NewBucket()
 	for _, e := range escrows {
-		key, err := escrowSeq.NextVal(kv)
+		var (
+			key []byte
+			err error
+		)
+		if e.ID != 0 {
+			key, err = escrowSeq.ReserveVal(kv, int64(e.ID))
+		} else {
+			key, err = escrowSeq.NextVal(kv)
+		}
 		if err != nil {
 			return errors.Wrap(err, "cannot acquire key")
 		}
+		if err := bucket.Has(kv, key); err == nil {
+			return errors.Wrapf(errors.ErrDuplicate, "escrow with id %d", e.ID)
+		}
 		escrow := Escrow{
-			Metadata:    &weave.Metadata{Schema: 1},
-			Source:      e.Source,
-			Arbiter:     e.Arbiter,
-			Destination: e.Destination,
-			Timeout:     e.Timeout,
-			Address:     Condition(key).Address(),
+			Metadata:     &weave.Metadata{Schema: 1},
+			Source:       e.Source,
+			Arbiter:      e.Arbiter,
+			Destination:  e.Destination,
+			Timeout:      e.Timeout,
+			Memo:         e.Memo,
+			Address:      Condition(key).Address(),
+			ReturnTaskID: e.ReturnTaskID,
+		}
+		if len(escrow.ReturnTaskID) == 0 {
+			taskID, err := scheduleReturn(kv, i.Scheduler, key, escrow.Timeout)
+			if err != nil {
+				return err
+			}
+			escrow.ReturnTaskID = taskID
+		} else if ok, err := kv.Has(escrow.ReturnTaskID); err != nil {
+			return errors.Wrap(err, "cannot check return task")
+		} else if !ok {
+			return errors.Wrapf(errors.ErrNotFound, "return task of escrow with id %d", e.ID)
 		}
 		if _, err := bucket.Put(kv, key, &escrow); err != nil {
 			return errors.Wrap(err, "cannot save escrow")
@@ -52,3 +93,44 @@ func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams
 	}
 	return nil
 }
+
+// ExportGenesis will write all escrows to opts, in the format expected by
+// FromGenesis. Escrow IDs are preserved so that the escrow addresses do not
+// change. Amount is not exported, because the funds are held by the escrow
+// address and exported together with all other balances by the cash
+// extension. The return task is exported by the cron extension, so only its
+// ID is written.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	it, err := NewBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate escrows")
+	}
+	defer it.Release()
+
+	escrows := []genesisEscrow{}
+	for {
+		var e Escrow
+		key, err := it.LoadNext(&e)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load escrow")
+		}
+		escrows = append(escrows, genesisEscrow{
+			ID:           binary.BigEndian.Uint64(key),
+			Source:       e.Source,
+			Arbiter:      e.Arbiter,
+			Destination:  e.Destination,
+			Timeout:      e.Timeout,
+			Memo:         e.Memo,
+			ReturnTaskID: e.ReturnTaskID,
+		})
+	}
+	raw, err := json.Marshal(escrows)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal escrows")
+	}
+	opts["escrow"] = raw
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -44,7 +45,7 @@ func TestGenesisKey(t *testing.T) {
 
 	// when
 	cashCtrl := cash.NewController(cash.NewBucket())
-	ini := Initializer{Minter: cashCtrl}
+	ini := Initializer{Minter: cashCtrl, Scheduler: &weavetest.Cron{}}
 	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, db))
 
 	// then
@@ -56,6 +57,9 @@ func TestGenesisKey(t *testing.T) {
 	assert.Equal(t, "c30a2424104f542576ef01feca2ff558f5eaa61a", hex.EncodeToString(e.Destination))
 	assert.Equal(t, "0000000000000000000000000000000000000000", hex.EncodeToString(e.Source))
 	assert.Equal(t, "0000000000000000000000000000000000000001", hex.EncodeToString(e.Arbiter))
+	if len(e.ReturnTaskID) == 0 {
+		t.Fatal("escrow return not scheduled")
+	}
 
 	balance, err := cashCtrl.Balance(db, e.Address)
 	assert.Nil(t, err)
@@ -63,3 +67,28 @@ func TestGenesisKey(t *testing.T) {
 	assert.Equal(t, coin.Coin{Ticker: "ALX", Whole: 987654321}, *balance[0])
 	assert.Equal(t, coin.Coin{Ticker: "IOV", Whole: 123456789}, *balance[1])
 }
+
+func TestGenesisMissingReturnTask(t *testing.T) {
+	const genesis = `
+{
+  "escrow": [
+    {
+      "arbiter": "0000000000000000000000000000000000000001",
+      "destination": "C30A2424104F542576EF01FECA2FF558F5EAA61A",
+      "source": "0000000000000000000000000000000000000000",
+      "timeout": "2034-11-10T23:00:00Z",
+      "return_task_id": "bm90IGEgdGFzaw=="
+    }
+  ]}`
+
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesis), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+
+	ini := Initializer{Minter: cash.NewController(cash.NewBucket()), Scheduler: &weavetest.Cron{}}
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want ErrNotFound, got %+v", err)
+	}
+}
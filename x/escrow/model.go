@@ -38,13 +38,14 @@ func (e *Escrow) Validate() error {
 // Copy makes a new set with the same coins
 func (e *Escrow) Copy() orm.CloneableData {
 	return &Escrow{
-		Metadata:    e.Metadata.Copy(),
-		Source:      e.Source,
-		Arbiter:     e.Arbiter,
-		Destination: e.Destination,
-		Timeout:     e.Timeout,
-		Memo:        e.Memo,
-		Address:     e.Address.Clone(),
+		Metadata:     e.Metadata.Copy(),
+		Source:       e.Source,
+		Arbiter:      e.Arbiter,
+		Destination:  e.Destination,
+		Timeout:      e.Timeout,
+		Memo:         e.Memo,
+		Address:      e.Address.Clone(),
+		ReturnTaskID: append([]byte(nil), e.ReturnTaskID...),
 	}
 }
 
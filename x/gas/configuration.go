@@ -0,0 +1,91 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+var _ gconf.OwnedConfig = (*Configuration)(nil)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	// Owner is optional. Without an owner, the configuration cannot be
+	// changed after the genesis.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	return errors.Append(errs, c.validateValues())
+}
+
+// validateValues ensures that none of the limits and costs is negative.
+func (c *Configuration) validateValues() error {
+	var errs error
+	values := []struct {
+		name  string
+		value int64
+	}{
+		{"TxGasLimit", c.TxGasLimit},
+		{"BlockGasLimit", c.BlockGasLimit},
+		{"HasCost", c.HasCost},
+		{"ReadCostFlat", c.ReadCostFlat},
+		{"ReadCostPerByte", c.ReadCostPerByte},
+		{"WriteCostFlat", c.WriteCostFlat},
+		{"WriteCostPerByte", c.WriteCostPerByte},
+		{"DeleteCost", c.DeleteCost},
+		{"IterNextCostFlat", c.IterNextCostFlat},
+		{"IterNextCostPerByte", c.IterNextCostPerByte},
+	}
+	for _, v := range values {
+		if v.value < 0 {
+			errs = errors.Append(errs, errors.Field(v.name, errors.ErrInput, "must not be negative"))
+		}
+	}
+	return errs
+}
+
+// Rules returns the gas rules declared by this configuration. Costs that are
+// not set are replaced by the default ones.
+func (c *Configuration) Rules() store.GasRules {
+	costs := store.DefaultGasConfig()
+	override := func(dst *int64, value int64) {
+		if value != 0 {
+			*dst = value
+		}
+	}
+	override(&costs.HasCost, c.HasCost)
+	override(&costs.ReadCostFlat, c.ReadCostFlat)
+	override(&costs.ReadCostPerByte, c.ReadCostPerByte)
+	override(&costs.WriteCostFlat, c.WriteCostFlat)
+	override(&costs.WriteCostPerByte, c.WriteCostPerByte)
+	override(&costs.DeleteCost, c.DeleteCost)
+	override(&costs.IterNextCostFlat, c.IterNextCostFlat)
+	override(&costs.IterNextCostPerByte, c.IterNextCostPerByte)
+	return store.GasRules{
+		Costs:      costs,
+		TxLimit:    c.TxGasLimit,
+		BlockLimit: c.BlockGasLimit,
+	}
+}
+
+// LoadRules is a store.GasRulesLoader that returns the gas rules declared by
+// the configuration of this package. Because the configuration is optional,
+// if it does not exist the default costs without any limits are returned.
+func LoadRules(db weave.ReadOnlyKVStore) (store.GasRules, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "gas", &conf); {
+	case err == nil:
+		return conf.Rules(), nil
+	case errors.ErrNotFound.Is(err):
+		return store.DefaultGasRules(db)
+	default:
+		return store.GasRules{}, errors.Wrap(err, "load configuration")
+	}
+}
@@ -0,0 +1,99 @@
+package gas
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestConfigurationValidation(t *testing.T) {
+	cases := map[string]struct {
+		Conf Configuration
+		// Field name to error mapping. Use `nil` if no error is expected.
+		WantErrs map[string]*errors.Error
+	}{
+		"valid configuration": {
+			Conf: Configuration{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Owner:         weavetest.NewCondition().Address(),
+				TxGasLimit:    1000,
+				BlockGasLimit: 100000,
+				WriteCostFlat: 10,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":      nil,
+				"Owner":         nil,
+				"TxGasLimit":    nil,
+				"BlockGasLimit": nil,
+				"WriteCostFlat": nil,
+			},
+		},
+		"all values are optional": {
+			Conf: Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"Owner":      nil,
+				"TxGasLimit": nil,
+			},
+		},
+		"negative values": {
+			Conf: Configuration{
+				Metadata:            &weave.Metadata{Schema: 1},
+				BlockGasLimit:       -1,
+				IterNextCostPerByte: -1,
+			},
+			WantErrs: map[string]*errors.Error{
+				"Metadata":            nil,
+				"TxGasLimit":          nil,
+				"BlockGasLimit":       errors.ErrInput,
+				"IterNextCostPerByte": errors.ErrInput,
+			},
+		},
+		"missing metadata": {
+			Conf: Configuration{},
+			WantErrs: map[string]*errors.Error{
+				"Metadata": errors.ErrMetadata,
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.Conf.Validate()
+			for field, wantErr := range tc.WantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	db := store.MemStore()
+
+	rules, err := LoadRules(db)
+	assert.Nil(t, err)
+	want := store.GasRules{Costs: store.DefaultGasConfig()}
+	assert.Equal(t, want, rules)
+
+	conf := Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		TxGasLimit:    1000,
+		BlockGasLimit: 5000,
+		WriteCostFlat: 7,
+	}
+	assert.Nil(t, gconf.Save(db, "gas", &conf))
+
+	rules, err = LoadRules(db)
+	assert.Nil(t, err)
+	want = store.GasRules{Costs: store.DefaultGasConfig(), TxLimit: 1000, BlockLimit: 5000}
+	// Costs that are not set are the default ones.
+	want.Costs.WriteCostFlat = 7
+	assert.Equal(t, want, rules)
+}
@@ -0,0 +1,16 @@
+/*
+Package gas implements the gas rules configuration.
+
+Gas costs of store operations and the gas limits decide the outcome of every
+transaction. In order for all nodes to process transactions the same way, they
+are declared in the state by the optional "gas" configuration, instead of
+being configured per node. LoadRules is a store.GasRulesLoader that returns
+the rules declared by the configuration, or the default costs without any
+limits if the configuration does not exist.
+
+The configuration can be changed by its owner using the UpdateConfigurationMsg.
+Set the owner to the address of an election rule in order to manage the gas
+rules via on-chain governance.
+
+*/
+package gas
@@ -0,0 +1,22 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x"
+)
+
+// RegisterRoutes registers the handler that allows the owner declared in the
+// configuration to update the gas rules.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("gas", r)
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// NewConfigHandler returns a handler that allows the owner to update the
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("gas", &conf, auth)
+}
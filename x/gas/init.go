@@ -0,0 +1,40 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// FromGenesis will load the configuration from the genesis file and save it
+// to the database.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	// Configuration is optional. Without it, the default costs are
+	// charged and the gas consumption is not limited.
+	switch err := gconf.InitConfig(kv, opts, "gas", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
+
+// ExportGenesis will write the configuration to opts, in the format expected
+// by FromGenesis.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	switch err := gconf.ExportConfig(db, opts, "gas", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "export config")
+	}
+	return nil
+}
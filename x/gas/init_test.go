@@ -0,0 +1,67 @@
+package gas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+)
+
+func TestGenesisConfiguration(t *testing.T) {
+	const genesis = `
+		{
+			"conf": {
+				"gas": {
+					"metadata": {"schema": 1},
+					"owner": "seq:gov/rule/1",
+					"tx_gas_limit": 100000,
+					"write_cost_flat": 500
+				}
+			}
+		}
+	`
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "gas")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+
+	rules, err := LoadRules(db)
+	if err != nil {
+		t.Fatalf("cannot load rules: %s", err)
+	}
+	if rules.TxLimit != 100000 || rules.Costs.WriteCostFlat != 500 {
+		t.Fatalf("unexpected rules: %+v", rules)
+	}
+
+	exported := make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis: %s", err)
+	}
+	if _, ok := exported["conf"]; !ok {
+		t.Fatal("configuration not exported")
+	}
+}
+
+func TestGenesisWithoutConfiguration(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "gas")
+	var ini Initializer
+	if err := ini.FromGenesis(weave.Options{}, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+	var conf Configuration
+	if err := gconf.Load(db, "gas", &conf); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want no configuration, got %+v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (UpdateConfigurationMsg) Path() string {
+	return "gas/update_configuration"
+}
+
+// Validate will skip any zero fields and validate the set ones.
+func (msg *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.Patch == nil {
+		return errors.Append(errs, errors.Field("Patch", errors.ErrEmpty, "required"))
+	}
+	if len(msg.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", msg.Patch.Owner.Validate())
+	}
+	return errors.Append(errs, msg.Patch.validateValues())
+}
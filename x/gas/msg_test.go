@@ -0,0 +1,58 @@
+package gas
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateUpdateConfigurationMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{TxGasLimit: 1000},
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &UpdateConfigurationMsg{
+				Patch: &Configuration{Owner: weavetest.NewCondition().Address()},
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"invalid owner": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{Owner: weave.Address("invalid")},
+			},
+			WantErr: errors.ErrInput,
+		},
+		"negative cost": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{ReadCostFlat: -1},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package gov
 
 import (
+	"bytes"
 	"encoding/binary"
+	"encoding/json"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -12,32 +15,48 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisGovernance is the genesis file representation of the governance
+// electorates and election rules.
+type genesisGovernance struct {
+	Electorate []genesisElectorate `json:"electorate"`
+	Rules      []genesisRule       `json:"rules"`
+}
+
+type genesisElectorate struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID       uint64           `json:"id,omitempty"`
+	Admin    weave.Address    `json:"admin"`
+	Title    string           `json:"title"`
+	Electors []genesisElector `json:"electors"`
+}
+
+type genesisElector struct {
+	Address weave.Address `json:"address"`
+	Weight  uint32        `json:"weight"`
+}
+
+type genesisRule struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID           uint64             `json:"id,omitempty"`
+	Admin        weave.Address      `json:"admin"`
+	ElectorateID uint64             `json:"electorate_id"`
+	Title        string             `json:"title"`
+	VotingPeriod weave.UnixDuration `json:"voting_period"`
+	Quorum       genesisFraction    `json:"quorum"`
+	Threshold    genesisFraction    `json:"threshold"`
+}
+
+type genesisFraction struct {
+	Numerator   uint32 `json:"numerator"`
+	Denominator uint32 `json:"denominator"`
+}
 
 // FromGenesis will parse initial governance electorate and election rules from genesis
 // and saves it in the database.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type fraction struct {
-		Numerator   uint32 `json:"numerator"`
-		Denominator uint32 `json:"denominator"`
-	}
-	var governance struct {
-		Electorate []struct {
-			Admin    weave.Address `json:"admin"`
-			Title    string        `json:"title"`
-			Electors []struct {
-				Address weave.Address `json:"address"`
-				Weight  uint32        `json:"weight"`
-			} `json:"electors"`
-		} `json:"electorate"`
-		Rules []struct {
-			Admin        weave.Address      `json:"admin"`
-			ElectorateID uint64             `json:"electorate_id"`
-			Title        string             `json:"title"`
-			VotingPeriod weave.UnixDuration `json:"voting_period"`
-			Quorum       fraction           `json:"quorum"`
-			Threshold    fraction           `json:"threshold"`
-		} `json:"rules"`
-	}
+	var governance genesisGovernance
 	if err := opts.ReadOptions("governance", &governance); err != nil {
 		return err
 	}
@@ -65,8 +84,19 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrapf(err, "electorate #%d is invalid", i)
 		}
 		sortByAddress(electorate.Electors)
-		if _, err := electBucket.Create(kv, &electorate); err != nil {
-			return err
+		if e.ID == 0 {
+			if _, err := electBucket.Create(kv, &electorate); err != nil {
+				return err
+			}
+			continue
+		}
+		seq := electBucket.Sequence("id")
+		id, err := seq.ReserveVal(kv, int64(e.ID))
+		if err != nil {
+			return errors.Wrap(err, "cannot reserve electorate ID")
+		}
+		if _, err := electBucket.CreateWithID(kv, id, &electorate); err != nil {
+			return errors.Wrapf(err, "electorate with id %d", e.ID)
 		}
 	}
 
@@ -78,7 +108,13 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		if err != nil {
 			return errors.Wrapf(err, "failed to load electorate with id: %d", r.ElectorateID)
 		}
-		newRuleID, err := rulesBucket.NextID(kv)
+		var newRuleID []byte
+		if r.ID != 0 {
+			seq := rulesBucket.Sequence(electionRuleSequence)
+			newRuleID, err = seq.ReserveVal(kv, int64(r.ID))
+		} else {
+			newRuleID, err = rulesBucket.NextID(kv)
+		}
 		if err != nil {
 			return errors.Wrap(err, "unable to generate ElectionRule sequence")
 		}
@@ -100,13 +136,126 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		}
 
 		if _, err := rulesBucket.CreateWithID(kv, newRuleID, &rule); err != nil {
+			return errors.Wrapf(err, "electionRule #%d", i)
+		}
+	}
+
+	return nil
+}
+
+// ExportGenesis will write the latest version of all electorates and
+// election rules to opts, in the format expected by FromGenesis. IDs are
+// preserved so that the election rule addresses do not change. Proposals and
+// votes are not exported, therefore the export fails while any proposal is
+// still in progress.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	err := orm.ForEach(db, NewProposalBucket().IDGenBucket, func(obj orm.Object) error {
+		p, err := asProposal(obj)
+		if err != nil {
+			return err
+		}
+		if p.Status == Proposal_Submitted {
+			return errors.Wrapf(errors.ErrState, "proposal %d is in progress", binary.BigEndian.Uint64(obj.Key()))
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot export proposals")
+	}
+
+	governance := genesisGovernance{
+		Electorate: []genesisElectorate{},
+		Rules:      []genesisRule{},
+	}
+
+	electorates, err := latestVersions(db, NewElectorateBucket().VersioningBucket)
+	if err != nil {
+		return errors.Wrap(err, "cannot load electorates")
+	}
+	for _, obj := range electorates {
+		e, err := asElectorate(obj)
+		if err != nil {
 			return err
 		}
+		ge := genesisElectorate{
+			ID:    binary.BigEndian.Uint64(obj.Key()),
+			Admin: e.Admin,
+			Title: e.Title,
+		}
+		for _, el := range e.Electors {
+			ge.Electors = append(ge.Electors, genesisElector{
+				Address: el.Address,
+				Weight:  el.Weight,
+			})
+		}
+		governance.Electorate = append(governance.Electorate, ge)
 	}
 
+	rules, err := latestVersions(db, NewElectionRulesBucket().VersioningBucket)
+	if err != nil {
+		return errors.Wrap(err, "cannot load election rules")
+	}
+	for _, obj := range rules {
+		r, err := asElectionRule(obj)
+		if err != nil {
+			return err
+		}
+		gr := genesisRule{
+			ID:           binary.BigEndian.Uint64(obj.Key()),
+			Admin:        r.Admin,
+			ElectorateID: binary.BigEndian.Uint64(r.ElectorateID),
+			Title:        r.Title,
+			VotingPeriod: r.VotingPeriod,
+			Threshold:    genesisFraction{Numerator: r.Threshold.Numerator, Denominator: r.Threshold.Denominator},
+		}
+		if r.Quorum != nil {
+			gr.Quorum = genesisFraction{Numerator: r.Quorum.Numerator, Denominator: r.Quorum.Denominator}
+		}
+		governance.Rules = append(governance.Rules, gr)
+	}
+
+	raw, err := json.Marshal(governance)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal governance")
+	}
+	opts["governance"] = raw
 	return nil
 }
 
+// latestVersions returns the latest version of every entity stored in the
+// given bucket, ordered by ID. Deleted entities are skipped. Each returned
+// object's key is the entity ID, without the version.
+func latestVersions(db weave.ReadOnlyKVStore, b orm.VersioningBucket) ([]orm.Object, error) {
+	var ids [][]byte
+	err := orm.ForEach(db, b.IDGenBucket, func(obj orm.Object) error {
+		var ref orm.VersionedIDRef
+		if err := ref.Unmarshal(obj.Key()); err != nil {
+			return errors.Wrap(err, "cannot unmarshal versioned ID")
+		}
+		if n := len(ids); n == 0 || !bytes.Equal(ids[n-1], ref.ID) {
+			ids = append(ids, ref.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make([]orm.Object, 0, len(ids))
+	for _, id := range ids {
+		_, obj, err := b.GetLatestVersion(db, id)
+		if errors.ErrDeleted.Is(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot load %x", id)
+		}
+		obj.SetKey(id)
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
 func encodeSequence(val uint64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, val)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
@@ -211,6 +212,96 @@ func TestInitFromGenesis(t *testing.T) {
 	}
 }
 
+func TestExportGenesis(t *testing.T) {
+	const genesisSnippet = `
+	{
+		"governance": {
+			"electorate": [
+				{
+					"admin": "0000000000000000000000000000000000000000",
+					"title": "first",
+					"electors": [{"weight": 10, "address": "1111111111111111111111111111111111111111"}]
+				},
+				{
+					"admin": "0000000000000000000000000000000000000000",
+					"title": "second",
+					"electors": [{"weight": 1, "address": "3333333333333333333333333333333333333333"}]
+				}
+			],
+			"rules": [
+				{
+					"id": 7,
+					"admin": "4444444444444444444444444444444444444444",
+					"title": "barr",
+					"voting_period": "2h",
+					"threshold": {"numerator": 1, "denominator": 2},
+					"electorate_id": 1
+				}
+			]
+		}
+	}`
+	var opts weave.Options
+	assert.Nil(t, json.Unmarshal([]byte(genesisSnippet), &opts))
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	var ini Initializer
+	assert.Nil(t, ini.FromGenesis(opts, weave.GenesisParams{}, db))
+
+	// Only the latest version of an electorate is exported.
+	electorates := NewElectorateBucket()
+	_, obj, err := electorates.GetLatestVersion(db, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	e, _ := asElectorate(obj)
+	e.Title = "first updated"
+	_, err = electorates.Update(db, weavetest.SequenceID(1), e)
+	assert.Nil(t, err)
+
+	// Proposals are not exported, so export is refused while any of
+	// them is still in progress.
+	proposals := NewProposalBucket()
+	proposal := proposalFixture(t, hAlice)
+	obj, err = proposals.Create(db, &proposal)
+	assert.Nil(t, err)
+	if err := ini.ExportGenesis(db, make(weave.Options)); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error, got %+v", err)
+	}
+	proposal.Status = Proposal_Closed
+	assert.Nil(t, proposals.Update(db, obj.Key(), &proposal))
+
+	exported := make(weave.Options)
+	assert.Nil(t, ini.ExportGenesis(db, exported))
+	var governance genesisGovernance
+	assert.Nil(t, exported.ReadOptions("governance", &governance))
+
+	if want, got := 2, len(governance.Electorate); want != got {
+		t.Fatalf("want %d electorates, got %d", want, got)
+	}
+	if want, got := "first updated", governance.Electorate[0].Title; want != got {
+		t.Errorf("want %q title, got %q", want, got)
+	}
+	if want, got := uint64(1), governance.Electorate[0].ID; want != got {
+		t.Errorf("want electorate ID %d, got %d", want, got)
+	}
+	if want, got := 1, len(governance.Rules); want != got {
+		t.Fatalf("want %d rules, got %d", want, got)
+	}
+	if want, got := uint64(7), governance.Rules[0].ID; want != got {
+		t.Errorf("want rule ID %d, got %d", want, got)
+	}
+
+	// Exported state must be loadable.
+	db = store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	assert.Nil(t, ini.FromGenesis(exported, weave.GenesisParams{}, db))
+	_, rObj, err := NewElectionRulesBucket().GetLatestVersion(db, weavetest.SequenceID(7))
+	assert.Nil(t, err)
+	r, _ := asElectionRule(rObj)
+	if exp, got := Condition(weavetest.SequenceID(7)).Address(), r.Address; !bytes.Equal(exp, got) {
+		t.Errorf("expected %v but got %v", exp, got)
+	}
+}
+
 func addr(s string) weave.Address {
 	a, err := hex.DecodeString(s)
 	if err != nil {
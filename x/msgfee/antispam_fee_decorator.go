@@ -4,10 +4,13 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
 )
 
 // AntispamFeeDecorator implements a decorator that for each processed transaction
-// asks for a minimal fee. The fee is defined globally in the app.
+// asks for a minimal fee. The fee is defined globally in the app. When the cash
+// fee market is enabled, the fee is scaled proportionally to the change of the
+// minimal fee (see cash.ScaleFee), so that spam gets more expensive under load.
 // If fee is not set (zero value) or is less than the fee already asked for the transaction
 // then this decorator is a noop.
 type AntispamFeeDecorator struct {
@@ -39,12 +42,16 @@ func (d *AntispamFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx
 	if res.RequiredFee.IsZero() {
 		return nil, errors.Wrap(errors.ErrEmpty, "required must not be zero")
 	}
-	if !res.RequiredFee.SameType(d.fee) {
+	fee, err := cash.ScaleFee(store, d.fee)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scale antispam fee")
+	}
+	if !res.RequiredFee.SameType(fee) {
 		return nil, errors.Wrapf(errors.ErrCurrency,
-			"antispam fee has the wrong type: expected %q, got %q", d.fee.Ticker, res.RequiredFee.Ticker)
+			"antispam fee has the wrong type: expected %q, got %q", fee.Ticker, res.RequiredFee.Ticker)
 	}
-	if !res.RequiredFee.IsGTE(d.fee) {
-		res.RequiredFee = d.fee
+	if !res.RequiredFee.IsGTE(fee) {
+		res.RequiredFee = fee
 	}
 	return res, nil
 }
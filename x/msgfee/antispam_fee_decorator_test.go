@@ -7,8 +7,11 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
 )
 
 func TestNewAntispamFeeDecoratorZero(t *testing.T) {
@@ -108,7 +111,7 @@ func TestNewAntispamFeeDecorator(t *testing.T) {
 				tc.Handler.CheckErr = tc.CheckErr
 			}
 
-			cres, err := decorator.Check(nil, nil, tc.Tx, tc.Handler)
+			cres, err := decorator.Check(nil, store.MemStore(), tc.Tx, tc.Handler)
 			if !tc.WantCheckErr.Is(err) {
 				t.Fatalf("check returned an unexpected error: %v", err)
 			}
@@ -124,6 +127,35 @@ func TestNewAntispamFeeDecorator(t *testing.T) {
 	}
 }
 
+func TestAntispamFeeDecoratorFeeMarket(t *testing.T) {
+	db := store.MemStore()
+	conf := cash.Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		CollectorAddress: weavetest.NewCondition().Address(),
+		MinimalFee:       coin.NewCoin(1, 0, "IOV"),
+		FeeMarket: &cash.FeeMarket{
+			TargetBlockGas:        100,
+			AdjustmentDenominator: 2,
+		},
+	}
+	if err := gconf.Save(db, "cash", &conf); err != nil {
+		t.Fatalf("cannot save cash configuration: %s", err)
+	}
+	// A full block increases the minimal fee by half, to 1.5 IOV.
+	ctx := weave.WithHeight(context.Background(), 1)
+	cash.NewFeeMarketEndBlocker().EndBlock(ctx, db, weave.BlockSummary{GasUsed: 200})
+
+	decorator := NewAntispamFeeDecorator(coin.NewCoin(2, 0, "IOV"))
+	handler := &weavetest.Handler{
+		CheckResult: weave.CheckResult{RequiredFee: coin.NewCoin(1, 0, "IOV")},
+	}
+	res, err := decorator.Check(ctx, db, &weavetest.Tx{}, handler)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, coin.NewCoin(3, 0, "IOV"), res.RequiredFee)
+}
+
 func BenchmarkAntispamFeeDecorator(b *testing.B) {
 	cases := map[string]struct {
 		Next    weave.Handler
@@ -4,11 +4,13 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
 )
 
 var _ weave.QueryHandler = (*AntiSpamQuery)(nil)
 
-// AntiSpamQuery allows querying currently set anti-spam fee
+// AntiSpamQuery allows querying currently set anti-spam fee. Returned fee is
+// scaled according to the cash fee market.
 type AntiSpamQuery struct {
 	minFee coin.Coin
 }
@@ -18,7 +20,11 @@ func NewAntiSpamQuery(minFee coin.Coin) *AntiSpamQuery {
 }
 
 func (q *AntiSpamQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
-	bytes, err := q.minFee.Marshal()
+	fee, err := cash.ScaleFee(db, q.minFee)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scale anti-spam fee")
+	}
+	bytes, err := fee.Marshal()
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to marshal anti-spam fee")
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest/assert"
 )
 
@@ -15,7 +16,7 @@ func TestAntiSpamQuery(t *testing.T) {
 	}
 
 	q := NewAntiSpamQuery(initialCoin)
-	model, err := q.Query(nil, "", nil)
+	model, err := q.Query(store.MemStore(), "", nil)
 	assert.Nil(t, err)
 
 	modelNum := len(model)
@@ -0,0 +1,24 @@
+package msgfee
+
+import (
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+var _ gconf.OwnedConfig = (*Configuration)(nil)
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	// Owner is optional. Without an owner, message fees can be declared
+	// only in the genesis.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	return errs
+}
@@ -12,5 +12,11 @@ This extension does not know of supported (installed) message paths and
 therefore cannot validate for their existence. Make sure that when registering
 a new message fee the path is set correctly.
 
+Message fees are declared in the genesis file. They can be changed later using
+SetMsgFeeMsg and RemoveMsgFeeMsg messages, signed by the owner declared in the
+configuration. Set the owner to the address of an election rule (for example
+"seq:gov/rule/1") in order to manage message fees via on-chain governance. The
+owner can change the configuration using UpdateConfigurationMsg.
+
 */
 package msgfee
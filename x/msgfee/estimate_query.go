@@ -0,0 +1,108 @@
+package msgfee
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
+)
+
+var _ weave.QueryHandler = (*FeeEstimateQuery)(nil)
+
+// FeeEstimateQuery allows querying the fee that is charged for processing a
+// transaction.
+//
+// Required fee is defined by three independent sources: the message fee
+// declared in this extension (computed the same way as by the FeeDecorator,
+// including all messages of a batch), the node-local anti-spam fee and the minimal
+// fee declared by the cash extension configuration. When the cash fee market
+// is enabled, both the anti-spam and the minimal fee are adjusted to the
+// current block fullness. A transaction must pay
+// at least each of them, so the estimated fee is the highest of all three.
+type FeeEstimateQuery struct {
+	antispamFee coin.Coin
+	decoder     weave.TxDecoder
+	bucket      *MsgFeeBucket
+}
+
+// NewFeeEstimateQuery returns a query handler that is using given anti-spam
+// fee value. Use the same value as provided to the AntispamFeeDecorator.
+// Queried transactions are deserialized using given decoder.
+func NewFeeEstimateQuery(antispamFee coin.Coin, decoder weave.TxDecoder) *FeeEstimateQuery {
+	return &FeeEstimateQuery{
+		antispamFee: antispamFee,
+		decoder:     decoder,
+		bucket:      NewMsgFeeBucket(),
+	}
+}
+
+// Query expects data to be a serialized transaction. It returns a single
+// model with an empty key and a serialized FeeEstimate as the value.
+// Transaction does not have to be signed.
+func (q *FeeEstimateQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unsupported query mode: %q", mod)
+	}
+	if len(data) == 0 {
+		return nil, errors.Wrap(errors.ErrEmpty, "transaction")
+	}
+	tx, err := q.decoder(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode transaction")
+	}
+	estimate, err := q.Estimate(db, tx)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := estimate.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal fee estimate")
+	}
+	return []weave.Model{weave.Pair([]byte(""), raw)}, nil
+}
+
+// Estimate returns the fee that is charged for processing given transaction.
+func (q *FeeEstimateQuery) Estimate(db weave.ReadOnlyKVStore, tx weave.Tx) (*FeeEstimate, error) {
+	var estimate FeeEstimate
+
+	msgFee, err := txFee(q.bucket, db, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get message fee")
+	}
+	if msgFee != nil {
+		estimate.MsgFee = *msgFee
+	}
+	antispamFee, err := cash.ScaleFee(db, q.antispamFee)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot scale antispam fee")
+	}
+	estimate.AntispamFee = antispamFee
+
+	minimalFee, err := cash.CurrentMinimalFee(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load minimal fee")
+	}
+	estimate.MinimalFee = minimalFee
+
+	for _, fee := range []coin.Coin{estimate.MsgFee, estimate.AntispamFee, estimate.MinimalFee} {
+		if fee.IsZero() {
+			continue
+		}
+		if estimate.Fee.IsZero() {
+			estimate.Fee = fee
+			continue
+		}
+		if !fee.SameType(estimate.Fee) {
+			return nil, errors.Wrapf(errors.ErrCurrency,
+				"fees declared in different currencies: %q and %q", estimate.Fee.Ticker, fee.Ticker)
+		}
+		if !estimate.Fee.IsGTE(fee) {
+			estimate.Fee = fee
+		}
+	}
+	return &estimate, nil
+}
+
+func (q *FeeEstimateQuery) RegisterQuery(qr weave.QueryRouter) {
+	qr.Register("/estimatefee", q)
+}
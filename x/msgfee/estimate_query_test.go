@@ -0,0 +1,175 @@
+package msgfee
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestFeeEstimateQuery(t *testing.T) {
+	cases := map[string]struct {
+		MsgFee       coin.Coin
+		AntispamFee  coin.Coin
+		MinimalFee   coin.Coin
+		FeeMarket    *cash.FeeMarket
+		BlockGasUsed int64
+		MsgPath      string
+		WantErr      *errors.Error
+		WantEstimate FeeEstimate
+	}{
+		"no fees declared": {
+			MsgPath:      "foo/bar",
+			WantEstimate: FeeEstimate{},
+		},
+		"message fee is the highest": {
+			MsgFee:      coin.NewCoin(3, 0, "IOV"),
+			AntispamFee: coin.NewCoin(1, 0, "IOV"),
+			MinimalFee:  coin.NewCoin(2, 0, "IOV"),
+			MsgPath:     "foo/bar",
+			WantEstimate: FeeEstimate{
+				Fee:         coin.NewCoin(3, 0, "IOV"),
+				MsgFee:      coin.NewCoin(3, 0, "IOV"),
+				AntispamFee: coin.NewCoin(1, 0, "IOV"),
+				MinimalFee:  coin.NewCoin(2, 0, "IOV"),
+			},
+		},
+		"minimal fee is higher than the message fee": {
+			MsgFee:     coin.NewCoin(0, 11, "IOV"),
+			MinimalFee: coin.NewCoin(1, 0, "IOV"),
+			MsgPath:    "foo/bar",
+			WantEstimate: FeeEstimate{
+				Fee:        coin.NewCoin(1, 0, "IOV"),
+				MsgFee:     coin.NewCoin(0, 11, "IOV"),
+				MinimalFee: coin.NewCoin(1, 0, "IOV"),
+			},
+		},
+		"antispam fee is the highest": {
+			AntispamFee: coin.NewCoin(4, 0, "IOV"),
+			MinimalFee:  coin.NewCoin(2, 0, "IOV"),
+			MsgPath:     "foo/bar",
+			WantEstimate: FeeEstimate{
+				Fee:         coin.NewCoin(4, 0, "IOV"),
+				AntispamFee: coin.NewCoin(4, 0, "IOV"),
+				MinimalFee:  coin.NewCoin(2, 0, "IOV"),
+			},
+		},
+		"message fee declared for another path is ignored": {
+			MsgFee:     coin.NewCoin(3, 0, "IOV"),
+			MinimalFee: coin.NewCoin(2, 0, "IOV"),
+			MsgPath:    "foo/baz",
+			WantEstimate: FeeEstimate{
+				Fee:        coin.NewCoin(2, 0, "IOV"),
+				MinimalFee: coin.NewCoin(2, 0, "IOV"),
+			},
+		},
+		"fee market adjusted minimal and antispam fee": {
+			AntispamFee: coin.NewCoin(1, 0, "IOV"),
+			MinimalFee:  coin.NewCoin(2, 0, "IOV"),
+			FeeMarket: &cash.FeeMarket{
+				TargetBlockGas:        100,
+				AdjustmentDenominator: 2,
+			},
+			BlockGasUsed: 200,
+			MsgPath:      "foo/bar",
+			WantEstimate: FeeEstimate{
+				Fee:         coin.NewCoin(3, 0, "IOV"),
+				AntispamFee: coin.NewCoin(1, 500000000, "IOV"),
+				MinimalFee:  coin.NewCoin(3, 0, "IOV"),
+			},
+		},
+		"fees of all batched messages are summed": {
+			MsgFee:     coin.NewCoin(3, 0, "IOV"),
+			MinimalFee: coin.NewCoin(2, 0, "IOV"),
+			MsgPath:    "foo/bar,foo/baz,foo/bar",
+			WantEstimate: FeeEstimate{
+				Fee:        coin.NewCoin(6, 0, "IOV"),
+				MsgFee:     coin.NewCoin(6, 0, "IOV"),
+				MinimalFee: coin.NewCoin(2, 0, "IOV"),
+			},
+		},
+		"fees declared in different currencies": {
+			MsgFee:     coin.NewCoin(3, 0, "IOV"),
+			MinimalFee: coin.NewCoin(2, 0, "DOGE"),
+			MsgPath:    "foo/bar",
+			WantErr:    errors.ErrCurrency,
+		},
+		"transaction is required": {
+			MsgPath: "",
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "msgfee")
+
+			conf := cash.Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				CollectorAddress: weavetest.NewCondition().Address(),
+				MinimalFee:       tc.MinimalFee,
+				FeeMarket:        tc.FeeMarket,
+			}
+			if err := gconf.Save(db, "cash", &conf); err != nil {
+				t.Fatalf("cannot save cash configuration: %s", err)
+			}
+			if tc.FeeMarket != nil {
+				ctx := weave.WithHeight(context.Background(), 1)
+				summary := weave.BlockSummary{GasUsed: tc.BlockGasUsed}
+				cash.NewFeeMarketEndBlocker().EndBlock(ctx, db, summary)
+			}
+			if !tc.MsgFee.IsZero() {
+				fee := MsgFee{
+					Metadata: &weave.Metadata{Schema: 1},
+					MsgPath:  "foo/bar",
+					Fee:      tc.MsgFee,
+				}
+				if _, err := NewMsgFeeBucket().Create(db, &fee); err != nil {
+					t.Fatalf("cannot create message fee: %s", err)
+				}
+			}
+
+			q := NewFeeEstimateQuery(tc.AntispamFee, pathTxDecoder)
+			models, err := q.Query(db, weave.KeyQueryMod, []byte(tc.MsgPath))
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.WantErr != nil {
+				return
+			}
+			if len(models) != 1 {
+				t.Fatalf("expected 1 model, got %d", len(models))
+			}
+			assert.Equal(t, []byte(""), models[0].Key)
+			var got FeeEstimate
+			if err := got.Unmarshal(models[0].Value); err != nil {
+				t.Fatalf("cannot unmarshal fee estimate: %s", err)
+			}
+			assert.Equal(t, tc.WantEstimate, got)
+		})
+	}
+}
+
+// pathTxDecoder returns a transaction with a message of the path given as the
+// transaction data. Comma separated paths result in a batch message.
+func pathTxDecoder(raw []byte) (weave.Tx, error) {
+	paths := strings.Split(string(raw), ",")
+	if len(paths) == 1 {
+		return &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: paths[0]}}, nil
+	}
+	msg := &batchMsg{Msg: weavetest.Msg{RoutePath: "test/batch"}}
+	for _, p := range paths {
+		msg.msgs = append(msg.msgs, &weavetest.Msg{RoutePath: p})
+	}
+	return &weavetest.Tx{Msg: msg}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/batch"
 )
 
 // FeeDecorator implements a decorator that for each processed transaction
@@ -12,6 +13,7 @@ import (
 // not increase the required fee value.
 // Additional fee is attached to only those transaction results that represent
 // a success.
+// The fee of a batch transaction includes the fees of all batched messages.
 type FeeDecorator struct {
 	bucket *MsgFeeBucket
 }
@@ -66,15 +68,53 @@ func (d *FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 	return res, nil
 }
 
-// txFee returns the fee value for a given transaction as configured in the store.
-func txFee(bucket *MsgFeeBucket, store weave.KVStore, tx weave.Tx) (*coin.Coin, error) {
+// txFee returns the fee value for a given transaction as configured in the
+// store.
+func txFee(bucket *MsgFeeBucket, store weave.ReadOnlyKVStore, tx weave.Tx) (*coin.Coin, error) {
 	msg, err := tx.GetMsg()
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get message")
 	}
+	return msgFee(bucket, store, msg)
+}
+
+// msgFee returns the fee value for a given message as configured in the
+// store. The fee of a batch message is the sum of the fee declared for the
+// batch message itself and the fees of all messages it contains, so that
+// batching cannot be used to avoid paying a message fee.
+func msgFee(bucket *MsgFeeBucket, store weave.ReadOnlyKVStore, msg weave.Msg) (*coin.Coin, error) {
 	fee, err := bucket.MessageFee(store, msg.Path())
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot get fee")
 	}
-	return fee, nil
+	batchMsg, ok := msg.(batch.Msg)
+	if !ok {
+		return fee, nil
+	}
+	msgs, err := batchMsg.MsgList()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get batch messages")
+	}
+	var total coin.Coin
+	if fee != nil {
+		total = *fee
+	}
+	for _, m := range msgs {
+		fee, err := msgFee(bucket, store, m)
+		if err != nil {
+			return nil, err
+		}
+		if coin.IsEmpty(fee) {
+			continue
+		}
+		if total.IsZero() {
+			total = *fee
+			continue
+		}
+		total, err = total.Add(*fee)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot sum batch message fees")
+		}
+	}
+	return &total, nil
 }
@@ -89,6 +89,31 @@ func TestFeeDecorator(t *testing.T) {
 			WantCheckFee:   coin.Coin{},
 			WantDeliverFee: coin.Coin{},
 		},
+		"batch message fee includes fees of all batched messages": {
+			InitFees: []MsgFee{
+				{
+					Metadata: &weave.Metadata{Schema: 1},
+					MsgPath:  "foo/bar",
+					Fee:      coin.NewCoin(0, 1234, "DOGE"),
+				},
+				{
+					Metadata: &weave.Metadata{Schema: 1},
+					MsgPath:  "test/batch",
+					Fee:      coin.NewCoin(1, 0, "DOGE"),
+				},
+			},
+			Handler: &weavetest.Handler{},
+			Tx: &weavetest.Tx{Msg: &batchMsg{
+				Msg: weavetest.Msg{RoutePath: "test/batch"},
+				msgs: []weave.Msg{
+					&weavetest.Msg{RoutePath: "foo/bar"},
+					&weavetest.Msg{RoutePath: "foo/baz"},
+					&weavetest.Msg{RoutePath: "foo/bar"},
+				},
+			}},
+			WantCheckFee:   coin.NewCoin(1, 2468, "DOGE"),
+			WantDeliverFee: coin.NewCoin(1, 2468, "DOGE"),
+		},
 		"message fee with a different ticker than the existing fee": {
 			InitFees: []MsgFee{
 				{
@@ -205,3 +230,13 @@ func BenchmarkFeeDecorator(b *testing.B) {
 		})
 	}
 }
+
+// batchMsg is a batch message that contains given messages.
+type batchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+func (m *batchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
@@ -0,0 +1,128 @@
+package msgfee
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x"
+)
+
+// RegisterRoutes registers handlers for managing message fees and the
+// configuration. Only the owner declared in the configuration is allowed to
+// change message fees and the configuration.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("msgfee", r)
+
+	bucket := NewMsgFeeBucket()
+	r.Handle(&SetMsgFeeMsg{}, &setMsgFeeHandler{auth: auth, bucket: bucket})
+	r.Handle(&RemoveMsgFeeMsg{}, &removeMsgFeeHandler{auth: auth, bucket: bucket})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+// NewConfigHandler returns a handler that allows the owner to update the
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("msgfee", &conf, auth)
+}
+
+type setMsgFeeHandler struct {
+	auth   x.Authenticator
+	bucket *MsgFeeBucket
+}
+
+var _ weave.Handler = (*setMsgFeeHandler)(nil)
+
+func (h *setMsgFeeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *setMsgFeeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	fee := MsgFee{
+		Metadata: &weave.Metadata{Schema: 1},
+		MsgPath:  msg.MsgPath,
+		Fee:      msg.Fee,
+	}
+	if _, err := h.bucket.Create(db, &fee); err != nil {
+		return nil, errors.Wrap(err, "cannot store fee")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *setMsgFeeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*SetMsgFeeMsg, error) {
+	var msg SetMsgFeeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := authorizeOwner(ctx, db, h.auth); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+type removeMsgFeeHandler struct {
+	auth   x.Authenticator
+	bucket *MsgFeeBucket
+}
+
+var _ weave.Handler = (*removeMsgFeeHandler)(nil)
+
+func (h *removeMsgFeeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *removeMsgFeeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.bucket.Delete(db, []byte(msg.MsgPath)); err != nil {
+		return nil, errors.Wrap(err, "cannot delete fee")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *removeMsgFeeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*RemoveMsgFeeMsg, error) {
+	var msg RemoveMsgFeeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := authorizeOwner(ctx, db, h.auth); err != nil {
+		return nil, err
+	}
+	fee, err := h.bucket.MessageFee(db, msg.MsgPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get fee")
+	}
+	if fee == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no fee declared for %q", msg.MsgPath)
+	}
+	return &msg, nil
+}
+
+// authorizeOwner returns an error if the configuration owner did not sign
+// the transaction.
+func authorizeOwner(ctx weave.Context, db weave.KVStore, auth x.Authenticator) error {
+	var conf Configuration
+	if err := gconf.Load(db, "msgfee", &conf); err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+	if conf.Owner == nil {
+		return errors.Wrap(errors.ErrUnauthorized, "owner signature required")
+	}
+	if !auth.HasAddress(ctx, conf.Owner) {
+		return errors.Wrap(errors.ErrUnauthorized, "owner did not sign transaction")
+	}
+	return nil
+}
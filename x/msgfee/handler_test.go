@@ -0,0 +1,233 @@
+package msgfee
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMsgFeeHandlers(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		Owner          weave.Address
+		Signers        []weave.Condition
+		InitFees       []MsgFee
+		Msg            weave.Msg
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		WantFees       map[string]*coin.Coin
+	}{
+		"owner can declare a new fee": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(1, 0, "IOV"),
+			},
+			WantFees: map[string]*coin.Coin{
+				"foo/bar": coin.NewCoinp(1, 0, "IOV"),
+			},
+		},
+		"owner can replace an existing fee": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+			InitFees: []MsgFee{
+				{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/bar", Fee: coin.NewCoin(1, 0, "IOV")},
+				{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/baz", Fee: coin.NewCoin(2, 0, "IOV")},
+			},
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(0, 5, "IOV"),
+			},
+			WantFees: map[string]*coin.Coin{
+				"foo/bar": coin.NewCoinp(0, 5, "IOV"),
+				"foo/baz": coin.NewCoinp(2, 0, "IOV"),
+			},
+		},
+		"owner can remove a fee": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+			InitFees: []MsgFee{
+				{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/bar", Fee: coin.NewCoin(1, 0, "IOV")},
+				{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/baz", Fee: coin.NewCoin(2, 0, "IOV")},
+			},
+			Msg: &RemoveMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+			},
+			WantFees: map[string]*coin.Coin{
+				"foo/bar": nil,
+				"foo/baz": coin.NewCoinp(2, 0, "IOV"),
+			},
+		},
+		"cannot remove a fee that is not declared": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+			Msg: &RemoveMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+			},
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+		"only the owner can declare a fee": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{stranger},
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(1, 0, "IOV"),
+			},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+			WantFees: map[string]*coin.Coin{
+				"foo/bar": nil,
+			},
+		},
+		"only the owner can remove a fee": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{stranger},
+			InitFees: []MsgFee{
+				{Metadata: &weave.Metadata{Schema: 1}, MsgPath: "foo/bar", Fee: coin.NewCoin(1, 0, "IOV")},
+			},
+			Msg: &RemoveMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+			},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+			WantFees: map[string]*coin.Coin{
+				"foo/bar": coin.NewCoinp(1, 0, "IOV"),
+			},
+		},
+		"fees cannot be changed without an owner": {
+			Owner:   nil,
+			Signers: []weave.Condition{owner},
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(1, 0, "IOV"),
+			},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"invalid message": {
+			Owner:   owner.Address(),
+			Signers: []weave.Condition{owner},
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+			},
+			WantCheckErr:   errors.ErrAmount,
+			WantDeliverErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "msgfee")
+
+			conf := Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    tc.Owner,
+			}
+			if err := gconf.Save(db, "msgfee", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			bucket := NewMsgFeeBucket()
+			for i, f := range tc.InitFees {
+				if _, err := bucket.Create(db, &f); err != nil {
+					t.Fatalf("cannot create #%d fee: %s", i, err)
+				}
+			}
+
+			auth := &weavetest.Auth{Signers: tc.Signers}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth)
+			tx := &weavetest.Tx{Msg: tc.Msg}
+
+			cache := db.CacheWrap()
+			if _, err := rt.Check(context.TODO(), cache, tx); !tc.WantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			cache.Discard()
+			if _, err := rt.Deliver(context.TODO(), db, tx); !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			for path, want := range tc.WantFees {
+				got, err := bucket.MessageFee(db, path)
+				if err != nil {
+					t.Fatalf("cannot get %q fee: %s", path, err)
+				}
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationHandler(t *testing.T) {
+	owner := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+	newOwner := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		Signer    weave.Condition
+		WantErr   *errors.Error
+		WantOwner weave.Address
+	}{
+		"owner can update the configuration": {
+			Signer:    owner,
+			WantOwner: newOwner,
+		},
+		"only the owner can update the configuration": {
+			Signer:    stranger,
+			WantErr:   errors.ErrUnauthorized,
+			WantOwner: owner.Address(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "msgfee")
+			conf := Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    owner.Address(),
+			}
+			if err := gconf.Save(db, "msgfee", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, &weavetest.Auth{Signer: tc.Signer})
+			tx := &weavetest.Tx{Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{Owner: newOwner},
+			}}
+			if _, err := rt.Deliver(context.TODO(), db, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			var got Configuration
+			if err := gconf.Load(db, "msgfee", &got); err != nil {
+				t.Fatalf("cannot load configuration: %s", err)
+			}
+			assert.Equal(t, tc.WantOwner, got.Owner)
+		})
+	}
+}
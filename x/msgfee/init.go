@@ -1,11 +1,14 @@
 package msgfee
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -13,15 +16,18 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisMsgFee is the genesis file representation of a message fee.
+type genesisMsgFee struct {
+	MsgPath string    `json:"msg_path"`
+	Fee     coin.Coin `json:"fee"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it to the
 // database
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	type msgfee struct {
-		MsgPath string    `json:"msg_path"`
-		Fee     coin.Coin `json:"fee"`
-	}
-	var fees []*msgfee
+	var fees []*genesisMsgFee
 	if err := opts.ReadOptions("msgfee", &fees); err != nil {
 		return errors.Wrap(err, "cannot load fees")
 	}
@@ -40,5 +46,44 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			return errors.Wrap(err, fmt.Sprintf("cannot store #%d fee", i))
 		}
 	}
+
+	// Configuration is optional. Without it, message fees cannot be
+	// changed after the genesis.
+	switch err := gconf.InitConfig(kv, opts, "msgfee", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
+
+// ExportGenesis will write all message fees to opts, in the format expected
+// by FromGenesis.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	fees := []genesisMsgFee{}
+	err := orm.ForEach(db, NewMsgFeeBucket(), func(obj orm.Object) error {
+		f, ok := obj.Value().(*MsgFee)
+		if !ok {
+			return errors.WithType(errors.ErrModel, obj.Value())
+		}
+		fees = append(fees, genesisMsgFee{MsgPath: f.MsgPath, Fee: f.Fee})
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot export fees")
+	}
+	raw, err := json.Marshal(fees)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal fees")
+	}
+	opts["msgfee"] = raw
+
+	switch err := gconf.ExportConfig(db, opts, "msgfee", &Configuration{}); {
+	case errors.ErrNotFound.Is(err):
+		// No configuration declared.
+	case err != nil:
+		return errors.Wrap(err, "export config")
+	}
 	return nil
 }
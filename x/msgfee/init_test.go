@@ -6,6 +6,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 )
@@ -80,3 +81,62 @@ func TestGenesisWithInvalidFee(t *testing.T) {
 	}
 
 }
+
+func TestGenesisConfiguration(t *testing.T) {
+	const genesis = `
+{
+	"conf": {
+		"msgfee": {
+			"metadata": {"schema": 1},
+			"owner": "seq:gov/rule/1"
+		}
+	},
+	"msgfee": []
+}
+	`
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "msgfee")
+	var ini Initializer
+	if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis: %s", err)
+	}
+
+	var conf Configuration
+	if err := gconf.Load(db, "msgfee", &conf); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	owner, err := weave.ParseAddress("seq:gov/rule/1")
+	if err != nil {
+		t.Fatalf("cannot parse owner address: %s", err)
+	}
+	if !conf.Owner.Equals(owner) {
+		t.Fatalf("unexpected owner: %s", conf.Owner)
+	}
+
+	exported := make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis: %s", err)
+	}
+	if _, ok := exported["conf"]; !ok {
+		t.Fatal("configuration not exported")
+	}
+
+	// Configuration is optional.
+	db = store.MemStore()
+	migration.MustInitPkg(db, "msgfee")
+	if err := ini.FromGenesis(weave.Options{"msgfee": []byte(`[]`)}, weave.GenesisParams{}, db); err != nil {
+		t.Fatalf("cannot load genesis without configuration: %s", err)
+	}
+	exported = make(weave.Options)
+	if err := ini.ExportGenesis(db, exported); err != nil {
+		t.Fatalf("cannot export genesis without configuration: %s", err)
+	}
+	if _, ok := exported["conf"]; ok {
+		t.Fatal("configuration exported")
+	}
+}
@@ -0,0 +1,70 @@
+package msgfee
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &SetMsgFeeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RemoveMsgFeeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*SetMsgFeeMsg)(nil)
+
+func (SetMsgFeeMsg) Path() string {
+	return "msgfee/set_msg_fee"
+}
+
+func (msg *SetMsgFeeMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.MsgPath == "" {
+		errs = errors.Append(errs, errors.Field("MsgPath", errors.ErrEmpty, "required"))
+	}
+	if msg.Fee.IsZero() {
+		errs = errors.Append(errs, errors.Field("Fee", errors.ErrAmount, "must not be zero, remove the fee instead"))
+	} else {
+		errs = errors.AppendField(errs, "Fee", msg.Fee.Validate())
+		if !msg.Fee.IsPositive() {
+			errs = errors.Append(errs, errors.Field("Fee", errors.ErrAmount, "must be positive"))
+		}
+	}
+	return errs
+}
+
+var _ weave.Msg = (*RemoveMsgFeeMsg)(nil)
+
+func (RemoveMsgFeeMsg) Path() string {
+	return "msgfee/remove_msg_fee"
+}
+
+func (msg *RemoveMsgFeeMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.MsgPath == "" {
+		errs = errors.Append(errs, errors.Field("MsgPath", errors.ErrEmpty, "required"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+func (UpdateConfigurationMsg) Path() string {
+	return "msgfee/update_configuration"
+}
+
+// Validate will skip any zero fields and validate the set ones.
+func (msg *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.Patch == nil {
+		return errors.Append(errs, errors.Field("Patch", errors.ErrEmpty, "required"))
+	}
+	if len(msg.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", msg.Patch.Owner.Validate())
+	}
+	return errs
+}
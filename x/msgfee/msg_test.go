@@ -0,0 +1,149 @@
+package msgfee
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestValidateSetMsgFeeMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &SetMsgFeeMsg{
+				MsgPath: "foo/bar",
+				Fee:     coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing message path": {
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Fee:      coin.NewCoin(1, 0, "IOV"),
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"zero fee": {
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(0, 0, "IOV"),
+			},
+			WantErr: errors.ErrAmount,
+		},
+		"negative fee": {
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(-1, 0, "IOV"),
+			},
+			WantErr: errors.ErrAmount,
+		},
+		"fee without a ticker": {
+			Msg: &SetMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+				Fee:      coin.NewCoin(1, 0, ""),
+			},
+			WantErr: errors.ErrCurrency,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateRemoveMsgFeeMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &RemoveMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				MsgPath:  "foo/bar",
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &RemoveMsgFeeMsg{
+				MsgPath: "foo/bar",
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing message path": {
+			Msg: &RemoveMsgFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateConfigurationMsg(t *testing.T) {
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid message": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{Owner: weavetest.NewCondition().Address()},
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &UpdateConfigurationMsg{
+				Patch: &Configuration{Owner: weavetest.NewCondition().Address()},
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"missing patch": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"invalid owner": {
+			Msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{Owner: weave.Address("invalid")},
+			},
+			WantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package multisig
 
 import (
+	"encoding/binary"
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -10,18 +13,26 @@ import (
 type Initializer struct{}
 
 var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisContract is the genesis file representation of a contract.
+type genesisContract struct {
+	// ID is optional. If not set, the next sequence value is used.
+	ID                  uint64               `json:"id,omitempty"`
+	Participants        []genesisParticipant `json:"participants"`
+	ActivationThreshold Weight               `json:"activation_threshold"`
+	AdminThreshold      Weight               `json:"admin_threshold"`
+}
+
+type genesisParticipant struct {
+	Signature weave.Address `json:"signature"`
+	Weight    Weight        `json:"weight"`
+}
 
 // FromGenesis will parse initial account info from genesis and save it in the
 // database.
 func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
-	var contracts []struct {
-		Participants []struct {
-			Signature weave.Address `json:"signature"`
-			Weight    Weight        `json:"weight"`
-		} `json:"participants"`
-		ActivationThreshold Weight `json:"activation_threshold"`
-		AdminThreshold      Weight `json:"admin_threshold"`
-	}
+	var contracts []genesisContract
 	if err := opts.ReadOptions("multisig", &contracts); err != nil {
 		return err
 	}
@@ -35,10 +46,21 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 				Weight:    p.Weight,
 			})
 		}
-		key, err := contractSeq.NextVal(kv)
+		var (
+			key []byte
+			err error
+		)
+		if c.ID != 0 {
+			key, err = contractSeq.ReserveVal(kv, int64(c.ID))
+		} else {
+			key, err = contractSeq.NextVal(kv)
+		}
 		if err != nil {
 			return errors.Wrap(err, "cannot acquire ID")
 		}
+		if err := bucket.Has(kv, key); err == nil {
+			return errors.Wrapf(errors.ErrDuplicate, "contract with id %d", c.ID)
+		}
 		contract := Contract{
 			Metadata:            &weave.Metadata{Schema: 1},
 			Participants:        ps,
@@ -52,3 +74,44 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	}
 	return nil
 }
+
+// ExportGenesis will write all contracts to opts, in the format expected by
+// FromGenesis. Contract IDs are preserved so that the contract addresses do
+// not change.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	it, err := NewContractBucket().Iterate(db, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "cannot iterate contracts")
+	}
+	defer it.Release()
+
+	contracts := []genesisContract{}
+	for {
+		var c Contract
+		key, err := it.LoadNext(&c)
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "cannot load contract")
+		}
+		gc := genesisContract{
+			ID:                  binary.BigEndian.Uint64(key),
+			ActivationThreshold: c.ActivationThreshold,
+			AdminThreshold:      c.AdminThreshold,
+		}
+		for _, p := range c.Participants {
+			gc.Participants = append(gc.Participants, genesisParticipant{
+				Signature: p.Signature,
+				Weight:    p.Weight,
+			})
+		}
+		contracts = append(contracts, gc)
+	}
+	raw, err := json.Marshal(contracts)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal contracts")
+	}
+	opts["multisig"] = raw
+	return nil
+}
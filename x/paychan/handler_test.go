@@ -40,6 +40,7 @@ func TestPaymentChannelHandlers(t *testing.T) {
 	source := weavetest.NewCondition()
 	// Because it is allowed, use different public key to sign the message.
 	sourceSig := weavetest.NewKey()
+	secpSig := crypto.GenPrivKeySecp256k1()
 	destination := weavetest.NewCondition()
 
 	cases := map[string]struct {
@@ -463,6 +464,60 @@ func TestPaymentChannelHandlers(t *testing.T) {
 				},
 			},
 		},
+		"transfer signed with a secp256k1 key": {
+			actions: []action{
+				{
+					conditions: []weave.Condition{source},
+					msg: &CreateMsg{
+						Metadata:     &weave.Metadata{Schema: 1},
+						Source:       source.Address(),
+						Destination:  destination.Address(),
+						SourcePubkey: secpSig.PublicKey(),
+						Total:        dogeCoin(10, 0),
+						Timeout:      weave.AsUnixTime(inOneHour),
+						Memo:         "start",
+					},
+					blocksize: 100,
+				},
+				{
+					conditions: []weave.Condition{source},
+					msg: setSignature(secpSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(4, 0),
+							Memo:      "much transfer",
+						},
+					}),
+					blocksize: 103,
+				},
+				{
+					conditions: []weave.Condition{source},
+					msg: setSignature(sourceSig, &TransferMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						Payment: &Payment{
+							ChainID:   "testchain-123",
+							ChannelID: weavetest.SequenceID(1),
+							Amount:    dogeCoin(5, 0),
+							Memo:      "wrong key",
+						},
+					}),
+					blocksize:    104,
+					wantCheckErr: errors.ErrMsg,
+				},
+			},
+			dbtests: []querycheck{
+				{
+					path:   "/wallets",
+					data:   destination.Address(),
+					bucket: cashBucket.Bucket,
+					wantRes: []orm.Object{
+						mustObject(cash.WalletWith(destination.Address(), dogeCoin(4, 0))),
+					},
+				},
+			},
+		},
 		"transfer signed with invalid key fails": {
 			actions: []action{
 				{
@@ -125,6 +125,35 @@ func TestVerifySignature(t *testing.T) {
 	}
 }
 
+func TestVerifySecp256k1Signature(t *testing.T) {
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "sigs")
+	priv := crypto.GenPrivKeySecp256k1()
+	perm := priv.PublicKey().Condition()
+
+	chainID := "emo-music-2345"
+	bz := []byte("my special valentine")
+	tx := NewStdTx(bz)
+
+	sig0, err := SignTx(priv, tx, chainID, 0)
+	assert.Nil(t, err)
+	sign, err := VerifySignature(kv, sig0, bz, chainID)
+	assert.Nil(t, err)
+	assert.Equal(t, perm, sign)
+
+	// replay is not allowed
+	if _, err := VerifySignature(kv, sig0, bz, chainID); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// signature of a different message
+	sig1, err := SignTx(priv, NewStdTx([]byte("other")), chainID, 1)
+	assert.Nil(t, err)
+	if _, err := VerifySignature(kv, sig1, bz, chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
 func TestVerifyTxSignatures(t *testing.T) {
 	kv := store.MemStore()
 	migration.MustInitPkg(kv, "sigs")
@@ -0,0 +1,73 @@
+package sigs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+var _ weave.Exporter = (*Initializer)(nil)
+
+// genesisUser is the genesis file representation of a user nonce.
+type genesisUser struct {
+	// Pubkey is the protobuf serialized public key of the signer.
+	Pubkey   []byte `json:"pubkey"`
+	Sequence int64  `json:"sequence"`
+}
+
+// FromGenesis will parse the signers sequence values from genesis and save
+// them to the database.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var users []genesisUser
+	if err := opts.ReadOptions("sigs", &users); err != nil {
+		return errors.Wrap(err, "cannot load users")
+	}
+
+	bucket := NewBucket()
+	for i, u := range users {
+		var pubkey crypto.PublicKey
+		if err := pubkey.Unmarshal(u.Pubkey); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("cannot unmarshal #%d user public key", i))
+		}
+		obj := NewUser(&pubkey)
+		AsUser(obj).Sequence = u.Sequence
+		if err := bucket.Save(kv, obj); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("cannot store #%d user", i))
+		}
+	}
+	return nil
+}
+
+// ExportGenesis will write the sequence values of all signers to opts, in
+// the format expected by FromGenesis. Sequence values are preserved so that
+// transactions signed for the exported chain cannot be replayed.
+func (*Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	users := []genesisUser{}
+	err := orm.ForEach(db, NewBucket(), func(obj orm.Object) error {
+		u := AsUser(obj)
+		pubkey, err := u.Pubkey.Marshal()
+		if err != nil {
+			return errors.Wrap(err, "cannot marshal public key")
+		}
+		users = append(users, genesisUser{Pubkey: pubkey, Sequence: u.Sequence})
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot export users")
+	}
+	raw, err := json.Marshal(users)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal users")
+	}
+	opts["sigs"] = raw
+	return nil
+}
@@ -0,0 +1,46 @@
+package sigs
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesisExportSequence(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+
+	bucket := NewBucket()
+	pub := weavetest.NewKey().PublicKey()
+	obj, err := bucket.GetOrCreate(db, pub)
+	assert.Nil(t, err)
+	AsUser(obj).Sequence = 7
+	assert.Nil(t, bucket.Save(db, obj))
+
+	var ini Initializer
+	exported := make(weave.Options)
+	assert.Nil(t, ini.ExportGenesis(db, exported))
+
+	db = store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	assert.Nil(t, ini.FromGenesis(exported, weave.GenesisParams{}, db))
+
+	obj, err = bucket.Get(db, pub.Address())
+	assert.Nil(t, err)
+	if obj == nil {
+		t.Fatal("user not loaded")
+	}
+	user := AsUser(obj)
+	assert.Equal(t, int64(7), user.Sequence)
+	assert.Equal(t, pub, user.Pubkey)
+
+	// A transaction signed with the sequence used before the export must
+	// be rejected.
+	if err := user.CheckAndIncrementSequence(6); !ErrInvalidSequence.Is(err) {
+		t.Fatalf("want ErrInvalidSequence, got %+v", err)
+	}
+}
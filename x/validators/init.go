@@ -1,6 +1,8 @@
 package validators
 
 import (
+	"encoding/json"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -39,3 +41,31 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return errors.Wrap(weave.StoreValidatorUpdates(kv, vu), "store validator updates")
 }
+
+var _ weave.Exporter = Initializer{}
+
+// ExportGenesis will write the list of accounts allowed to update the
+// validators to opts, in the format expected by FromGenesis. The validator
+// set itself is not part of the application state in the genesis file.
+func (Initializer) ExportGenesis(db weave.ReadOnlyKVStore, opts weave.Options) error {
+	obj, err := NewAccountBucket().Get(db, []byte(accountListKey))
+	if err != nil {
+		return errors.Wrap(err, "cannot load accounts")
+	}
+	accounts := WeaveAccounts{Addresses: []weave.Address{}}
+	if obj != nil {
+		acc, ok := obj.Value().(*Accounts)
+		if !ok {
+			return errors.WithType(errors.ErrModel, obj.Value())
+		}
+		for _, a := range acc.Addresses {
+			accounts.Addresses = append(accounts.Addresses, weave.Address(a))
+		}
+	}
+	raw, err := json.Marshal(accounts)
+	if err != nil {
+		return errors.Wrap(err, "cannot marshal accounts")
+	}
+	opts[optKey] = raw
+	return nil
+}